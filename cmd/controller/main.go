@@ -19,34 +19,57 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
+	"strings"
+	"sync"
 	"time"
 
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog"
 
 	"cellery.io/cellery-controller/pkg/clients"
+	"cellery.io/cellery-controller/pkg/cloudevents"
 	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
 	"cellery.io/cellery-controller/pkg/controller/cell"
 	"cellery.io/cellery-controller/pkg/controller/component"
 	"cellery.io/cellery-controller/pkg/controller/composite"
 	"cellery.io/cellery-controller/pkg/controller/gateway"
 	"cellery.io/cellery-controller/pkg/controller/sts"
+	"cellery.io/cellery-controller/pkg/debug"
 	"cellery.io/cellery-controller/pkg/informers"
 	"cellery.io/cellery-controller/pkg/logging"
+	"cellery.io/cellery-controller/pkg/metrics"
 	"cellery.io/cellery-controller/pkg/signals"
+	"cellery.io/cellery-controller/pkg/tracing"
 	"cellery.io/cellery-controller/pkg/version"
 )
 
 const (
 	threadsPerController = 2
 	componentName        = "Controller"
+	serviceName          = "cellery-controller"
+	systemNamespace      = "cellery-system"
 )
 
 var (
-	masterURL  string
-	kubeconfig string
+	masterURL         string
+	kubeconfig        string
+	namespaces        string
+	namespaceSelector string
+	secretSelector    string
+	configMapSelector string
+	reconcileTimeout  time.Duration
+	apiTimeout        time.Duration
+	maxRetries        int
+	metricsAddress    string
+	debugAddress      string
+	debugAllowRemote  bool
 )
 
 func main() {
@@ -68,23 +91,57 @@ func main() {
 		logger.Fatalf("Error building kubeconfig: %s", err.Error())
 	}
 
-	clientset, err := clients.NewFromConfig(cfg)
+	// Informers use long running watches, hence only the clients used by the reconcilers are bounded by the API timeout
+	informerClientset, err := clients.NewFromConfig(cfg)
+	if err != nil {
+		logger.Fatalf("Error building clients: %v", err)
+	}
+
+	// Replace the bootstrap logger with the one configured by the logging ConfigMap
+	configuredLogger, err := logging.NewLoggerFromCluster(informerClientset.Kubernetes(), systemNamespace, stopCh)
+	if err != nil {
+		logger.Fatalf("Error building logger from the logging config: %v", err)
+	}
+	logger = configuredLogger
+	defer logger.Sync()
 
+	reconcilerCfg := rest.CopyConfig(cfg)
+	reconcilerCfg.Timeout = apiTimeout
+	clientset, err := clients.NewFromConfig(reconcilerCfg)
 	if err != nil {
 		logger.Fatalf("Error building clients: %v", err)
 	}
 
 	// Create required informers
-	informerset := informers.New(clientset, time.Second*60)
+	informerOpts := informers.Options{
+		NamespaceSelector: namespaceSelector,
+		SecretSelector:    secretSelector,
+		ConfigMapSelector: configMapSelector,
+	}
+	if len(namespaces) > 0 {
+		informerOpts.Namespaces = strings.Split(namespaces, ",")
+	}
+	if len(namespaces) > 0 || len(namespaceSelector) > 0 {
+		// The system namespace is always watched since it contains the global configuration
+		informerOpts.Namespaces = append(informerOpts.Namespaces, systemNamespace)
+	}
+	informerset, err := informers.NewWithOptions(informerClientset, time.Second*60, informerOpts)
+	if err != nil {
+		logger.Fatalf("Error building informers: %v", err)
+	}
 
 	// Create config watcher
-	cw := config.NewWatcher(informerset, "cellery-config", "cellery-secret", "cellery-system", logger)
+	cw := config.NewWatcher(informerset, "cellery-config", "cellery-secret", systemNamespace, logger)
+
+	// The publisher is set once the configuration is loaded, since the sink is read from it
+	publisher := &cloudevents.Deferred{}
 
 	// Create crd controllers
 	gatewayController := gateway.NewController(
 		clientset,
 		informerset,
 		cw,
+		publisher,
 		logger,
 	)
 
@@ -92,6 +149,7 @@ func main() {
 		clientset,
 		informerset,
 		cw,
+		publisher,
 		logger,
 	)
 
@@ -106,6 +164,7 @@ func main() {
 		clientset,
 		informerset,
 		cw,
+		publisher,
 		logger,
 	)
 
@@ -127,19 +186,105 @@ func main() {
 		logger.Fatalf("Error checking config resources: %v", err)
 	}
 
+	// Export the traces of the reconcile loops if an exporter is configured
+	if exporter := newTraceExporter(cw, logger); exporter != nil {
+		provider := tracing.NewProvider(exporter, serviceName)
+		tracing.SetProvider(provider)
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := provider.Shutdown(ctx); err != nil {
+				logger.Errorf("Error flushing traces: %v", err)
+			}
+		}()
+	}
+
+	// Publish the lifecycle events of the cells if a sink is configured
+	if sink := cw.StringValue(config.ConfigMapKeyCloudEventsSink); len(sink) > 0 {
+		sinkPublisher := cloudevents.NewPublisher(
+			cloudevents.NewHTTPSender(sink),
+			int(cw.IntValue(config.ConfigMapKeyCloudEventsBufferSize)),
+			logger.Named("cloudevents"),
+		)
+		publisher.SetTarget(sinkPublisher)
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := sinkPublisher.Shutdown(ctx); err != nil {
+				logger.Errorf("Error flushing cloud events: %v", err)
+			}
+		}()
+	}
+
 	//Start controllers
 	logger.Info("Starting controllers...")
-	go gatewayController.Run(threadsPerController, stopCh)
-	go componentController.Run(threadsPerController, stopCh)
-	go tokenServiceController.Run(threadsPerController, stopCh)
-	go cellController.Run(threadsPerController, stopCh)
-	go compositeController.Run(threadsPerController, stopCh)
-
-	// Prevent exiting the main process
-	<-stopCh
+	controllers := []*controller.Controller{
+		gatewayController,
+		componentController,
+		tokenServiceController,
+		cellController,
+		compositeController,
+	}
+	var wg sync.WaitGroup
+	for _, c := range controllers {
+		c.SetReconcileTimeout(reconcileTimeout)
+		c.SetMaxRetries(maxRetries)
+		wg.Add(1)
+		go func(c *controller.Controller) {
+			defer wg.Done()
+			c.Run(threadsPerController, stopCh)
+		}(c)
+	}
+
+	if len(metricsAddress) > 0 {
+		go metrics.Serve(metricsAddress, stopCh, logger)
+	}
+
+	if len(debugAddress) > 0 {
+		if err := debug.ValidateAddress(debugAddress, debugAllowRemote); err != nil {
+			logger.Fatalf("Error serving debug endpoints: %v", err)
+		}
+		go debug.Serve(debugAddress, controllers, stopCh, logger)
+	}
+
+	// Prevent exiting the main process until the controllers are drained
+	wg.Wait()
+	logger.Info("Controllers stopped")
+}
+
+func newTraceExporter(cfg config.Interface, logger *zap.SugaredLogger) sdktrace.SpanExporter {
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch name := cfg.StringValue(config.ConfigMapKeyTracingExporter); name {
+	case "", "none":
+		return nil
+	case "zipkin":
+		exporter, err = tracing.NewZipkinExporter(cfg.StringValue(config.ConfigMapKeyZipkinAddress))
+	case "otlp":
+		exporter, err = tracing.NewOTLPExporter(cfg.StringValue(config.ConfigMapKeyOtlpEndpoint))
+	default:
+		logger.Warnf("Unknown tracing exporter %q, tracing is disabled", name)
+		return nil
+	}
+	if err != nil {
+		logger.Warnf("Error creating the tracing exporter, tracing is disabled: %v", err)
+		return nil
+	}
+	return exporter
 }
 
 func init() {
 	flag.StringVar(&kubeconfig, "kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
 	flag.StringVar(&masterURL, "master", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
+	flag.StringVar(&namespaces, "namespaces", "", "Comma separated list of namespaces to watch. All namespaces are watched if not specified.")
+	flag.StringVar(&namespaceSelector, "namespace-selector", "", "Label selector of the namespaces to watch. Matching namespaces are resolved at startup.")
+	flag.StringVar(&secretSelector, "secret-selector", "", "Label selector of the Secrets to watch. The cellery configuration secrets should match the selector.")
+	flag.DurationVar(&reconcileTimeout, "reconcile-timeout", controller.DefaultReconcileTimeout, "Maximum duration of a single reconcile.")
+	flag.DurationVar(&apiTimeout, "api-timeout", 30*time.Second, "Maximum duration of a single Kubernetes API request made by the reconcilers.")
+	flag.IntVar(&maxRetries, "max-retries", controller.DefaultMaxRetries, "Number of retries of a failing reconcile before the object is marked as failed.")
+	flag.StringVar(&metricsAddress, "metrics-address", ":9090", "Address to serve the Prometheus metrics on. Metrics are not served if empty.")
+	flag.StringVar(&debugAddress, "debug-address", "", "Address to serve pprof and the controller debug endpoints on, e.g. 127.0.0.1:8008. "+
+		"The endpoints are not authenticated, hence only loopback addresses are accepted unless --debug-allow-remote is set. Debug endpoints are disabled if empty.")
+	flag.BoolVar(&debugAllowRemote, "debug-allow-remote", false, "Allow serving the unauthenticated debug endpoints on a non-loopback --debug-address.")
+	flag.StringVar(&configMapSelector, "configmap-selector", "", "Label selector of the ConfigMaps to watch. The cellery configuration maps should match the selector.")
 }
@@ -34,7 +34,8 @@ import (
 )
 
 const (
-	componentName = "Webhook"
+	componentName   = "Webhook"
+	systemNamespace = "cellery-system"
 )
 
 var (
@@ -67,8 +68,16 @@ func main() {
 		logger.Fatalf("Error building clients: %v", err)
 	}
 
+	// Replace the bootstrap logger with the one configured by the logging ConfigMap
+	configuredLogger, err := logging.NewLoggerFromCluster(clientset.Kubernetes(), systemNamespace, stopCh)
+	if err != nil {
+		logger.Fatalf("Error building logger from the logging config: %v", err)
+	}
+	logger = configuredLogger
+	defer logger.Sync()
+
 	opt := webhook.ServerOptions{
-		Namespace:             "cellery-system",
+		Namespace:             systemNamespace,
 		ServerSecretName:      "webhook-certs",
 		RootSecretName:        "cellery-secret",
 		ServiceName:           "webhook",
@@ -78,7 +87,7 @@ func main() {
 		Port:                  8443,
 	}
 
-	server := webhook.NewServer(clientset.Kubernetes(), opt, logger)
+	server := webhook.NewServer(clientset.Kubernetes(), clientset.Mesh(), opt, logger)
 
 	if err = server.Run(stopCh); err != nil {
 		logger.Fatalf("Failed to run the admission webhook: %v", err)
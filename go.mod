@@ -3,10 +3,18 @@ module cellery.io/cellery-controller
 go 1.12
 
 require (
-	github.com/google/go-cmp v0.3.0
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/google/go-cmp v0.6.0
 	github.com/googleapis/gnostic v0.3.1 // indirect
 	github.com/mattbaird/jsonpatch v0.0.0-20171005235357-81af80346b1a
-	github.com/pkg/errors v0.8.1 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
+	github.com/prometheus/client_golang v1.13.0
+	github.com/robfig/cron/v3 v3.0.1
+	go.opentelemetry.io/otel v1.24.0
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.24.0
+	go.opentelemetry.io/otel/exporters/zipkin v1.24.0
+	go.opentelemetry.io/otel/sdk v1.24.0
+	go.opentelemetry.io/otel/trace v1.24.0
 	go.uber.org/atomic v1.4.0 // indirect
 	go.uber.org/multierr v1.1.0 // indirect
 	go.uber.org/zap v1.9.1
@@ -15,4 +23,5 @@ require (
 	k8s.io/client-go v0.0.0-20190620085101-78d2af792bab
 	k8s.io/code-generator v0.0.0-20190612205613-18da4a14b22b
 	k8s.io/klog v0.3.3
+	sigs.k8s.io/yaml v1.1.0
 )
@@ -29,7 +29,7 @@ CODEGEN_PKG=${CODEGEN_PKG:-$(cd ${SCRIPT_ROOT}; ls -d -1 ./vendor/k8s.io/code-ge
 #                  instead of the $GOPATH directly. For normal projects this can be dropped.
 bash "${CODEGEN_PKG}"/generate-groups.sh "deepcopy,client,informer,lister" \
   cellery.io/cellery-controller/pkg/generated cellery.io/cellery-controller/pkg/apis \
-  "mesh:v1alpha2 istio/networking:v1alpha3 istio/authentication:v1alpha1 knative/serving:v1alpha1 knative/serving:v1beta1" \
+  "mesh:v1alpha2 autoscaling:v2 keda:v1alpha1 istio/networking:v1alpha3 istio/authentication:v1alpha1 istio/security:v1beta1 gatewayapi:v1,v1alpha2 k8snetworking:v1 certmanager:v1 knative/serving:v1alpha1 knative/serving:v1beta1 knative/serving:v1" \
   --go-header-file ${SCRIPT_ROOT}/hack/boilerplate.go.txt
 #  --output-base "$(dirname ${BASH_SOURCE})/../../.." \
 
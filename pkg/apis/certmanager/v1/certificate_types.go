@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Taken from: https://github.com/cert-manager/cert-manager/blob/v1.12.0/pkg/apis/certmanager/v1/types_certificate.go
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Certificate is a specification for a Certificate resource
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateSpec   `json:"spec"`
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// CertificateSpec is the spec for a Certificate resource
+type CertificateSpec struct {
+	// Name of the Secret the issued key and certificate are stored in
+	SecretName string   `json:"secretName"`
+	CommonName string   `json:"commonName,omitempty"`
+	DNSNames   []string `json:"dnsNames,omitempty"`
+	// Issuer of the certificate, e.g. an ACME issuer
+	IssuerRef ObjectReference `json:"issuerRef"`
+}
+
+// ObjectReference refers to an Issuer or a ClusterIssuer
+type ObjectReference struct {
+	Name string `json:"name"`
+	// Kind of the issuer. Defaults to Issuer.
+	Kind  string `json:"kind,omitempty"`
+	Group string `json:"group,omitempty"`
+}
+
+const (
+	IssuerKind        = "Issuer"
+	ClusterIssuerKind = "ClusterIssuer"
+)
+
+// CertificateStatus is the status for a Certificate resource
+type CertificateStatus struct {
+	Conditions  []CertificateCondition `json:"conditions,omitempty"`
+	NotAfter    *metav1.Time           `json:"notAfter,omitempty"`
+	RenewalTime *metav1.Time           `json:"renewalTime,omitempty"`
+	Revision    *int                   `json:"revision,omitempty"`
+}
+
+type CertificateConditionType string
+
+const (
+	// CertificateConditionReady is set when the certificate in the Secret is valid and up to date
+	CertificateConditionReady CertificateConditionType = "Ready"
+	// CertificateConditionIssuing is set while a certificate is being issued
+	CertificateConditionIssuing CertificateConditionType = "Issuing"
+)
+
+// CertificateCondition contains the details of a condition of a Certificate
+type CertificateCondition struct {
+	Type CertificateConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateList is a list of Certificate resources
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Certificate `json:"items"`
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Taken from: https://github.com/kubernetes-sigs/gateway-api/tree/v1.2.1/apis/v1
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GRPCRoute provides a way to route gRPC requests. This includes the capability
+// to match requests by hostname, gRPC service, gRPC method, or HTTP/2 header.
+type GRPCRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of GRPCRoute.
+	Spec GRPCRouteSpec `json:"spec,omitempty"`
+
+	// Status defines the current state of GRPCRoute.
+	// +optional
+	Status GRPCRouteStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GRPCRouteList contains a list of GRPCRoute.
+type GRPCRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCRoute `json:"items"`
+}
+
+// GRPCRouteSpec defines the desired state of GRPCRoute
+type GRPCRouteSpec struct {
+	CommonRouteSpec `json:",inline"`
+
+	// +optional
+	Hostnames []Hostname `json:"hostnames,omitempty"`
+
+	// Rules are a list of gRPC matchers, filters and actions.
+	// +optional
+	Rules []GRPCRouteRule `json:"rules,omitempty"`
+}
+
+// GRPCRouteRule defines the semantics for matching a gRPC request based on
+// conditions (matches), processing it (filters), and forwarding the request to
+// an API object (backendRefs).
+type GRPCRouteRule struct {
+	// +optional
+	Matches []GRPCRouteMatch `json:"matches,omitempty"`
+
+	// +optional
+	Filters []GRPCRouteFilter `json:"filters,omitempty"`
+
+	// +optional
+	BackendRefs []GRPCBackendRef `json:"backendRefs,omitempty"`
+}
+
+// GRPCRouteMatch defines the predicate used to match requests to a given
+// action.
+type GRPCRouteMatch struct {
+	// Method specifies a gRPC request service/method matcher. Matches all the
+	// services and methods if empty.
+	// +optional
+	Method *GRPCMethodMatch `json:"method,omitempty"`
+}
+
+// GRPCMethodMatch describes how to select a gRPC route by matching the gRPC
+// request service and/or method.
+type GRPCMethodMatch struct {
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// +optional
+	Method *string `json:"method,omitempty"`
+}
+
+// GRPCRouteFilterType identifies a type of GRPCRoute filter.
+type GRPCRouteFilterType string
+
+const (
+	GRPCRouteFilterRequestHeaderModifier GRPCRouteFilterType = "RequestHeaderModifier"
+)
+
+// GRPCRouteFilter defines processing steps that must be completed during the
+// request or response lifecycle.
+type GRPCRouteFilter struct {
+	Type GRPCRouteFilterType `json:"type"`
+
+	// +optional
+	RequestHeaderModifier *HTTPHeaderFilter `json:"requestHeaderModifier,omitempty"`
+}
+
+// GRPCBackendRef defines how a GRPCRoute forwards a gRPC request.
+type GRPCBackendRef struct {
+	BackendRef `json:",inline"`
+
+	// +optional
+	Filters []GRPCRouteFilter `json:"filters,omitempty"`
+}
+
+// GRPCRouteStatus defines the observed state of GRPCRoute.
+type GRPCRouteStatus struct {
+	RouteStatus `json:",inline"`
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Taken from: https://github.com/kubernetes-sigs/gateway-api/tree/v1.2.1/apis/v1
+
+type (
+	Group             string
+	Kind              string
+	ObjectName        string
+	Namespace         string
+	SectionName       string
+	PortNumber        int32
+	Hostname          string
+	GatewayController string
+)
+
+// ParentReference identifies an API object (usually a Gateway) that can be considered
+// a parent of this resource (usually a route).
+type ParentReference struct {
+	// Group is the group of the referent. Defaults to gateway.networking.k8s.io.
+	// +optional
+	Group *Group `json:"group,omitempty"`
+
+	// Kind is kind of the referent. Defaults to Gateway.
+	// +optional
+	Kind *Kind `json:"kind,omitempty"`
+
+	// Namespace is the namespace of the referent. Defaults to the namespace of the route.
+	// +optional
+	Namespace *Namespace `json:"namespace,omitempty"`
+
+	// Name is the name of the referent.
+	Name ObjectName `json:"name"`
+
+	// SectionName is the name of a section within the target resource, i.e. the
+	// name of a listener of a Gateway.
+	// +optional
+	SectionName *SectionName `json:"sectionName,omitempty"`
+
+	// Port is the network port the route targets, i.e. the port of the listeners
+	// of a Gateway the route is attached to.
+	// +optional
+	Port *PortNumber `json:"port,omitempty"`
+}
+
+// CommonRouteSpec defines the common attributes that all routes MUST include
+// within their spec.
+type CommonRouteSpec struct {
+	// ParentRefs references the resources (usually Gateways) that a route wants
+	// to be attached to.
+	// +optional
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+}
+
+// BackendObjectReference defines how an ObjectReference that is specific to
+// BackendRef. Refers to a Service in the namespace of the route by default.
+type BackendObjectReference struct {
+	// +optional
+	Group *Group `json:"group,omitempty"`
+
+	// +optional
+	Kind *Kind `json:"kind,omitempty"`
+
+	Name ObjectName `json:"name"`
+
+	// +optional
+	Namespace *Namespace `json:"namespace,omitempty"`
+
+	// Port specifies the destination port number to use for this resource.
+	// +optional
+	Port *PortNumber `json:"port,omitempty"`
+}
+
+// BackendRef defines how a route should forward a request to a Kubernetes
+// resource.
+type BackendRef struct {
+	BackendObjectReference `json:",inline"`
+
+	// Weight specifies the proportion of requests forwarded to the referenced
+	// backend.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// RouteStatus defines the common attributes that all routes MUST include within
+// their status.
+type RouteStatus struct {
+	// Parents is a list of parent resources (usually Gateways) that are
+	// associated with the route, and the status of the route with respect to
+	// each parent.
+	Parents []RouteParentStatus `json:"parents"`
+}
+
+// RouteParentStatus describes the status of a route with respect to an
+// associated Parent.
+type RouteParentStatus struct {
+	ParentRef ParentReference `json:"parentRef"`
+
+	// ControllerName is a domain/path string that indicates the name of the
+	// controller that wrote this status.
+	ControllerName GatewayController `json:"controllerName"`
+
+	// Conditions describes the status of the route with respect to the Gateway,
+	// e.g. Accepted and ResolvedRefs.
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// Condition to store the condition state
+type Condition struct {
+	// Type of condition
+	Type string `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// The generation of the route the condition was set based upon.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+}
+
+// HTTPHeader represents an HTTP Header name and value as defined by RFC 7230.
+type HTTPHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// HTTPHeaderFilter defines a filter that modifies the headers of an HTTP
+// request or response.
+type HTTPHeaderFilter struct {
+	// +optional
+	Set []HTTPHeader `json:"set,omitempty"`
+
+	// +optional
+	Add []HTTPHeader `json:"add,omitempty"`
+
+	// +optional
+	Remove []string `json:"remove,omitempty"`
+}
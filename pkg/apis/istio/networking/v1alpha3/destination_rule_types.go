@@ -66,9 +66,9 @@ type TrafficPolicy struct {
 	// Settings controlling the load balancer algorithms.
 	LoadBalancer *LoadBalancerSettings `json:"loadBalancer,omitempty"`
 	// Settings controlling the volume of connections to an upstream service
-	//ConnectionPool *ConnectionPoolSettings `json:"connectionPool,omitempty"`
+	ConnectionPool *ConnectionPoolSettings `json:"connectionPool,omitempty"`
 	// Settings controlling eviction of unhealthy hosts from the load balancing pool
-	//OutlierDetection *OutlierDetection `json:"outlierDetection,omitempty"`
+	OutlierDetection *OutlierDetection `json:"outlierDetection,omitempty"`
 	// TLS related settings for connections to the upstream service.
 	//Tls *TLSSettings `json:"tls,omitempty"`
 	// Traffic policies specific to individual ports. Note that port level
@@ -88,6 +88,55 @@ type LoadBalancerSettings struct {
 	Simple string `json:"simple,omitempty"`
 }
 
+// Connection pool settings for an upstream host. The settings apply to each
+// individual host in the upstream service.
+type ConnectionPoolSettings struct {
+	// Settings common to both HTTP and TCP upstream connections.
+	Tcp *ConnectionPoolSettings_TCPSettings `json:"tcp,omitempty"`
+	// HTTP connection pool settings.
+	Http *ConnectionPoolSettings_HTTPSettings `json:"http,omitempty"`
+}
+
+// Settings common to both HTTP and TCP upstream connections.
+type ConnectionPoolSettings_TCPSettings struct {
+	// Maximum number of HTTP1 /TCP connections to a destination host.
+	MaxConnections int32 `json:"maxConnections,omitempty"`
+	// TCP connection timeout.
+	ConnectTimeout string `json:"connectTimeout,omitempty"`
+}
+
+// Settings applicable to HTTP1.1/HTTP2/GRPC connections.
+type ConnectionPoolSettings_HTTPSettings struct {
+	// Maximum number of pending HTTP requests to a destination. Default 1024.
+	Http1MaxPendingRequests int32 `json:"http1MaxPendingRequests,omitempty"`
+	// Maximum number of requests to a backend. Default 1024.
+	Http2MaxRequests int32 `json:"http2MaxRequests,omitempty"`
+	// Maximum number of requests per connection to a backend. Setting this
+	// parameter to 1 disables keep alive.
+	MaxRequestsPerConnection int32 `json:"maxRequestsPerConnection,omitempty"`
+	// Maximum number of retries that can be outstanding to all hosts in a
+	// cluster at a given time. Defaults to 3.
+	MaxRetries int32 `json:"maxRetries,omitempty"`
+}
+
+// A Circuit breaker implementation that tracks the status of each
+// individual host in the upstream service.
+type OutlierDetection struct {
+	// Number of errors before a host is ejected from the connection
+	// pool. Defaults to 5.
+	ConsecutiveErrors int32 `json:"consecutiveErrors,omitempty"`
+	// Time interval between ejection sweep analysis. format:
+	// 1h/1m/1s/1ms. MUST BE >=1ms. Default is 10s.
+	Interval string `json:"interval,omitempty"`
+	// Minimum ejection duration. A host will remain ejected for a period
+	// equal to the product of minimum ejection duration and the number of
+	// times the host has been ejected. Default is 30s.
+	BaseEjectionTime string `json:"baseEjectionTime,omitempty"`
+	// Maximum % of hosts in the load balancing pool for the upstream
+	// service that can be ejected. Defaults to 10%.
+	MaxEjectionPercent int32 `json:"maxEjectionPercent,omitempty"`
+}
+
 // Traffic policies that apply to specific ports of the service
 type TrafficPolicy_PortTrafficPolicy struct {
 	// Specifies the port name or number of a port on the destination service
@@ -35,8 +35,16 @@ type EnvoyFilter struct {
 }
 
 type EnvoyFilterSpec struct {
+	// Deprecated: Removed in istio 1.5. Use WorkloadSelector instead.
 	WorkloadLabels map[string]string `json:"workloadLabels,omitempty"`
-	Filters        []Filter          `json:"filters"`
+	// Deprecated: Removed in istio 1.5. Use ConfigPatches instead.
+	Filters []Filter `json:"filters,omitempty"`
+
+	// Criteria used to select the specific set of pods/VMs on which this
+	// patch configuration should be applied.
+	WorkloadSelector *WorkloadSelector `json:"workloadSelector,omitempty"`
+	// One or more patches with match conditions.
+	ConfigPatches []EnvoyConfigObjectPatch `json:"configPatches,omitempty"`
 }
 
 type Filter struct {
@@ -109,6 +117,78 @@ type GoogleGRPC struct {
 	StatPrefix string `json:"stat_prefix"`
 }
 
+// The following types are taken from: https://github.com/istio/api/blob/1.5.0/networking/v1alpha3/envoy_filter.pb.go
+// Patch values are limited to the ext_authz http filter which is the only filter generated by the controller.
+
+type WorkloadSelector struct {
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Changes to be made to various envoy config objects.
+type EnvoyConfigObjectPatch struct {
+	// Specifies where in the Envoy configuration, the patch should be applied.
+	ApplyTo string `json:"applyTo"`
+	// Match on listener/route configuration/cluster.
+	Match *EnvoyConfigObjectMatch `json:"match,omitempty"`
+	// The patch to apply along with the operation.
+	Patch *Patch `json:"patch"`
+}
+
+// One or more match conditions to be met before a patch is applied to the generated configuration.
+type EnvoyConfigObjectMatch struct {
+	// The specific config generation context to match on. One of ANY, SIDECAR_INBOUND,
+	// SIDECAR_OUTBOUND and GATEWAY.
+	Context string `json:"context,omitempty"`
+	// Match on envoy listener attributes.
+	Listener *ListenerPatchMatch `json:"listener,omitempty"`
+}
+
+// Conditions specified in a listener match must be met for the patch to be applied to a listener.
+type ListenerPatchMatch struct {
+	// The service port/gateway port to which traffic is being sent/received.
+	PortNumber uint32 `json:"portNumber,omitempty"`
+	// Match a specific filter chain in a listener.
+	FilterChain *FilterChainMatch `json:"filterChain,omitempty"`
+}
+
+type FilterChainMatch struct {
+	// The name of a specific filter to apply the patch to.
+	Filter *FilterMatch `json:"filter,omitempty"`
+}
+
+type FilterMatch struct {
+	// The filter name to match on.
+	Name string `json:"name"`
+	// The next level filter within this filter to match on. Typically used for HTTP
+	// connection manager filters.
+	SubFilter *SubFilterMatch `json:"subFilter,omitempty"`
+}
+
+type SubFilterMatch struct {
+	// The filter name to match on.
+	Name string `json:"name"`
+}
+
+// Patch specifies how the selected object should be modified.
+type Patch struct {
+	// Determines how the patch should be applied. One of MERGE, ADD, REMOVE, INSERT_BEFORE,
+	// INSERT_AFTER and INSERT_FIRST.
+	Operation string `json:"operation"`
+	// The filter to be inserted.
+	Value *HTTPFilter `json:"value,omitempty"`
+}
+
+type HTTPFilter struct {
+	Name        string          `json:"name"`
+	TypedConfig *ExtAuthzConfig `json:"typed_config,omitempty"`
+}
+
+type ExtAuthzConfig struct {
+	Type                string      `json:"@type"`
+	TransportAPIVersion string      `json:"transport_api_version,omitempty"`
+	GRPCService         GRPCService `json:"grpc_service"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type EnvoyFilterList struct {
@@ -106,11 +106,68 @@ type HTTPRoute struct {
 	// Rewrite HTTP URIs and Authority headers. Rewrite cannot be used with
 	// Redirect primitive. Rewrite will be performed before forwarding.
 	Rewrite *HTTPRewrite `json:"rewrite,omitempty"`
+	// Timeout for HTTP requests.
+	Timeout string `json:"timeout,omitempty"`
+	// Retry policy for HTTP requests.
+	Retries *HTTPRetry `json:"retries,omitempty"`
+	// Fault injection policy to apply on HTTP traffic at the client side.
+	// Note that timeouts or retries will not be enabled when faults are
+	// enabled on the client side.
+	Fault *HTTPFaultInjection `json:"fault,omitempty"`
 	// Additional HTTP headers to add before forwarding a request to the
 	// destination service.
 	AppendHeaders map[string]string `json:"appendHeaders,omitempty"`
 }
 
+// Describes the retry policy to use when a HTTP request fails.
+type HTTPRetry struct {
+	// REQUIRED. Number of retries for a given request. The interval
+	// between retries will be determined automatically (25ms+). Actual
+	// number of retries attempted depends on the httpReqTimeout.
+	Attempts int32 `json:"attempts"`
+	// Timeout per retry attempt for a given request. format: 1h/1m/1s/1ms.
+	// MUST BE >=1ms.
+	PerTryTimeout string `json:"perTryTimeout,omitempty"`
+	// Specifies the conditions under which retry takes place.
+	// One or more policies can be specified using a ',' delimited list.
+	RetryOn string `json:"retryOn,omitempty"`
+}
+
+// HTTPFaultInjection can be used to specify one or more faults to inject
+// while forwarding http requests to the destination specified in a route.
+type HTTPFaultInjection struct {
+	// Delay requests before forwarding, emulating various failures such as
+	// network issues, overloaded upstream service, etc.
+	Delay *HTTPFaultInjection_Delay `json:"delay,omitempty"`
+	// Abort Http request attempts and return error codes back to downstream
+	// service, giving the impression that the upstream service is faulty.
+	Abort *HTTPFaultInjection_Abort `json:"abort,omitempty"`
+}
+
+// Delay specification is used to inject latency into the request
+// forwarding path.
+type HTTPFaultInjection_Delay struct {
+	// REQUIRED. Add a fixed delay before forwarding the request. Format:
+	// 1h/1m/1s/1ms. MUST be >=1ms.
+	FixedDelay string `json:"fixedDelay,omitempty"`
+	// Percentage of requests on which the delay will be injected.
+	Percentage *Percent `json:"percentage,omitempty"`
+}
+
+// Abort specification is used to prematurely abort a request with a
+// pre-specified error code.
+type HTTPFaultInjection_Abort struct {
+	// REQUIRED. HTTP status code to use to abort the Http request.
+	HttpStatus int32 `json:"httpStatus,omitempty"`
+	// Percentage of requests to be aborted with the error code provided.
+	Percentage *Percent `json:"percentage,omitempty"`
+}
+
+// Percent specifies a percentage in the range of [0.0, 100.0].
+type Percent struct {
+	Value float64 `json:"value,omitempty"`
+}
+
 type HTTPMatchRequest struct {
 	// URI to match
 	// values are case-sensitive and formatted as follows:
@@ -26,6 +26,64 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolSettings) DeepCopyInto(out *ConnectionPoolSettings) {
+	*out = *in
+	if in.Tcp != nil {
+		in, out := &in.Tcp, &out.Tcp
+		*out = new(ConnectionPoolSettings_TCPSettings)
+		**out = **in
+	}
+	if in.Http != nil {
+		in, out := &in.Http, &out.Http
+		*out = new(ConnectionPoolSettings_HTTPSettings)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolSettings.
+func (in *ConnectionPoolSettings) DeepCopy() *ConnectionPoolSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionPoolSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolSettings_HTTPSettings) DeepCopyInto(out *ConnectionPoolSettings_HTTPSettings) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolSettings_HTTPSettings.
+func (in *ConnectionPoolSettings_HTTPSettings) DeepCopy() *ConnectionPoolSettings_HTTPSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionPoolSettings_HTTPSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolSettings_TCPSettings) DeepCopyInto(out *ConnectionPoolSettings_TCPSettings) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolSettings_TCPSettings.
+func (in *ConnectionPoolSettings_TCPSettings) DeepCopy() *ConnectionPoolSettings_TCPSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionPoolSettings_TCPSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Destination) DeepCopyInto(out *Destination) {
 	*out = *in
@@ -149,6 +207,53 @@ func (in *DestinationWeight) DeepCopy() *DestinationWeight {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyConfigObjectMatch) DeepCopyInto(out *EnvoyConfigObjectMatch) {
+	*out = *in
+	if in.Listener != nil {
+		in, out := &in.Listener, &out.Listener
+		*out = new(ListenerPatchMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyConfigObjectMatch.
+func (in *EnvoyConfigObjectMatch) DeepCopy() *EnvoyConfigObjectMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyConfigObjectMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvoyConfigObjectPatch) DeepCopyInto(out *EnvoyConfigObjectPatch) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(EnvoyConfigObjectMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Patch != nil {
+		in, out := &in.Patch, &out.Patch
+		*out = new(Patch)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyConfigObjectPatch.
+func (in *EnvoyConfigObjectPatch) DeepCopy() *EnvoyConfigObjectPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvoyConfigObjectPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvoyFilter) DeepCopyInto(out *EnvoyFilter) {
 	*out = *in
@@ -226,6 +331,18 @@ func (in *EnvoyFilterSpec) DeepCopyInto(out *EnvoyFilterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WorkloadSelector != nil {
+		in, out := &in.WorkloadSelector, &out.WorkloadSelector
+		*out = new(WorkloadSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigPatches != nil {
+		in, out := &in.ConfigPatches, &out.ConfigPatches
+		*out = make([]EnvoyConfigObjectPatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -239,6 +356,23 @@ func (in *EnvoyFilterSpec) DeepCopy() *EnvoyFilterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtAuthzConfig) DeepCopyInto(out *ExtAuthzConfig) {
+	*out = *in
+	out.GRPCService = in.GRPCService
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthzConfig.
+func (in *ExtAuthzConfig) DeepCopy() *ExtAuthzConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtAuthzConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
@@ -258,6 +392,27 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterChainMatch) DeepCopyInto(out *FilterChainMatch) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(FilterMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterChainMatch.
+func (in *FilterChainMatch) DeepCopy() *FilterChainMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterChainMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilterConfig) DeepCopyInto(out *FilterConfig) {
 	*out = *in
@@ -275,6 +430,27 @@ func (in *FilterConfig) DeepCopy() *FilterConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterMatch) DeepCopyInto(out *FilterMatch) {
+	*out = *in
+	if in.SubFilter != nil {
+		in, out := &in.SubFilter, &out.SubFilter
+		*out = new(SubFilterMatch)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterMatch.
+func (in *FilterMatch) DeepCopy() *FilterMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GRPCService) DeepCopyInto(out *GRPCService) {
 	*out = *in
@@ -402,6 +578,95 @@ func (in *GoogleGRPC) DeepCopy() *GoogleGRPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPFaultInjection) DeepCopyInto(out *HTTPFaultInjection) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(HTTPFaultInjection_Delay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(HTTPFaultInjection_Abort)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPFaultInjection.
+func (in *HTTPFaultInjection) DeepCopy() *HTTPFaultInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPFaultInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPFaultInjection_Abort) DeepCopyInto(out *HTTPFaultInjection_Abort) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(Percent)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPFaultInjection_Abort.
+func (in *HTTPFaultInjection_Abort) DeepCopy() *HTTPFaultInjection_Abort {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPFaultInjection_Abort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPFaultInjection_Delay) DeepCopyInto(out *HTTPFaultInjection_Delay) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(Percent)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPFaultInjection_Delay.
+func (in *HTTPFaultInjection_Delay) DeepCopy() *HTTPFaultInjection_Delay {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPFaultInjection_Delay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPFilter) DeepCopyInto(out *HTTPFilter) {
+	*out = *in
+	if in.TypedConfig != nil {
+		in, out := &in.TypedConfig, &out.TypedConfig
+		*out = new(ExtAuthzConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPFilter.
+func (in *HTTPFilter) DeepCopy() *HTTPFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPMatchRequest) DeepCopyInto(out *HTTPMatchRequest) {
 	*out = *in
@@ -465,6 +730,22 @@ func (in *HTTPMatchRequest) DeepCopy() *HTTPMatchRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRetry) DeepCopyInto(out *HTTPRetry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetry.
+func (in *HTTPRetry) DeepCopy() *HTTPRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRewrite) DeepCopyInto(out *HTTPRewrite) {
 	*out = *in
@@ -511,6 +792,16 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 		*out = new(HTTPRewrite)
 		**out = **in
 	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(HTTPRetry)
+		**out = **in
+	}
+	if in.Fault != nil {
+		in, out := &in.Fault, &out.Fault
+		*out = new(HTTPFaultInjection)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AppendHeaders != nil {
 		in, out := &in.AppendHeaders, &out.AppendHeaders
 		*out = make(map[string]string, len(*in))
@@ -601,6 +892,27 @@ func (in *ListenerMatch) DeepCopy() *ListenerMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerPatchMatch) DeepCopyInto(out *ListenerPatchMatch) {
+	*out = *in
+	if in.FilterChain != nil {
+		in, out := &in.FilterChain, &out.FilterChain
+		*out = new(FilterChainMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerPatchMatch.
+func (in *ListenerPatchMatch) DeepCopy() *ListenerPatchMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerPatchMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerSettings) DeepCopyInto(out *LoadBalancerSettings) {
 	*out = *in
@@ -617,6 +929,59 @@ func (in *LoadBalancerSettings) DeepCopy() *LoadBalancerSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetection) DeepCopyInto(out *OutlierDetection) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetection.
+func (in *OutlierDetection) DeepCopy() *OutlierDetection {
+	if in == nil {
+		return nil
+	}
+	out := new(OutlierDetection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Patch) DeepCopyInto(out *Patch) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(HTTPFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Patch.
+func (in *Patch) DeepCopy() *Patch {
+	if in == nil {
+		return nil
+	}
+	out := new(Patch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Percent) DeepCopyInto(out *Percent) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Percent.
+func (in *Percent) DeepCopy() *Percent {
+	if in == nil {
+		return nil
+	}
+	out := new(Percent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Port) DeepCopyInto(out *Port) {
 	*out = *in
@@ -717,6 +1082,22 @@ func (in *StringMatch) DeepCopy() *StringMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubFilterMatch) DeepCopyInto(out *SubFilterMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubFilterMatch.
+func (in *SubFilterMatch) DeepCopy() *SubFilterMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(SubFilterMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPRoute) DeepCopyInto(out *TCPRoute) {
 	*out = *in
@@ -784,6 +1165,16 @@ func (in *TrafficPolicy) DeepCopyInto(out *TrafficPolicy) {
 		*out = new(LoadBalancerSettings)
 		**out = **in
 	}
+	if in.ConnectionPool != nil {
+		in, out := &in.ConnectionPool, &out.ConnectionPool
+		*out = new(ConnectionPoolSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(OutlierDetection)
+		**out = **in
+	}
 	if in.PortLevelSettings != nil {
 		in, out := &in.PortLevelSettings, &out.PortLevelSettings
 		*out = make([]*TrafficPolicy_PortTrafficPolicy, len(*in))
@@ -941,3 +1332,26 @@ func (in *VirtualServiceSpec) DeepCopy() *VirtualServiceSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadSelector) DeepCopyInto(out *WorkloadSelector) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSelector.
+func (in *WorkloadSelector) DeepCopy() *WorkloadSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadSelector)
+	in.DeepCopyInto(out)
+	return out
+}
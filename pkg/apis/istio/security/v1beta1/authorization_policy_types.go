@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Taken from: https://github.com/istio/api/blob/1.5.0/security/v1beta1/authorization.pb.go
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AuthorizationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AuthorizationPolicySpec `json:"spec"`
+}
+
+// AuthorizationPolicy enables access control on workloads.
+type AuthorizationPolicySpec struct {
+	// Optional. Workload selector decides where to apply the authorization policy.
+	// If not set, the authorization policy will be applied to all workloads in the
+	// same namespace as the authorization policy.
+	Selector *WorkloadSelector `json:"selector,omitempty"`
+	// Optional. A list of rules to match the request. A match occurs when at least
+	// one rule matches the request.
+	Rules []Rule `json:"rules,omitempty"`
+	// Optional. The action to take if the request is matched with the rules.
+	Action AuthorizationPolicyAction `json:"action,omitempty"`
+}
+
+type AuthorizationPolicyAction string
+
+const (
+	// Allow a request only if it matches the rules. This is the default type.
+	AuthorizationPolicyActionAllow AuthorizationPolicyAction = "ALLOW"
+	// Deny a request if it matches any of the rules.
+	AuthorizationPolicyActionDeny AuthorizationPolicyAction = "DENY"
+)
+
+// Rule matches requests from a list of sources that perform a list of operations subject to a
+// list of conditions. A match occurs when at least one source, operation and condition
+// matches the request. An empty rule is always matched.
+type Rule struct {
+	// Optional. from specifies the source of a request.
+	From []RuleFrom `json:"from,omitempty"`
+	// Optional. to specifies the operation of a request.
+	To []RuleTo `json:"to,omitempty"`
+	// Optional. when specifies a list of additional conditions of a request.
+	When []Condition `json:"when,omitempty"`
+}
+
+type RuleFrom struct {
+	// Source specifies the source of a request.
+	Source *Source `json:"source,omitempty"`
+}
+
+type RuleTo struct {
+	// Operation specifies the operation of a request.
+	Operation *Operation `json:"operation,omitempty"`
+}
+
+// Source specifies the source identities of a request. Fields in the source are
+// ANDed together.
+type Source struct {
+	Principals           []string `json:"principals,omitempty"`
+	NotPrincipals        []string `json:"notPrincipals,omitempty"`
+	RequestPrincipals    []string `json:"requestPrincipals,omitempty"`
+	NotRequestPrincipals []string `json:"notRequestPrincipals,omitempty"`
+	Namespaces           []string `json:"namespaces,omitempty"`
+	NotNamespaces        []string `json:"notNamespaces,omitempty"`
+	IpBlocks             []string `json:"ipBlocks,omitempty"`
+	NotIpBlocks          []string `json:"notIpBlocks,omitempty"`
+}
+
+// Operation specifies the operations of a request. Fields in the operation are
+// ANDed together.
+type Operation struct {
+	Hosts      []string `json:"hosts,omitempty"`
+	NotHosts   []string `json:"notHosts,omitempty"`
+	Ports      []string `json:"ports,omitempty"`
+	NotPorts   []string `json:"notPorts,omitempty"`
+	Methods    []string `json:"methods,omitempty"`
+	NotMethods []string `json:"notMethods,omitempty"`
+	Paths      []string `json:"paths,omitempty"`
+	NotPaths   []string `json:"notPaths,omitempty"`
+}
+
+// Condition specifies additional required attributes.
+type Condition struct {
+	// The name of an Istio attribute.
+	Key string `json:"key"`
+	// Optional. A list of allowed values for the attribute.
+	Values []string `json:"values,omitempty"`
+	// Optional. A list of negative match of values for the attribute.
+	NotValues []string `json:"notValues,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AuthorizationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []AuthorizationPolicy `json:"items"`
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Taken from: https://github.com/istio/api/blob/1.5.0/security/v1beta1/peer_authentication.pb.go
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PeerAuthentication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PeerAuthenticationSpec `json:"spec"`
+}
+
+// PeerAuthentication defines how traffic will be tunneled (or not) to the sidecar.
+type PeerAuthenticationSpec struct {
+	// The selector determines the workloads to apply the ChannelAuthentication on.
+	// If not set, the policy will be applied to all workloads in the same namespace as the policy.
+	Selector *WorkloadSelector `json:"selector,omitempty"`
+	// Mutual TLS settings for workload. If not defined, inherit from parent.
+	Mtls *PeerAuthenticationMutualTLS `json:"mtls,omitempty"`
+	// Port specific mutual TLS settings. The keys are the ports of the workload
+	// (container ports), not the service ports.
+	PortLevelMtls map[uint32]PeerAuthenticationMutualTLS `json:"portLevelMtls,omitempty"`
+}
+
+type PeerAuthenticationMutualTLS struct {
+	// Defines the mTLS mode used for peer authentication.
+	Mode PeerAuthenticationMode `json:"mode,omitempty"`
+}
+
+type PeerAuthenticationMode string
+
+const (
+	// Inherit from parent, if has one. Otherwise treated as PERMISSIVE.
+	PeerAuthenticationModeUnset PeerAuthenticationMode = "UNSET"
+	// Connection is not tunneled.
+	PeerAuthenticationModeDisable PeerAuthenticationMode = "DISABLE"
+	// Connection can be either plaintext or mTLS tunnel.
+	PeerAuthenticationModePermissive PeerAuthenticationMode = "PERMISSIVE"
+	// Connection is an mTLS tunnel (TLS with client cert must be presented).
+	PeerAuthenticationModeStrict PeerAuthenticationMode = "STRICT"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PeerAuthenticationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []PeerAuthentication `json:"items"`
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Taken from: https://github.com/istio/api/blob/1.5.0/security/v1beta1/request_authentication.pb.go
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type RequestAuthentication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec RequestAuthenticationSpec `json:"spec"`
+}
+
+// RequestAuthentication defines what request authentication methods are supported by a workload.
+// If will reject a request if the request contains invalid authentication information, based on the
+// configured authentication rules. A request that does not contain any authentication credentials
+// will be accepted but will not have any authenticated identity.
+type RequestAuthenticationSpec struct {
+	// The selector determines the workloads to apply the RequestAuthentication on.
+	// If not set, the policy will be applied to all workloads in the same namespace as the policy.
+	Selector *WorkloadSelector `json:"selector,omitempty"`
+	// Define the list of JWTs that can be validated at the selected workloads' proxy. A valid token
+	// will be used to extract the authenticated identity.
+	JwtRules []JWTRule `json:"jwtRules,omitempty"`
+}
+
+// JSON Web Token (JWT) token format for authentication as defined by
+// [RFC 7519](https://tools.ietf.org/html/rfc7519).
+type JWTRule struct {
+	// Identifies the issuer that issued the JWT.
+	Issuer string `json:"issuer,omitempty"`
+	// The list of JWT audiences that are allowed to access. A JWT containing any of these
+	// audiences will be accepted.
+	Audiences []string `json:"audiences,omitempty"`
+	// URL of the provider's public key set to validate signature of the JWT.
+	JwksUri string `json:"jwksUri,omitempty"`
+	// JSON Web Key Set of public keys to validate signature of the JWT.
+	Jwks string `json:"jwks,omitempty"`
+	// List of header locations from which JWT is expected.
+	FromHeaders []JWTHeader `json:"fromHeaders,omitempty"`
+	// List of query parameters from which JWT is expected.
+	FromParams []string `json:"fromParams,omitempty"`
+	// This field specifies the header name to output a successfully verified JWT payload to the
+	// backend.
+	OutputPayloadToHeader string `json:"outputPayloadToHeader,omitempty"`
+	// If set to true, the orginal token will be kept for the ustream request.
+	ForwardOriginalToken bool `json:"forwardOriginalToken,omitempty"`
+}
+
+// This message specifies a header location to extract JWT token.
+type JWTHeader struct {
+	// The HTTP header name.
+	Name string `json:"name"`
+	// The prefix that should be stripped before decoding the token.
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type RequestAuthenticationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []RequestAuthentication `json:"items"`
+}
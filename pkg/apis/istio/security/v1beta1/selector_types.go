@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1beta1
+
+// Taken from: https://github.com/istio/api/blob/1.5.0/type/v1beta1/selector.pb.go
+
+// WorkloadSelector specifies the criteria used to determine if a policy can be applied
+// to a proxy. The matching criteria includes the metadata associated with a proxy,
+// workload instance info such as labels attached to the pod/VM, or any other info
+// that the proxy provides to Istio during the initial handshake.
+type WorkloadSelector struct {
+	// One or more labels that indicate a specific set of pods/VMs
+	// on which a policy should be applied. The scope of label search is restricted to
+	// the configuration namespace in which the resource is present.
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+}
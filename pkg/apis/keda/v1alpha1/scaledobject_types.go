@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
+)
+
+// Taken from: https://github.com/kedacore/keda/blob/v2.10.0/apis/keda/v1alpha1/scaledobject_types.go
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ScaledObject is a specification for a ScaledObject resource
+type ScaledObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScaledObjectSpec   `json:"spec"`
+	Status ScaledObjectStatus `json:"status,omitempty"`
+}
+
+// ScaledObjectSpec is the spec for a ScaledObject resource
+type ScaledObjectSpec struct {
+	ScaleTargetRef  *ScaleTarget    `json:"scaleTargetRef"`
+	PollingInterval *int32          `json:"pollingInterval,omitempty"`
+	CooldownPeriod  *int32          `json:"cooldownPeriod,omitempty"`
+	MinReplicaCount *int32          `json:"minReplicaCount,omitempty"`
+	MaxReplicaCount *int32          `json:"maxReplicaCount,omitempty"`
+	Triggers        []ScaleTriggers `json:"triggers"`
+}
+
+// ScaleTarget holds the a reference to the scale target Object
+type ScaleTarget struct {
+	Name                   string `json:"name"`
+	APIVersion             string `json:"apiVersion,omitempty"`
+	Kind                   string `json:"kind,omitempty"`
+	EnvSourceContainerName string `json:"envSourceContainerName,omitempty"`
+}
+
+// ScaleTriggers reference the scaler that will be used
+type ScaleTriggers struct {
+	Type              string                         `json:"type"`
+	Name              string                         `json:"name,omitempty"`
+	Metadata          map[string]string              `json:"metadata"`
+	AuthenticationRef *ScaledObjectAuthRef           `json:"authenticationRef,omitempty"`
+	MetricType        autoscalingv2.MetricTargetType `json:"metricType,omitempty"`
+}
+
+// ScaledObjectAuthRef points to the TriggerAuthentication or ClusterTriggerAuthentication object that
+// is used to authenticate the scaler with the environment
+type ScaledObjectAuthRef struct {
+	Name string `json:"name"`
+	// Kind of the resource being referred to. Defaults to TriggerAuthentication.
+	Kind string `json:"kind,omitempty"`
+}
+
+// ScaledObjectStatus is the status for a ScaledObject resource
+type ScaledObjectStatus struct {
+	ScaleTargetKind      string       `json:"scaleTargetKind,omitempty"`
+	OriginalReplicaCount *int32       `json:"originalReplicaCount,omitempty"`
+	LastActiveTime       *metav1.Time `json:"lastActiveTime,omitempty"`
+	ExternalMetricNames  []string     `json:"externalMetricNames,omitempty"`
+	HpaName              string       `json:"hpaName,omitempty"`
+	Conditions           []Condition  `json:"conditions,omitempty"`
+}
+
+// Condition to store the condition state
+type Condition struct {
+	// Type of condition
+	Type string `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ScaledObjectList is a list of ScaledObject resources
+type ScaledObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []ScaledObject `json:"items"`
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Taken from: https://github.com/knative/serving/tree/release-1.0/pkg/apis/serving/v1
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Revision is an immutable snapshot of code and configuration.  A revision
+// references a container image. Revisions are created by updates to a
+// Configuration.
+//
+// See also: https://github.com/knative/serving/blob/main/docs/spec/overview.md#revision
+type Revision struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec RevisionSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status RevisionStatus `json:"status,omitempty"`
+}
+
+// RevisionTemplateSpec describes the data a revision should have when created from a template.
+// Based on: https://github.com/kubernetes/api/blob/e771f807/core/v1/types.go#L3179-L3190
+type RevisionTemplateSpec struct {
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec RevisionSpec `json:"spec,omitempty"`
+}
+
+// RevisionSpec holds the desired state of the Revision (from the client).
+type RevisionSpec struct {
+	corev1.PodSpec `json:",inline"`
+
+	// ContainerConcurrency specifies the maximum allowed in-flight (concurrent)
+	// requests per container of the Revision.  Defaults to `0` which means
+	// concurrency to the application is not limited, and the system decides the
+	// target concurrency for the autoscaler.
+	// +optional
+	ContainerConcurrency *int64 `json:"containerConcurrency,omitempty"`
+
+	// TimeoutSeconds is the maximum duration in seconds that the request routing
+	// layer will wait for a request delivered to a container to begin replying
+	// (send network traffic). If unspecified, a system default will be provided.
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// RevisionStatus communicates the observed state of the Revision (from the controller).
+type RevisionStatus struct {
+	Status `json:",inline"`
+
+	// LogURL specifies the generated logging url for this particular revision
+	// based on the revision url template specified in the controller's config.
+	// +optional
+	LogURL string `json:"logUrl,omitempty"`
+
+	// ActualReplicas reflects the amount of ready pods running this revision.
+	// +optional
+	ActualReplicas *int32 `json:"actualReplicas,omitempty"`
+
+	// DesiredReplicas reflects the desired amount of pods running this revision.
+	// +optional
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RevisionList is a list of Revision resources
+type RevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Revision `json:"items"`
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	autoscalingV2beta1 "k8s.io/api/autoscaling/v2beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AutoscaleOverride replaces the HPA replica range and metrics of a component or the gateway of a
+// running Cell or Composite instance without redeploying the instance.
+type AutoscaleOverride struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AutoscaleOverrideSpec `json:"spec"`
+}
+
+type AutoscaleOverrideSpec struct {
+	// Name of the Cell or the Composite instance
+	Instance string `json:"instance"`
+	// Name of the component in the instance. The gateway of the instance is overridden if not set.
+	Component    string `json:"component,omitempty"`
+	ReplicaRange `json:",inline"`
+	Metrics      []autoscalingV2beta1.MetricSpec `json:"metrics,omitempty"`
+}
+
+// TargetKind returns the kind of the object overridden by the AutoscaleOverride.
+func (o *AutoscaleOverride) TargetKind() string {
+	if len(o.Spec.Component) == 0 {
+		return "Gateway"
+	}
+	return "Component"
+}
+
+// TargetName returns the name of the Component or the Gateway overridden by the AutoscaleOverride.
+func (o *AutoscaleOverride) TargetName() string {
+	if len(o.Spec.Component) == 0 {
+		return o.Spec.Instance + "--gateway"
+	}
+	return o.Spec.Instance + "--" + o.Spec.Component
+}
+
+// ApplyTo replaces the replica range and the metrics of the given HPA with the ones of the override.
+// Metrics of the HPA are kept if the override does not have any.
+func (o *AutoscaleOverride) ApplyTo(hpa *HorizontalPodAutoscaler) {
+	if o.Spec.MinReplicas != nil {
+		hpa.MinReplicas = o.Spec.MinReplicas
+	}
+	if o.Spec.MaxReplicas > 0 {
+		hpa.MaxReplicas = o.Spec.MaxReplicas
+	}
+	if len(o.Spec.Metrics) > 0 {
+		hpa.Metrics = o.Spec.Metrics
+	}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type AutoscaleOverrideList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []AutoscaleOverride `json:"items"`
+}
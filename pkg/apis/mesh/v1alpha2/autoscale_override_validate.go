@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import "k8s.io/apimachinery/pkg/util/validation/field"
+
+func (o *AutoscaleOverride) Validate() field.ErrorList {
+	var allErrs field.ErrorList
+	fldPath := field.NewPath("spec")
+	if len(o.Spec.Instance) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("instance"), ""))
+	}
+	if o.Spec.MinReplicas == nil && o.Spec.MaxReplicas == 0 && len(o.Spec.Metrics) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "one of minReplicas, maxReplicas or metrics must be set"))
+	}
+	if o.Spec.MinReplicas != nil && *o.Spec.MinReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *o.Spec.MinReplicas, "must be greater than or equal to 1"))
+	}
+	if o.Spec.MinReplicas != nil && o.Spec.MaxReplicas > 0 && *o.Spec.MinReplicas > o.Spec.MaxReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *o.Spec.MinReplicas, "must be less than or equal to maxReplicas"))
+	}
+	allErrs = append(allErrs, ValidateHpaMetrics(o.Spec.Metrics, fldPath.Child("metrics"))...)
+	return allErrs
+}
@@ -56,6 +56,10 @@ type CellStatus struct {
 	TokenServiceGeneration  int64            `json:"tokenServiceGeneration,omitempty"`
 	RoutingVsGeneration     int64            `json:"routingVsGeneration,omitempty"`
 	ComponentGenerations    map[string]int64 `json:"componentGenerations,omitempty"`
+	// Name of the ControllerRevision which holds the spec observed last
+	CurrentRevision string `json:"currentRevision,omitempty"`
+	// Existing resources adopted by the cell, as kind/name
+	AdoptedResources []string `json:"adoptedResources,omitempty"`
 	// Current conditions of the cell.
 	// +patchMergeKey=type
 	// +patchStrategy=merge
@@ -70,6 +74,8 @@ const (
 	CellCurrentStatusReady CellCurrentStatus = "Ready"
 
 	CellCurrentStatusNotReady CellCurrentStatus = "NotReady"
+
+	CellCurrentStatusSuspended CellCurrentStatus = "Suspended"
 )
 
 type CellCondition struct {
@@ -81,8 +87,40 @@ type CellConditionType string
 
 const (
 	CellReady CellConditionType = "Ready"
+
+	CellReconcilePaused CellConditionType = "ReconcilePaused"
+
+	CellReconcileFailed CellConditionType = "ReconcileFailed"
+
+	CellSuspended CellConditionType = "Suspended"
 )
 
+// SetCondition adds or updates the condition of the given type and returns true if the conditions were changed.
+func (cs *CellStatus) SetCondition(t CellConditionType, status corev1.ConditionStatus) bool {
+	for i := range cs.Conditions {
+		if cs.Conditions[i].Type == t {
+			if cs.Conditions[i].Status == status {
+				return false
+			}
+			cs.Conditions[i].Status = status
+			return true
+		}
+	}
+	cs.Conditions = append(cs.Conditions, CellCondition{Type: t, Status: status})
+	return true
+}
+
+// RemoveCondition removes the condition of the given type and returns true if it was present.
+func (cs *CellStatus) RemoveCondition(t CellConditionType) bool {
+	for i := range cs.Conditions {
+		if cs.Conditions[i].Type == t {
+			cs.Conditions = append(cs.Conditions[:i], cs.Conditions[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type CellList struct {
@@ -51,7 +51,7 @@ func (cs *ComponentSpec) Default() {
 }
 
 func (sp *ComponentScalingPolicy) Default() {
-	if sp.Replicas == nil && sp.Hpa == nil && sp.Kpa == nil {
+	if sp.Replicas == nil && sp.Hpa == nil && sp.Kpa == nil && sp.EventDriven == nil {
 		sp.Replicas = ptr.Int32(1)
 	}
 	if sp.Hpa != nil && sp.Hpa.MinReplicas == nil {
@@ -61,6 +61,10 @@ func (sp *ComponentScalingPolicy) Default() {
 	if sp.Kpa != nil && sp.Kpa.MinReplicas == nil {
 		sp.Kpa.MinReplicas = ptr.Int32(0)
 	}
+
+	if sp.EventDriven != nil && sp.EventDriven.MinReplicas == nil {
+		sp.EventDriven.MinReplicas = ptr.Int32(0)
+	}
 }
 
 func (pm *PortMapping) Default() {
@@ -85,4 +89,7 @@ func (cstat *ComponentStatus) Default() {
 	if cstat.SecretGenerations == nil {
 		cstat.SecretGenerations = make(map[string]int64)
 	}
+	if cstat.TriggerAuthenticationGenerations == nil {
+		cstat.TriggerAuthenticationGenerations = make(map[string]int64)
+	}
 }
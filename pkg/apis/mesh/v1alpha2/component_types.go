@@ -22,6 +22,8 @@ import (
 	autoscalingV2beta1 "k8s.io/api/autoscaling/v2beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
 )
 
 // +genclient
@@ -43,12 +45,18 @@ type ComponentSpec struct {
 	VolumeClaims   []VolumeClaim          `json:"volumeClaims,omitempty"`
 	Configurations []corev1.ConfigMap     `json:"configurations,omitempty"`
 	Secrets        []corev1.Secret        `json:"secrets,omitempty"`
+	// Traffic policy of the connections to the service of the component
+	TrafficPolicy *TrafficPolicy `json:"trafficPolicy,omitempty"`
 }
 
 type ComponentScalingPolicy struct {
 	Replicas *int32                   `json:"replicas,omitempty"`
 	Hpa      *HorizontalPodAutoscaler `json:"hpa,omitempty"`
 	Kpa      *KnativePodAutoscaler    `json:"kpa,omitempty"`
+	// EventDriven scales the component with a KEDA ScaledObject on event sources such as queues
+	EventDriven *EventDrivenAutoscaler `json:"eventDriven,omitempty"`
+	// Schedules which override the replicas of the policy while they are active
+	Schedules []ScalingSchedule `json:"schedules,omitempty"`
 }
 
 func (sp *ComponentScalingPolicy) MinReplicas() int32 {
@@ -58,6 +66,9 @@ func (sp *ComponentScalingPolicy) MinReplicas() int32 {
 		}
 		return 1
 	}
+	if sp.IsEventDriven() && sp.EventDriven.MinReplicas != nil {
+		return *sp.EventDriven.MinReplicas
+	}
 	if sp.Replicas != nil {
 		return *sp.Replicas
 	}
@@ -72,6 +83,84 @@ func (sp *ComponentScalingPolicy) IsKpa() bool {
 	return !sp.IsHpa() && sp.Kpa != nil
 }
 
+func (sp *ComponentScalingPolicy) IsEventDriven() bool {
+	return !sp.IsHpa() && !sp.IsKpa() && sp.EventDriven != nil
+}
+
+// IsAutoscaled returns true if the replicas of the workload are managed by an HPA, either created
+// by the controller or by KEDA for an event driven policy.
+func (sp *ComponentScalingPolicy) IsAutoscaled() bool {
+	return sp.IsHpa() || sp.IsEventDriven()
+}
+
+// ApplySchedule overrides the replicas of the policy, or the replica range of the HPA or the
+// ScaledObject, with the replicas of the given schedule. Knative autoscaled policies are not scheduled.
+func (sp *ComponentScalingPolicy) ApplySchedule(s *ScalingSchedule) {
+	if sp.IsHpa() {
+		s.applyToReplicaRange(&sp.Hpa.ReplicaRange, 1)
+	} else if sp.IsEventDriven() {
+		s.applyToReplicaRange(&sp.EventDriven.ReplicaRange, 0)
+	} else if !sp.IsKpa() {
+		sp.Replicas = s.replicas(sp.Replicas)
+	}
+}
+
+// ScalingSchedule is active for the given duration from each time matching its cron schedule.
+type ScalingSchedule struct {
+	Name string `json:"name"`
+	// Standard cron expression of the start of the schedule
+	Schedule string `json:"schedule"`
+	// IANA time zone of the cron expression, UTC if not set
+	TimeZone string          `json:"timeZone,omitempty"`
+	Duration metav1.Duration `json:"duration"`
+	// Fixed replicas while the schedule is active
+	Replicas     *int32 `json:"replicas,omitempty"`
+	ReplicaRange `json:",inline"`
+}
+
+func (s *ScalingSchedule) replicas(replicas *int32) *int32 {
+	if s.Replicas != nil {
+		return s.Replicas
+	}
+	if s.MinReplicas != nil {
+		return s.MinReplicas
+	}
+	return replicas
+}
+
+// applyToReplicaRange overrides the bounds of the range with the ones of the schedule. A bound which is
+// not set by the schedule follows the other one if they cross, and both are kept at or above the given minimum.
+func (s *ScalingSchedule) applyToReplicaRange(r *ReplicaRange, minReplicas int32) {
+	if s.Replicas != nil {
+		replicas := *s.Replicas
+		r.MinReplicas = &replicas
+		r.MaxReplicas = replicas
+	} else {
+		if s.MinReplicas != nil {
+			min := *s.MinReplicas
+			r.MinReplicas = &min
+		}
+		if s.MaxReplicas > 0 {
+			r.MaxReplicas = s.MaxReplicas
+		}
+		if r.MinReplicas != nil && *r.MinReplicas > r.MaxReplicas {
+			if s.MaxReplicas > 0 {
+				min := r.MaxReplicas
+				r.MinReplicas = &min
+			} else {
+				r.MaxReplicas = *r.MinReplicas
+			}
+		}
+	}
+	if r.MinReplicas != nil && *r.MinReplicas < minReplicas {
+		min := minReplicas
+		r.MinReplicas = &min
+	}
+	if r.MaxReplicas < minReplicas {
+		r.MaxReplicas = minReplicas
+	}
+}
+
 type ReplicaRange struct {
 	MinReplicas *int32 `json:"minReplicas,omitempty"`
 	MaxReplicas int32  `json:"maxReplicas,omitempty"`
@@ -81,12 +170,51 @@ type HorizontalPodAutoscaler struct {
 	Overridable  *bool `json:"overridable"`
 	ReplicaRange `json:",inline"`
 	Metrics      []autoscalingV2beta1.MetricSpec `json:"metrics,omitempty"`
+	// Behavior configures the stabilization windows and the scale up/down policies of the HPA.
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// IsOverridable returns true unless the HPA is explicitly marked as not overridable by AutoscaleOverrides.
+func (h *HorizontalPodAutoscaler) IsOverridable() bool {
+	return h.Overridable == nil || *h.Overridable
+}
+
+// EventDrivenAutoscaler scales the component on the triggers of a KEDA ScaledObject.
+type EventDrivenAutoscaler struct {
+	ReplicaRange `json:",inline"`
+	// Interval in seconds to check each trigger
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+	// Period in seconds to wait after the last active trigger before scaling down to zero
+	CooldownPeriod *int32               `json:"cooldownPeriod,omitempty"`
+	Triggers       []EventDrivenTrigger `json:"triggers"`
+}
+
+type EventDrivenTrigger struct {
+	Name string `json:"name"`
+	// KEDA scaler of the trigger such as kafka or rabbitmq
+	Type     string            `json:"type"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Parameters of the scaler taken from the secrets of the component
+	SecretRefs []EventDrivenSecretRef `json:"secretRefs,omitempty"`
+}
+
+type EventDrivenSecretRef struct {
+	Parameter string `json:"parameter"`
+	// Name of a secret of the component
+	Secret string `json:"secret"`
+	Key    string `json:"key"`
 }
 
 type KnativePodAutoscaler struct {
 	ReplicaRange `json:",inline"`
-	Concurrency  int64             `json:"concurrency"`
-	Selector     map[string]string `json:"selector,omitempty"`
+	// Maximum number of in-flight requests per replica, unlimited if zero
+	Concurrency int64 `json:"concurrency"`
+	// Percentage of the concurrency the autoscaler targets before adding replicas
+	TargetUtilization *int32 `json:"targetUtilization,omitempty"`
+	// Period to wait after the last request before scaling down
+	ScaleDownDelay *metav1.Duration `json:"scaleDownDelay,omitempty"`
+	// Deprecated: ignored since the component is served by a serving.knative.dev/v1 Service
+	Selector map[string]string `json:"selector,omitempty"`
 }
 
 type PortMapping struct {
@@ -113,12 +241,95 @@ type ComponentStatus struct {
 	JobGeneration                    int64                  `json:"jobGeneration,omitempty"`
 	ServiceGeneration                int64                  `json:"serviceGeneration,omitempty"`
 	HpaGeneration                    int64                  `json:"hpaGeneration,omitempty"`
-	ServingConfigurationGeneration   int64                  `json:"servingConfigurationGeneration,omitempty"`
-	ServingVirtualServiceGeneration  int64                  `json:"servingVirtualServiceGeneration,omitempty"`
+	ScaledObjectGeneration           int64                  `json:"scaledObjectGeneration,omitempty"`
+	ServingServiceGeneration         int64                  `json:"servingServiceGeneration,omitempty"`
 	TlsPolicyGeneration              int64                  `json:"TlsPolicyGeneration,omitempty"`
+	PeerAuthenticationGeneration     int64                  `json:"peerAuthenticationGeneration,omitempty"`
+	DestinationRuleGeneration        int64                  `json:"destinationRuleGeneration,omitempty"`
 	PersistantVolumeClaimGenerations map[string]int64       `json:"persistantVolumeClaimGenerations,omitempty"`
 	ConfigMapGenerations             map[string]int64       `json:"configMapGenerations,omitempty"`
 	SecretGenerations                map[string]int64       `json:"secretGenerations,omitempty"`
+	TriggerAuthenticationGenerations map[string]int64       `json:"triggerAuthenticationGenerations,omitempty"`
+	// Deprecated: generations of the serving.knative.dev/v1alpha1 resources, only kept to remove them
+	ServingConfigurationGeneration  int64 `json:"servingConfigurationGeneration,omitempty"`
+	ServingVirtualServiceGeneration int64 `json:"servingVirtualServiceGeneration,omitempty"`
+	// Name of the scaling schedule currently applied to the component
+	ActiveSchedule string `json:"activeSchedule,omitempty"`
+	// AutoscaleOverride merged into the HPA of the component
+	AutoscaleOverride           string `json:"autoscaleOverride,omitempty"`
+	AutoscaleOverrideGeneration int64  `json:"autoscaleOverrideGeneration,omitempty"`
+	// Existing resources adopted by the component, as kind/name
+	AdoptedResources []string `json:"adoptedResources,omitempty"`
+	// Current conditions of the component.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []ComponentCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+type ComponentCondition struct {
+	Type    ComponentConditionType `json:"type"`
+	Status  corev1.ConditionStatus `json:"status"`
+	Reason  string                 `json:"reason,omitempty"`
+	Message string                 `json:"message,omitempty"`
+}
+
+type ComponentConditionType string
+
+const (
+	ComponentReconcilePaused ComponentConditionType = "ReconcilePaused"
+
+	ComponentReconcileFailed ComponentConditionType = "ReconcileFailed"
+
+	// Conditions reported by the Knative serving Service of a zero scaling component
+	ComponentServingReady ComponentConditionType = "ServingReady"
+
+	ComponentServingConfigurationsReady ComponentConditionType = "ServingConfigurationsReady"
+
+	ComponentServingRoutesReady ComponentConditionType = "ServingRoutesReady"
+)
+
+// SetCondition adds or updates the condition of the given type and returns true if the conditions were changed.
+func (cstat *ComponentStatus) SetCondition(t ComponentConditionType, status corev1.ConditionStatus) bool {
+	for i := range cstat.Conditions {
+		if cstat.Conditions[i].Type == t {
+			if cstat.Conditions[i].Status == status {
+				return false
+			}
+			cstat.Conditions[i].Status = status
+			return true
+		}
+	}
+	cstat.Conditions = append(cstat.Conditions, ComponentCondition{Type: t, Status: status})
+	return true
+}
+
+// SetConditionWithReason adds or updates the condition of the given type along with the reason and the
+// message of it and returns true if the conditions were changed.
+func (cstat *ComponentStatus) SetConditionWithReason(t ComponentConditionType, status corev1.ConditionStatus, reason, message string) bool {
+	for i := range cstat.Conditions {
+		if cstat.Conditions[i].Type == t {
+			if cstat.Conditions[i].Status == status && cstat.Conditions[i].Reason == reason && cstat.Conditions[i].Message == message {
+				return false
+			}
+			cstat.Conditions[i].Status = status
+			cstat.Conditions[i].Reason = reason
+			cstat.Conditions[i].Message = message
+			return true
+		}
+	}
+	cstat.Conditions = append(cstat.Conditions, ComponentCondition{Type: t, Status: status, Reason: reason, Message: message})
+	return true
+}
+
+// RemoveCondition removes the condition of the given type and returns true if it was present.
+func (cstat *ComponentStatus) RemoveCondition(t ComponentConditionType) bool {
+	for i := range cstat.Conditions {
+		if cstat.Conditions[i].Type == t {
+			cstat.Conditions = append(cstat.Conditions[:i], cstat.Conditions[i+1:]...)
+			return true
+		}
+	}
+	return false
 }
 
 func (cstat *ComponentStatus) SetType(t ComponentType) {
@@ -174,6 +385,8 @@ const (
 	ComponentCurrentStatusNotReady ComponentCurrentStatus = "NotReady"
 
 	ComponentCurrentStatusIdle ComponentCurrentStatus = "Idle"
+
+	ComponentCurrentStatusSuspended ComponentCurrentStatus = "Suspended"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
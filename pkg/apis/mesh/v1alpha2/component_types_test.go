@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestApplyScheduleToReplicaRange(t *testing.T) {
+	int32Ptr := func(i int32) *int32 { return &i }
+	tests := []struct {
+		name     string
+		base     ReplicaRange
+		schedule ScalingSchedule
+		min      int32
+		want     ReplicaRange
+	}{
+		{
+			name:     "replica range within the base range",
+			base:     ReplicaRange{MinReplicas: int32Ptr(1), MaxReplicas: 5},
+			schedule: ScalingSchedule{ReplicaRange: ReplicaRange{MinReplicas: int32Ptr(2), MaxReplicas: 4}},
+			min:      1,
+			want:     ReplicaRange{MinReplicas: int32Ptr(2), MaxReplicas: 4},
+		},
+		{
+			name:     "min replicas above the base max replicas",
+			base:     ReplicaRange{MinReplicas: int32Ptr(1), MaxReplicas: 5},
+			schedule: ScalingSchedule{ReplicaRange: ReplicaRange{MinReplicas: int32Ptr(8)}},
+			min:      1,
+			want:     ReplicaRange{MinReplicas: int32Ptr(8), MaxReplicas: 8},
+		},
+		{
+			name:     "max replicas below the base min replicas",
+			base:     ReplicaRange{MinReplicas: int32Ptr(3), MaxReplicas: 5},
+			schedule: ScalingSchedule{ReplicaRange: ReplicaRange{MaxReplicas: 2}},
+			min:      1,
+			want:     ReplicaRange{MinReplicas: int32Ptr(2), MaxReplicas: 2},
+		},
+		{
+			name:     "zero replicas for an hpa",
+			base:     ReplicaRange{MinReplicas: int32Ptr(1), MaxReplicas: 5},
+			schedule: ScalingSchedule{Replicas: int32Ptr(0)},
+			min:      1,
+			want:     ReplicaRange{MinReplicas: int32Ptr(1), MaxReplicas: 1},
+		},
+		{
+			name:     "zero replicas for a scaled object",
+			base:     ReplicaRange{MinReplicas: int32Ptr(1), MaxReplicas: 5},
+			schedule: ScalingSchedule{Replicas: int32Ptr(0)},
+			want:     ReplicaRange{MinReplicas: int32Ptr(0), MaxReplicas: 0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.base
+			test.schedule.applyToReplicaRange(&got, test.min)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected replica range (-want, +got)\n%v", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,10 @@ package v1alpha2
 
 import (
 	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	autoscalingV2beta1 "k8s.io/api/autoscaling/v2beta1"
 	//apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -39,6 +43,163 @@ func (cs *ComponentSpec) Validate(fldPath *field.Path) field.ErrorList {
 			fmt.Sprintf("must be one of '%s', '%s', '%s'", ComponentTypeDeployment, ComponentTypeJob, ComponentTypeStatefulSet)))
 	}
 	allErrs = append(allErrs, ValidatePodSpec(&cs.Template, fldPath.Child("template"))...)
+	allErrs = append(allErrs, ValidateScalingSchedules(cs.ScalingPolicy.Schedules, fldPath.Child("scalingPolicy", "schedules"))...)
+	if cs.ScalingPolicy.IsHpa() {
+		allErrs = append(allErrs, ValidateHpaScalingSchedules(cs.ScalingPolicy.Schedules, fldPath.Child("scalingPolicy", "schedules"))...)
+		allErrs = append(allErrs, ValidateHpaMetrics(cs.ScalingPolicy.Hpa.Metrics, fldPath.Child("scalingPolicy", "hpa", "metrics"))...)
+	}
+	if cs.ScalingPolicy.EventDriven != nil {
+		allErrs = append(allErrs, cs.validateEventDriven(fldPath.Child("scalingPolicy", "eventDriven"))...)
+	}
+	if cs.ScalingPolicy.IsKpa() {
+		allErrs = append(allErrs, cs.validateKpa(fldPath)...)
+	}
+	if cs.TrafficPolicy != nil {
+		allErrs = append(allErrs, cs.TrafficPolicy.Validate(fldPath.Child("trafficPolicy"))...)
+	}
+
+	return allErrs
+}
+
+// maxScaleDownDelay is the longest scale down delay accepted by the Knative autoscaler.
+const maxScaleDownDelay = time.Hour
+
+func (cs *ComponentSpec) validateKpa(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	kpa := cs.ScalingPolicy.Kpa
+	kpaPath := fldPath.Child("scalingPolicy", "kpa")
+	if cs.Type != ComponentTypeDeployment {
+		allErrs = append(allErrs, field.Forbidden(kpaPath, fmt.Sprintf("may only be set for a component of type '%s'", ComponentTypeDeployment)))
+	}
+	// A Knative revision routes the requests to a single port of a single container
+	if len(cs.Ports) > 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ports"), len(cs.Ports), "must have at most 1 port for a kpa component"))
+	}
+	if kpa.Concurrency < 0 {
+		allErrs = append(allErrs, field.Invalid(kpaPath.Child("concurrency"), kpa.Concurrency, "must be greater than or equal to 0"))
+	}
+	if kpa.MinReplicas != nil && kpa.MaxReplicas > 0 && *kpa.MinReplicas > kpa.MaxReplicas {
+		allErrs = append(allErrs, field.Invalid(kpaPath.Child("minReplicas"), *kpa.MinReplicas, "must be less than or equal to maxReplicas"))
+	}
+	if kpa.TargetUtilization != nil && (*kpa.TargetUtilization < 1 || *kpa.TargetUtilization > 100) {
+		allErrs = append(allErrs, field.Invalid(kpaPath.Child("targetUtilization"), *kpa.TargetUtilization, "must be between 1 and 100"))
+	}
+	if kpa.ScaleDownDelay != nil && (kpa.ScaleDownDelay.Duration < 0 || kpa.ScaleDownDelay.Duration > maxScaleDownDelay) {
+		allErrs = append(allErrs, field.Invalid(kpaPath.Child("scaleDownDelay"), kpa.ScaleDownDelay.Duration.String(),
+			fmt.Sprintf("must be between 0s and %s", maxScaleDownDelay)))
+	}
+	return allErrs
+}
+
+func (cs *ComponentSpec) validateEventDriven(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	ed := cs.ScalingPolicy.EventDriven
+	if cs.ScalingPolicy.Hpa != nil || cs.ScalingPolicy.Kpa != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "may not be set together with hpa or kpa"))
+	}
+	if cs.Type == ComponentTypeJob {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("may not be set for a component of type '%s'", ComponentTypeJob)))
+	}
+	if ed.MinReplicas != nil && *ed.MinReplicas < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *ed.MinReplicas, "must be greater than or equal to 0"))
+	}
+	if ed.MinReplicas != nil && ed.MaxReplicas > 0 && *ed.MinReplicas > ed.MaxReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *ed.MinReplicas, "must be less than or equal to maxReplicas"))
+	}
+	if len(ed.Triggers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("triggers"), ""))
+	}
+	secrets := make(map[string]bool)
+	for _, s := range cs.Secrets {
+		secrets[s.Name] = true
+	}
+	names := make(map[string]bool)
+	for i, t := range ed.Triggers {
+		idxPath := fldPath.Child("triggers").Index(i)
+		if len(t.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else if names[t.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), t.Name))
+		}
+		names[t.Name] = true
+		if len(t.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), ""))
+		}
+		for j, ref := range t.SecretRefs {
+			refPath := idxPath.Child("secretRefs").Index(j)
+			if len(ref.Parameter) == 0 {
+				allErrs = append(allErrs, field.Required(refPath.Child("parameter"), ""))
+			}
+			if len(ref.Key) == 0 {
+				allErrs = append(allErrs, field.Required(refPath.Child("key"), ""))
+			}
+			if len(ref.Secret) == 0 {
+				allErrs = append(allErrs, field.Required(refPath.Child("secret"), ""))
+			} else if !secrets[ref.Secret] {
+				allErrs = append(allErrs, field.NotFound(refPath.Child("secret"), ref.Secret))
+			}
+		}
+	}
+	return allErrs
+}
 
+func ValidateScalingSchedules(schedules []ScalingSchedule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	names := make(map[string]bool)
+	for i, s := range schedules {
+		idxPath := fldPath.Index(i)
+		if len(s.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else if names[s.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), s.Name))
+		}
+		names[s.Name] = true
+		if _, err := cron.ParseStandard(s.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("schedule"), s.Schedule, err.Error()))
+		}
+		if _, err := time.LoadLocation(s.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("timeZone"), s.TimeZone, err.Error()))
+		}
+		if s.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("duration"), s.Duration.String(), "must be greater than 0"))
+		}
+		if s.Replicas == nil && s.MinReplicas == nil && s.MaxReplicas == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("replicas"), "one of replicas, minReplicas or maxReplicas must be set"))
+		}
+		if s.Replicas != nil && *s.Replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("replicas"), *s.Replicas, "must be greater than or equal to 0"))
+		}
+		if s.MinReplicas != nil && s.MaxReplicas > 0 && *s.MinReplicas > s.MaxReplicas {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("minReplicas"), *s.MinReplicas, "must be less than or equal to maxReplicas"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateHpaScalingSchedules rejects the schedules which would scale an HPA below a single replica.
+func ValidateHpaScalingSchedules(schedules []ScalingSchedule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, s := range schedules {
+		idxPath := fldPath.Index(i)
+		if s.Replicas != nil && *s.Replicas < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("replicas"), *s.Replicas, "must be greater than or equal to 1 for a horizontal pod autoscaler"))
+		}
+		if s.MinReplicas != nil && *s.MinReplicas < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("minReplicas"), *s.MinReplicas, "must be greater than or equal to 1 for a horizontal pod autoscaler"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateHpaMetrics rejects the resource metrics which do not have a target, since the target of the
+// generated HPA metric cannot be derived from them.
+func ValidateHpaMetrics(metrics []autoscalingV2beta1.MetricSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, m := range metrics {
+		if m.Resource != nil && m.Resource.TargetAverageUtilization == nil && m.Resource.TargetAverageValue == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("resource"),
+				"one of targetAverageUtilization or targetAverageValue must be set"))
+		}
+	}
 	return allErrs
 }
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	"testing"
+
+	autoscalingV2beta1 "k8s.io/api/autoscaling/v2beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateHpaMetrics(t *testing.T) {
+	int32Ptr := func(i int32) *int32 { return &i }
+	quantity := resource.MustParse("100Mi")
+	resourceMetric := func(source autoscalingV2beta1.ResourceMetricSource) autoscalingV2beta1.MetricSpec {
+		return autoscalingV2beta1.MetricSpec{Type: autoscalingV2beta1.ResourceMetricSourceType, Resource: &source}
+	}
+	tests := []struct {
+		name    string
+		metrics []autoscalingV2beta1.MetricSpec
+		want    []string
+	}{
+		{
+			name: "no metrics",
+		},
+		{
+			name: "resource metric with a target utilization",
+			metrics: []autoscalingV2beta1.MetricSpec{
+				resourceMetric(autoscalingV2beta1.ResourceMetricSource{Name: corev1.ResourceCPU, TargetAverageUtilization: int32Ptr(70)}),
+			},
+		},
+		{
+			name: "resource metric with a target value",
+			metrics: []autoscalingV2beta1.MetricSpec{
+				resourceMetric(autoscalingV2beta1.ResourceMetricSource{Name: corev1.ResourceMemory, TargetAverageValue: &quantity}),
+			},
+		},
+		{
+			name: "resource metric without a target",
+			metrics: []autoscalingV2beta1.MetricSpec{
+				resourceMetric(autoscalingV2beta1.ResourceMetricSource{Name: corev1.ResourceCPU, TargetAverageUtilization: int32Ptr(70)}),
+				resourceMetric(autoscalingV2beta1.ResourceMetricSource{Name: corev1.ResourceMemory}),
+			},
+			want: []string{"metrics[1].resource"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := ValidateHpaMetrics(test.metrics, field.NewPath("metrics"))
+			if len(errs) != len(test.want) {
+				t.Fatalf("ValidateHpaMetrics() = %v, want errors of %v", errs, test.want)
+			}
+			for i, err := range errs {
+				if err.Field != test.want[i] || err.Type != field.ErrorTypeRequired {
+					t.Errorf("ValidateHpaMetrics() = %v, want a required error of %s", err, test.want[i])
+				}
+			}
+		})
+	}
+}
@@ -49,6 +49,10 @@ type CompositeStatus struct {
 	TokenServiceGeneration int64                             `json:"tokenServiceGeneration,omitempty"`
 	ComponentGenerations   map[string]int64                  `json:"componentGenerations,omitempty"`
 	RoutingVsGeneration    int64                             `json:"routingVsGeneration,omitempty"`
+	// Name of the ControllerRevision which holds the spec observed last
+	CurrentRevision string `json:"currentRevision,omitempty"`
+	// Existing resources adopted by the composite, as kind/name
+	AdoptedResources []string `json:"adoptedResources,omitempty"`
 	// Current conditions of the composite.
 	// +patchMergeKey=type
 	// +patchStrategy=merge
@@ -63,6 +67,8 @@ const (
 	CompositeCurrentStatusReady CompositeCurrentStatus = "Ready"
 
 	CompositeCurrentStatusNotReady CompositeCurrentStatus = "NotReady"
+
+	CompositeCurrentStatusSuspended CompositeCurrentStatus = "Suspended"
 )
 
 type CompositeCondition struct {
@@ -74,8 +80,40 @@ type CompositeConditionType string
 
 const (
 	CompositeReady CompositeConditionType = "Ready"
+
+	CompositeReconcilePaused CompositeConditionType = "ReconcilePaused"
+
+	CompositeReconcileFailed CompositeConditionType = "ReconcileFailed"
+
+	CompositeSuspended CompositeConditionType = "Suspended"
 )
 
+// SetCondition adds or updates the condition of the given type and returns true if the conditions were changed.
+func (cs *CompositeStatus) SetCondition(t CompositeConditionType, status corev1.ConditionStatus) bool {
+	for i := range cs.Conditions {
+		if cs.Conditions[i].Type == t {
+			if cs.Conditions[i].Status == status {
+				return false
+			}
+			cs.Conditions[i].Status = status
+			return true
+		}
+	}
+	cs.Conditions = append(cs.Conditions, CompositeCondition{Type: t, Status: status})
+	return true
+}
+
+// RemoveCondition removes the condition of the given type and returns true if it was present.
+func (cs *CompositeStatus) RemoveCondition(t CompositeConditionType) bool {
+	for i := range cs.Conditions {
+		if cs.Conditions[i].Type == t {
+			cs.Conditions = append(cs.Conditions[:i], cs.Conditions[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type CompositeList struct {
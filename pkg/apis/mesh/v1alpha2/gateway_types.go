@@ -20,8 +20,10 @@ package v1alpha2
 
 import (
 	"k8s.io/api/autoscaling/v2beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
 	"cellery.io/cellery-controller/pkg/ptr"
 )
 
@@ -47,9 +49,23 @@ type GatewaySpec struct {
 type GwScalingPolicy struct {
 	Replicas *int32                   `json:"replicas,omitempty"`
 	Hpa      *HorizontalPodAutoscaler `json:"hpa,omitempty"`
+	// Schedules which override the replicas of the policy while they are active
+	Schedules []ScalingSchedule `json:"schedules,omitempty"`
+}
+
+// ApplySchedule overrides the replicas of the policy, or the replica range of the HPA, with the
+// replicas of the given schedule.
+func (sp *GwScalingPolicy) ApplySchedule(s *ScalingSchedule) {
+	if sp.Hpa != nil {
+		s.applyToReplicaRange(&sp.Hpa.ReplicaRange, 1)
+	} else {
+		sp.Replicas = s.replicas(sp.Replicas)
+	}
 }
 
 type Ingress struct {
+	// Backend which serves the routes of the ingress. The routes are served by Istio if empty.
+	Backend           IngressBackend    `json:"backend,omitempty"`
 	IngressExtensions IngressExtensions `json:"extensions,omitempty"`
 	HTTPRoutes        []HTTPRoute       `json:"http,omitempty"`
 	GRPCRoutes        []GRPCRoute       `json:"grpc,omitempty"`
@@ -60,6 +76,21 @@ func (ing *Ingress) HasRoutes() bool {
 	return len(ing.HTTPRoutes) > 0 || len(ing.GRPCRoutes) > 0 || len(ing.TCPRoutes) > 0
 }
 
+// UsesGatewayAPI returns true if the routes are served by the Kubernetes Gateway API instead of Istio.
+func (ing *Ingress) UsesGatewayAPI() bool {
+	return ing.Backend == IngressBackendGatewayAPI
+}
+
+type IngressBackend string
+
+const (
+	// IngressBackendIstio serves the routes with an Istio Gateway and a VirtualService.
+	IngressBackendIstio IngressBackend = "Istio"
+	// IngressBackendGatewayAPI serves the routes with HTTPRoutes, GRPCRoutes and TCPRoutes which are
+	// attached to a shared Gateway of the Kubernetes Gateway API.
+	IngressBackendGatewayAPI IngressBackend = "GatewayAPI"
+)
+
 type IngressExtensions struct {
 	ApiPublisher   *ApiPublisherConfig   `json:"apiPublisher,omitempty"`
 	ClusterIngress *ClusterIngressConfig `json:"clusterIngress,omitempty"`
@@ -92,6 +123,31 @@ func (ap *ApiPublisherConfig) HasVersion() bool {
 type ClusterIngressConfig struct {
 	Host string    `json:"host,omitempty"`
 	Tls  TlsConfig `json:"tls,omitempty"`
+	// Name of the IngressClass serving the Ingress. The default IngressClass of the cluster is used if empty.
+	IngressClassName string `json:"ingressClassName,omitempty"`
+	// Hosts served by the Ingress in addition to the host
+	Hosts []ClusterIngressHost `json:"hosts,omitempty"`
+	// Annotations added to the Ingress as they are, e.g. the annotations specific to the ingress controller
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Shared Gateway of the Kubernetes Gateway API which the routes are attached to. Overrides the Gateway
+	// configured in the cellery-config.
+	ParentRef *GatewayParentRef `json:"parentRef,omitempty"`
+}
+
+type GatewayParentRef struct {
+	Name string `json:"name"`
+	// Namespace of the Gateway. Defaults to the namespace of the cell.
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the listener of the Gateway which the HTTPRoute is attached to
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+type ClusterIngressHost struct {
+	Host string `json:"host"`
+	// Path prefixes routed to the gateway. All the paths of the host are routed if empty.
+	Paths []string `json:"paths,omitempty"`
+	// Name of the secret holding the TLS certificate of the host
+	TlsSecret string `json:"tlsSecret,omitempty"`
 }
 
 func (ci *ClusterIngressConfig) HasSecret() bool {
@@ -102,12 +158,46 @@ func (ci *ClusterIngressConfig) HasCertAndKey() bool {
 	return len(ci.Tls.Key) > 0 && len(ci.Tls.Cert) > 0
 }
 
+func (ci *ClusterIngressConfig) HasAutoTls() bool {
+	return ci.Tls.AutoTls
+}
+
+// CertificateHosts returns the hosts covered by the automatic certificate, which are the hosts without a
+// TLS secret of their own.
+func (ci *ClusterIngressConfig) CertificateHosts() []string {
+	var hosts []string
+	if len(ci.Host) > 0 {
+		hosts = append(hosts, ci.Host)
+	}
+	for _, h := range ci.Hosts {
+		if len(h.Host) > 0 && len(h.TlsSecret) == 0 {
+			hosts = append(hosts, h.Host)
+		}
+	}
+	return hosts
+}
+
 type TlsConfig struct {
 	Secret string `json:"secret,omitempty"`
 	Key    string `json:"key,omitempty"`
 	Cert   string `json:"cert,omitempty"`
+	// Request a certificate for the hosts of the cluster ingress from cert-manager instead of providing one
+	AutoTls bool `json:"autoTls,omitempty"`
+	// Issuer of the automatic certificate. Defaults to the ClusterIssuer configured in the cellery-config.
+	Issuer *CertificateIssuer `json:"issuer,omitempty"`
 }
 
+type CertificateIssuer struct {
+	Name string `json:"name"`
+	// Kind of the issuer, either Issuer or ClusterIssuer. Defaults to ClusterIssuer.
+	Kind string `json:"kind,omitempty"`
+}
+
+const (
+	CertificateIssuerKindIssuer        = "Issuer"
+	CertificateIssuerKindClusterIssuer = "ClusterIssuer"
+)
+
 type OidcConfig struct {
 	ProviderUrl    string   `json:"providerUrl"`
 	ClientId       string   `json:"clientId"`
@@ -128,6 +218,9 @@ type OidcConfig struct {
 type Destination struct {
 	Host string `json:"host,omitempty"`
 	Port uint32 `json:"port,omitempty"`
+	// Traffic policy of the connections to the destination host. The routes to the same host must have the
+	// same traffic policy.
+	TrafficPolicy *TrafficPolicy `json:"trafficPolicy,omitempty"`
 }
 
 type HTTPRoute struct {
@@ -139,6 +232,10 @@ type HTTPRoute struct {
 	Port         uint32          `json:"port"`
 	Destination  Destination     `json:"destination,omitempty"`
 	ZeroScale    bool            `json:"zeroScale,omitempty"`
+	// Timeout of the requests, including the retries
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	Retries *RetryPolicy     `json:"retries,omitempty"`
+	Fault   *FaultInjection  `json:"fault,omitempty"`
 }
 
 type APIDefinition struct {
@@ -150,6 +247,10 @@ type GRPCRoute struct {
 	Port        uint32      `json:"port"`
 	Destination Destination `json:"destination,omitempty"`
 	ZeroScale   bool        `json:"zeroScale,omitempty"`
+	// Timeout of the requests, including the retries
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	Retries *RetryPolicy     `json:"retries,omitempty"`
+	Fault   *FaultInjection  `json:"fault,omitempty"`
 }
 
 type TCPRoute struct {
@@ -158,21 +259,78 @@ type TCPRoute struct {
 }
 
 type GatewayStatus struct {
-	PublisherStatus                PublisherCurrentStatus `json:"gatewayType"`
-	ServiceName                    string                 `json:"serviceName"`
-	Status                         GatewayCurrentStatus   `json:"status"`
-	AvailableReplicas              int32                  `json:"availableReplicas"`
-	ObservedGeneration             int64                  `json:"observedGeneration,omitempty"`
-	DeploymentGeneration           int64                  `json:"deploymentGeneration,omitempty"`
-	JobGeneration                  int64                  `json:"jobGeneration,omitempty"`
-	ServiceGeneration              int64                  `json:"serviceGeneration,omitempty"`
-	VirtualServiceGeneration       int64                  `json:"virtualServiceGeneration,omitempty"`
-	IstioGatewayGeneration         int64                  `json:"istioGatewayGeneration,omitempty"`
-	ClusterIngressGeneration       int64                  `json:"clusterIngressGeneration,omitempty"`
-	ClusterIngressSecretGeneration int64                  `json:"clusterIngressSecretGeneration,omitempty"`
-	OidcEnvoyFilterGeneration      int64                  `json:"oidcEnvoyFilterGeneration,omitempty"`
-	ConfigMapGeneration            int64                  `json:"configMapGeneration,omitempty"`
-	HpaGeneration                  int64                  `json:"hpaGeneration,omitempty"`
+	PublisherStatus                 PublisherCurrentStatus `json:"gatewayType"`
+	ServiceName                     string                 `json:"serviceName"`
+	Status                          GatewayCurrentStatus   `json:"status"`
+	AvailableReplicas               int32                  `json:"availableReplicas"`
+	ObservedGeneration              int64                  `json:"observedGeneration,omitempty"`
+	DeploymentGeneration            int64                  `json:"deploymentGeneration,omitempty"`
+	JobGeneration                   int64                  `json:"jobGeneration,omitempty"`
+	ServiceGeneration               int64                  `json:"serviceGeneration,omitempty"`
+	VirtualServiceGeneration        int64                  `json:"virtualServiceGeneration,omitempty"`
+	IstioGatewayGeneration          int64                  `json:"istioGatewayGeneration,omitempty"`
+	ClusterIngressGeneration        int64                  `json:"clusterIngressGeneration,omitempty"`
+	ClusterIngressSecretGeneration  int64                  `json:"clusterIngressSecretGeneration,omitempty"`
+	OidcEnvoyFilterGeneration       int64                  `json:"oidcEnvoyFilterGeneration,omitempty"`
+	RequestAuthenticationGeneration int64                  `json:"requestAuthenticationGeneration,omitempty"`
+	AuthorizationPolicyGeneration   int64                  `json:"authorizationPolicyGeneration,omitempty"`
+	ConfigMapGeneration             int64                  `json:"configMapGeneration,omitempty"`
+	HpaGeneration                   int64                  `json:"hpaGeneration,omitempty"`
+	HTTPRouteGeneration             int64                  `json:"httpRouteGeneration,omitempty"`
+	CertificateGeneration           int64                  `json:"certificateGeneration,omitempty"`
+	// Name of the scaling schedule currently applied to the gateway
+	ActiveSchedule string `json:"activeSchedule,omitempty"`
+	// AutoscaleOverride merged into the HPA of the gateway
+	AutoscaleOverride           string `json:"autoscaleOverride,omitempty"`
+	AutoscaleOverrideGeneration int64  `json:"autoscaleOverrideGeneration,omitempty"`
+	// Existing resources adopted by the gateway, as kind/name
+	AdoptedResources []string `json:"adoptedResources,omitempty"`
+	// Current conditions of the gateway.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []GatewayCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+type GatewayCondition struct {
+	Type   GatewayConditionType   `json:"type"`
+	Status corev1.ConditionStatus `json:"status"`
+}
+
+type GatewayConditionType string
+
+const (
+	GatewayReconcilePaused GatewayConditionType = "ReconcilePaused"
+
+	GatewayReconcileFailed GatewayConditionType = "ReconcileFailed"
+
+	// GatewayCertificateReady reports whether the automatic certificate of the cluster ingress is issued
+	GatewayCertificateReady GatewayConditionType = "CertificateReady"
+)
+
+// SetCondition adds or updates the condition of the given type and returns true if the conditions were changed.
+func (gs *GatewayStatus) SetCondition(t GatewayConditionType, status corev1.ConditionStatus) bool {
+	for i := range gs.Conditions {
+		if gs.Conditions[i].Type == t {
+			if gs.Conditions[i].Status == status {
+				return false
+			}
+			gs.Conditions[i].Status = status
+			return true
+		}
+	}
+	gs.Conditions = append(gs.Conditions, GatewayCondition{Type: t, Status: status})
+	return true
+}
+
+// RemoveCondition removes the condition of the given type and returns true if it was present.
+func (gs *GatewayStatus) RemoveCondition(t GatewayConditionType) bool {
+	for i := range gs.Conditions {
+		if gs.Conditions[i].Type == t {
+			gs.Conditions = append(gs.Conditions[:i], gs.Conditions[i+1:]...)
+			return true
+		}
+	}
+	return false
 }
 
 func (gs *GatewayStatus) ResetServiceName() {
@@ -238,3 +396,10 @@ func (sp *Gateway) Metrics() []v2beta1.MetricSpec {
 	}
 	return nil
 }
+
+func (sp *Gateway) Behavior() *autoscalingv2.HorizontalPodAutoscalerBehavior {
+	if &sp.Spec.ScalingPolicy != nil && sp.Spec.ScalingPolicy.Hpa != nil {
+		return sp.Spec.ScalingPolicy.Hpa.Behavior
+	}
+	return nil
+}
@@ -18,8 +18,127 @@
 
 package v1alpha2
 
-import "k8s.io/apimachinery/pkg/util/validation/field"
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
 
 func (c *Gateway) Validate() field.ErrorList {
-	return nil
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, c.Spec.Ingress.Validate(field.NewPath("spec", "ingress"))...)
+	allErrs = append(allErrs, ValidateScalingSchedules(c.Spec.ScalingPolicy.Schedules, field.NewPath("spec", "scalingPolicy", "schedules"))...)
+	if c.Spec.ScalingPolicy.Hpa != nil {
+		allErrs = append(allErrs, ValidateHpaScalingSchedules(c.Spec.ScalingPolicy.Schedules, field.NewPath("spec", "scalingPolicy", "schedules"))...)
+		allErrs = append(allErrs, ValidateHpaMetrics(c.Spec.ScalingPolicy.Hpa.Metrics, field.NewPath("spec", "scalingPolicy", "hpa", "metrics"))...)
+	}
+	return allErrs
+}
+
+func (ing *Ingress) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch ing.Backend {
+	case "", IngressBackendIstio, IngressBackendGatewayAPI:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("backend"), ing.Backend,
+			[]string{string(IngressBackendIstio), string(IngressBackendGatewayAPI)}))
+	}
+	if ing.UsesGatewayAPI() {
+		// authentication is enforced by the gateway which is not in the path of the Gateway API routes
+		for i, r := range ing.HTTPRoutes {
+			if r.Authenticate {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("http").Index(i).Child("authenticate"),
+					"authenticated routes are not supported by the GatewayAPI backend"))
+			}
+		}
+		for i, r := range ing.HTTPRoutes {
+			if r.Timeout != nil || r.Retries != nil || r.Fault != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("http").Index(i),
+					"timeout, retries and fault are not supported by the GatewayAPI backend"))
+			}
+		}
+		for i, r := range ing.GRPCRoutes {
+			if r.Timeout != nil || r.Retries != nil || r.Fault != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("grpc").Index(i),
+					"timeout, retries and fault are not supported by the GatewayAPI backend"))
+			}
+		}
+		if ing.IngressExtensions.HasOidc() {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("extensions", "oidc"),
+				"oidc is not supported by the GatewayAPI backend"))
+		}
+	}
+	if ci := ing.IngressExtensions.ClusterIngress; ci != nil && ci.ParentRef != nil && len(ci.ParentRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("extensions", "clusterIngress", "parentRef", "name"), ""))
+	}
+	if ci := ing.IngressExtensions.ClusterIngress; ci != nil {
+		allErrs = append(allErrs, ci.validateTls(ing, fldPath.Child("extensions", "clusterIngress", "tls"))...)
+	}
+	for i, r := range ing.HTTPRoutes {
+		allErrs = append(allErrs, validateRoutePolicies(r.Timeout, r.Retries, r.Fault, fldPath.Child("http").Index(i))...)
+	}
+	for i, r := range ing.GRPCRoutes {
+		allErrs = append(allErrs, validateRoutePolicies(r.Timeout, r.Retries, r.Fault, fldPath.Child("grpc").Index(i))...)
+	}
+	allErrs = append(allErrs, ing.validateTrafficPolicies(fldPath)...)
+	return allErrs
+}
+
+// validateTrafficPolicies validates the traffic policies of the route destinations. Since a single
+// DestinationRule is created for each host, the routes to the same host can not have different policies.
+func (ing *Ingress) validateTrafficPolicies(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	policies := make(map[string]*TrafficPolicy)
+	validate := func(destination Destination, destinationPath *field.Path) {
+		if destination.TrafficPolicy == nil {
+			return
+		}
+		policyPath := destinationPath.Child("trafficPolicy")
+		allErrs = append(allErrs, destination.TrafficPolicy.Validate(policyPath)...)
+		if p, ok := policies[destination.Host]; ok && !equality.Semantic.DeepEqual(p, destination.TrafficPolicy) {
+			allErrs = append(allErrs, field.Invalid(policyPath, destination.Host,
+				"the routes to the same host must have the same traffic policy"))
+		}
+		policies[destination.Host] = destination.TrafficPolicy
+	}
+	for i, r := range ing.HTTPRoutes {
+		validate(r.Destination, fldPath.Child("http").Index(i).Child("destination"))
+	}
+	for i, r := range ing.GRPCRoutes {
+		validate(r.Destination, fldPath.Child("grpc").Index(i).Child("destination"))
+	}
+	for i, r := range ing.TCPRoutes {
+		validate(r.Destination, fldPath.Child("tcp").Index(i).Child("destination"))
+	}
+	return allErrs
+}
+
+func (ci *ClusterIngressConfig) validateTls(ing *Ingress, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if issuer := ci.Tls.Issuer; issuer != nil {
+		if !ci.HasAutoTls() {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("issuer"), "issuer is only used with autoTls"))
+		}
+		if len(issuer.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("issuer", "name"), ""))
+		}
+		switch issuer.Kind {
+		case "", CertificateIssuerKindIssuer, CertificateIssuerKindClusterIssuer:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("issuer", "kind"), issuer.Kind,
+				[]string{CertificateIssuerKindIssuer, CertificateIssuerKindClusterIssuer}))
+		}
+	}
+	if !ci.HasAutoTls() {
+		return allErrs
+	}
+	if ci.HasSecret() || len(ci.Tls.Key) > 0 || len(ci.Tls.Cert) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoTls"), "autoTls can not be combined with a secret or a key and cert"))
+	}
+	if ing.UsesGatewayAPI() {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoTls"), "TLS is terminated by the parent Gateway with the GatewayAPI backend"))
+	}
+	if len(ci.CertificateHosts()) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("autoTls"), "a certificate can only be requested for a host of the cluster ingress"))
+	}
+	return allErrs
 }
@@ -57,6 +57,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TokenServiceList{},
 		&Component{},
 		&ComponentList{},
+		&AutoscaleOverride{},
+		&AutoscaleOverrideList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
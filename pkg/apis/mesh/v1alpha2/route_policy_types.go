@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RetryPolicy retries the failed requests of a route.
+type RetryPolicy struct {
+	// Number of retries of a request
+	Attempts int32 `json:"attempts"`
+	// Timeout of each attempt
+	PerTryTimeout *metav1.Duration `json:"perTryTimeout,omitempty"`
+	// Comma separated conditions of the failures which are retried, e.g. 5xx,connect-failure
+	RetryOn string `json:"retryOn,omitempty"`
+}
+
+// FaultInjection delays or aborts a percentage of the requests of a route. It is meant for chaos testing in
+// non-production environments.
+type FaultInjection struct {
+	Delay *FaultDelay `json:"delay,omitempty"`
+	Abort *FaultAbort `json:"abort,omitempty"`
+}
+
+type FaultDelay struct {
+	// Percentage of the requests which are delayed
+	Percent    int32           `json:"percent"`
+	FixedDelay metav1.Duration `json:"fixedDelay"`
+}
+
+type FaultAbort struct {
+	// Percentage of the requests which are aborted
+	Percent    int32 `json:"percent"`
+	HttpStatus int32 `json:"httpStatus"`
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// retryOnConditions are the Envoy retry conditions which can be used in the retryOn of a retry policy.
+// See https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/router_filter#x-envoy-retry-on
+var retryOnConditions = map[string]bool{
+	"5xx":                    true,
+	"gateway-error":          true,
+	"reset":                  true,
+	"connect-failure":        true,
+	"retriable-4xx":          true,
+	"refused-stream":         true,
+	"retriable-status-codes": true,
+	"retriable-headers":      true,
+	"envoy-ratelimited":      true,
+	"cancelled":              true,
+	"deadline-exceeded":      true,
+	"internal":               true,
+	"resource-exhausted":     true,
+	"unavailable":            true,
+}
+
+// validateRoutePolicies validates the timeout, the retry policy and the fault injection of a route.
+func validateRoutePolicies(timeout *metav1.Duration, retries *RetryPolicy, fault *FaultInjection, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validatePositiveDuration(timeout, fldPath.Child("timeout"))...)
+	if retries != nil {
+		allErrs = append(allErrs, retries.Validate(fldPath.Child("retries"))...)
+	}
+	if fault != nil {
+		allErrs = append(allErrs, fault.Validate(fldPath.Child("fault"))...)
+	}
+	return allErrs
+}
+
+func (rp *RetryPolicy) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateNonNegative(rp.Attempts, fldPath.Child("attempts"))...)
+	allErrs = append(allErrs, validatePositiveDuration(rp.PerTryTimeout, fldPath.Child("perTryTimeout"))...)
+	if len(rp.RetryOn) > 0 {
+		for _, c := range strings.Split(rp.RetryOn, ",") {
+			c = strings.TrimSpace(c)
+			if retryOnConditions[c] {
+				continue
+			}
+			// Istio accepts the HTTP status codes which are retried in addition to the conditions
+			if code, err := strconv.Atoi(c); err == nil && code >= 100 && code <= 599 {
+				continue
+			}
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("retryOn"), c, "must be a supported retry condition or an HTTP status code"))
+		}
+	}
+	return allErrs
+}
+
+func (fi *FaultInjection) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if fi.Delay == nil && fi.Abort == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "either delay or abort must be set"))
+	}
+	if d := fi.Delay; d != nil {
+		delayPath := fldPath.Child("delay")
+		allErrs = append(allErrs, validatePercent(d.Percent, delayPath.Child("percent"))...)
+		if d.FixedDelay.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(delayPath.Child("fixedDelay"), d.FixedDelay.String(), "must be greater than 0"))
+		}
+	}
+	if a := fi.Abort; a != nil {
+		abortPath := fldPath.Child("abort")
+		allErrs = append(allErrs, validatePercent(a.Percent, abortPath.Child("percent"))...)
+		if a.HttpStatus < 200 || a.HttpStatus > 599 {
+			allErrs = append(allErrs, field.Invalid(abortPath.Child("httpStatus"), a.HttpStatus, "must be between 200 and 599"))
+		}
+	}
+	return allErrs
+}
+
+func validatePercent(value int32, fldPath *field.Path) field.ErrorList {
+	if value < 0 || value > 100 {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be between 0 and 100")}
+	}
+	return nil
+}
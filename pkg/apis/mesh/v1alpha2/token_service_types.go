@@ -19,6 +19,7 @@
 package v1alpha2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -61,6 +62,51 @@ type TokenServiceStatus struct {
 	ConfigMapGeneration    int64                     `json:"configMapGeneration,omitempty"`
 	OpaConfigMapGeneration int64                     `json:"opaConfigMapGeneration,omitempty"`
 	EnvoyFilterGeneration  int64                     `json:"envoyFilterGeneration,omitempty"`
+	// Existing resources adopted by the token service, as kind/name
+	AdoptedResources []string `json:"adoptedResources,omitempty"`
+	// Current conditions of the token service.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []TokenServiceCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+type TokenServiceCondition struct {
+	Type   TokenServiceConditionType `json:"type"`
+	Status corev1.ConditionStatus    `json:"status"`
+}
+
+type TokenServiceConditionType string
+
+const (
+	TokenServiceReconcilePaused TokenServiceConditionType = "ReconcilePaused"
+
+	TokenServiceReconcileFailed TokenServiceConditionType = "ReconcileFailed"
+)
+
+// SetCondition adds or updates the condition of the given type and returns true if the conditions were changed.
+func (ts *TokenServiceStatus) SetCondition(t TokenServiceConditionType, status corev1.ConditionStatus) bool {
+	for i := range ts.Conditions {
+		if ts.Conditions[i].Type == t {
+			if ts.Conditions[i].Status == status {
+				return false
+			}
+			ts.Conditions[i].Status = status
+			return true
+		}
+	}
+	ts.Conditions = append(ts.Conditions, TokenServiceCondition{Type: t, Status: status})
+	return true
+}
+
+// RemoveCondition removes the condition of the given type and returns true if it was present.
+func (ts *TokenServiceStatus) RemoveCondition(t TokenServiceConditionType) bool {
+	for i := range ts.Conditions {
+		if ts.Conditions[i].Type == t {
+			ts.Conditions = append(ts.Conditions[:i], ts.Conditions[i+1:]...)
+			return true
+		}
+	}
+	return false
 }
 
 type InterceptMode string
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrafficPolicy configures the connections to a destination. It is reconciled into an Istio DestinationRule
+// which applies to all the clients of the destination in the mesh.
+type TrafficPolicy struct {
+	// Load balancing algorithm, one of ROUND_ROBIN, LEAST_CONN, RANDOM or PASSTHROUGH
+	LoadBalancer     LoadBalancerType  `json:"loadBalancer,omitempty"`
+	ConnectionPool   *ConnectionPool   `json:"connectionPool,omitempty"`
+	OutlierDetection *OutlierDetection `json:"outlierDetection,omitempty"`
+}
+
+type LoadBalancerType string
+
+const (
+	LoadBalancerRoundRobin  LoadBalancerType = "ROUND_ROBIN"
+	LoadBalancerLeastConn   LoadBalancerType = "LEAST_CONN"
+	LoadBalancerRandom      LoadBalancerType = "RANDOM"
+	LoadBalancerPassthrough LoadBalancerType = "PASSTHROUGH"
+)
+
+// ConnectionPool limits the connections and the requests to each host of the destination.
+type ConnectionPool struct {
+	// Maximum number of connections to a host
+	MaxConnections int32            `json:"maxConnections,omitempty"`
+	ConnectTimeout *metav1.Duration `json:"connectTimeout,omitempty"`
+	// Maximum number of requests waiting for a connection
+	MaxPendingRequests int32 `json:"maxPendingRequests,omitempty"`
+	// Maximum number of concurrent requests to a host
+	MaxRequests              int32 `json:"maxRequests,omitempty"`
+	MaxRequestsPerConnection int32 `json:"maxRequestsPerConnection,omitempty"`
+	// Maximum number of concurrent retries to all the hosts
+	MaxRetries int32 `json:"maxRetries,omitempty"`
+}
+
+// OutlierDetection ejects the hosts of the destination which keep failing from the load balancing pool.
+type OutlierDetection struct {
+	// Number of consecutive errors before a host is ejected
+	ConsecutiveErrors int32 `json:"consecutiveErrors,omitempty"`
+	// Interval between the analyses of the hosts
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Minimum duration a host is ejected for
+	BaseEjectionTime *metav1.Duration `json:"baseEjectionTime,omitempty"`
+	// Maximum percentage of the hosts which can be ejected
+	MaxEjectionPercent int32 `json:"maxEjectionPercent,omitempty"`
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (tp *TrafficPolicy) Validate(fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch tp.LoadBalancer {
+	case "", LoadBalancerRoundRobin, LoadBalancerLeastConn, LoadBalancerRandom, LoadBalancerPassthrough:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("loadBalancer"), tp.LoadBalancer, []string{
+			string(LoadBalancerRoundRobin), string(LoadBalancerLeastConn), string(LoadBalancerRandom), string(LoadBalancerPassthrough),
+		}))
+	}
+	if cp := tp.ConnectionPool; cp != nil {
+		cpPath := fldPath.Child("connectionPool")
+		allErrs = append(allErrs, validateNonNegative(cp.MaxConnections, cpPath.Child("maxConnections"))...)
+		allErrs = append(allErrs, validatePositiveDuration(cp.ConnectTimeout, cpPath.Child("connectTimeout"))...)
+		allErrs = append(allErrs, validateNonNegative(cp.MaxPendingRequests, cpPath.Child("maxPendingRequests"))...)
+		allErrs = append(allErrs, validateNonNegative(cp.MaxRequests, cpPath.Child("maxRequests"))...)
+		allErrs = append(allErrs, validateNonNegative(cp.MaxRequestsPerConnection, cpPath.Child("maxRequestsPerConnection"))...)
+		allErrs = append(allErrs, validateNonNegative(cp.MaxRetries, cpPath.Child("maxRetries"))...)
+	}
+	if od := tp.OutlierDetection; od != nil {
+		odPath := fldPath.Child("outlierDetection")
+		allErrs = append(allErrs, validateNonNegative(od.ConsecutiveErrors, odPath.Child("consecutiveErrors"))...)
+		allErrs = append(allErrs, validatePositiveDuration(od.Interval, odPath.Child("interval"))...)
+		allErrs = append(allErrs, validatePositiveDuration(od.BaseEjectionTime, odPath.Child("baseEjectionTime"))...)
+		allErrs = append(allErrs, validatePercent(od.MaxEjectionPercent, odPath.Child("maxEjectionPercent"))...)
+	}
+	return allErrs
+}
+
+func validateNonNegative(value int32, fldPath *field.Path) field.ErrorList {
+	if value < 0 {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be greater than or equal to 0")}
+	}
+	return nil
+}
+
+func validatePositiveDuration(d *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	if d != nil && d.Duration <= 0 {
+		return field.ErrorList{field.Invalid(fldPath, d.String(), "must be greater than 0")}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package clients
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	ingressV1GroupVersion            = "networking.k8s.io/v1"
+	gatewayAPIV1GroupVersion         = "gateway.networking.k8s.io/v1"
+	gatewayAPIV1alpha2GroupVersion   = "gateway.networking.k8s.io/v1alpha2"
+	certManagerV1GroupVersion        = "cert-manager.io/v1"
+	kedaV1alpha1GroupVersion         = "keda.sh/v1alpha1"
+	istioSecurityV1beta1GroupVersion = "security.istio.io/v1beta1"
+	istioAuthnV1alpha1GroupVersion   = "authentication.istio.io/v1alpha1"
+)
+
+// IngressV1Available reports whether the cluster serves the networking.k8s.io/v1 Ingress API which replaced
+// the extensions/v1beta1 API in Kubernetes 1.19. Since the v1 API is served by all the recent clusters, it is
+// only reported as unavailable if the discovery confirms that it is missing.
+func IngressV1Available(c Interface) bool {
+	resources, err := c.Kubernetes().Discovery().ServerResourcesForGroupVersion(ingressV1GroupVersion)
+	if errors.IsNotFound(err) {
+		return false
+	} else if err != nil {
+		return true
+	}
+	for _, r := range resources.APIResources {
+		if r.Name == "ingresses" {
+			return true
+		}
+	}
+	return false
+}
+
+// GatewayAPIRoutes reports the routes of the Kubernetes Gateway API served by the cluster.
+type GatewayAPIRoutes struct {
+	HTTPRoutes bool
+	GRPCRoutes bool
+	TCPRoutes  bool
+}
+
+// ServedGatewayAPIRoutes discovers the routes of the Kubernetes Gateway API served by the cluster. Unlike the
+// Ingress API, the Gateway API is installed with CRDs, hence a route is only reported as served if the discovery
+// confirms it. TCPRoutes are only served by the experimental channel of the Gateway API.
+func ServedGatewayAPIRoutes(c Interface) GatewayAPIRoutes {
+	v1 := servedResources(c, gatewayAPIV1GroupVersion)
+	v1alpha2 := servedResources(c, gatewayAPIV1alpha2GroupVersion)
+	return GatewayAPIRoutes{
+		HTTPRoutes: v1["httproutes"],
+		GRPCRoutes: v1["grpcroutes"],
+		TCPRoutes:  v1alpha2["tcproutes"],
+	}
+}
+
+// CertificatesAvailable reports whether cert-manager is installed in the cluster and serves the
+// cert-manager.io/v1 Certificates.
+func CertificatesAvailable(c Interface) bool {
+	return servedResources(c, certManagerV1GroupVersion)["certificates"]
+}
+
+// KedaAvailable reports whether KEDA is installed in the cluster and serves the keda.sh/v1alpha1 ScaledObjects
+// and TriggerAuthentications.
+func KedaAvailable(c Interface) bool {
+	served := servedResources(c, kedaV1alpha1GroupVersion)
+	return served["scaledobjects"] && served["triggerauthentications"]
+}
+
+// IstioSecurityAvailable reports whether the cluster serves the security.istio.io/v1beta1 PeerAuthentications,
+// RequestAuthentications and AuthorizationPolicies which were introduced in istio 1.5.
+func IstioSecurityAvailable(c Interface) bool {
+	served := servedResources(c, istioSecurityV1beta1GroupVersion)
+	return served["peerauthentications"] && served["requestauthentications"] && served["authorizationpolicies"]
+}
+
+// IstioAuthenticationAvailable reports whether the cluster serves the authentication.istio.io/v1alpha1 Policies
+// which were removed in istio 1.6.
+func IstioAuthenticationAvailable(c Interface) bool {
+	return servedResources(c, istioAuthnV1alpha1GroupVersion)["policies"]
+}
+
+func servedResources(c Interface, groupVersion string) map[string]bool {
+	served := make(map[string]bool)
+	resources, err := c.Kubernetes().Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		return served
+	}
+	for _, r := range resources.APIResources {
+		served[r.Name] = true
+	}
+	return served
+}
@@ -20,68 +20,128 @@ package fake
 
 import (
 	"fmt"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 
 	clientgotesting "k8s.io/client-go/testing"
 
 	"cellery.io/cellery-controller/pkg/clients"
 	meshfake "cellery.io/cellery-controller/pkg/generated/clientset/versioned/fake"
+	meshscheme "cellery.io/cellery-controller/pkg/generated/clientset/versioned/scheme"
 )
 
-type fake struct {
+// Clients is a clients.Interface backed by fake clientsets which records the actions performed
+// on them.
+type Clients struct {
 	clients.Interface
 	fakeKubeClient *kubefake.Clientset
 	fakeMeshClient *meshfake.Clientset
 	createActions  []clientgotesting.CreateAction
 	updatesActions []clientgotesting.UpdateAction
+	deleteActions  []clientgotesting.DeleteAction
 }
 
-func New(objs ...runtime.Object) *fake {
-	ks := kubefake.NewSimpleClientset()
+// New creates fake clients seeded with the given objects. The objects of the mesh clientset
+// (cellery, istio and knative types) are added to the fake mesh client and the rest to the fake
+// kubernetes client.
+func New(objs ...runtime.Object) *Clients {
+	var kubeObjs []runtime.Object
 	ms := meshfake.NewSimpleClientset()
-	return &fake{
+	for _, obj := range objs {
+		gvks, _, err := meshscheme.Scheme.ObjectKinds(obj)
+		if err != nil {
+			kubeObjs = append(kubeObjs, obj)
+			continue
+		}
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			panic(err)
+		}
+		if err := ms.Tracker().Create(resourceFor(gvks[0]), obj, accessor.GetNamespace()); err != nil {
+			panic(err)
+		}
+	}
+	ks := kubefake.NewSimpleClientset(kubeObjs...)
+	return &Clients{
 		Interface:      clients.NewFromClients(ks, ms),
 		fakeKubeClient: ks,
 		fakeMeshClient: ms,
 	}
 }
 
-func (f *fake) FakeKubernetesClient() *kubefake.Clientset {
+// resourceFor returns the resource of the kind. The tracker of the fake clientsets guesses the
+// resources with meta.UnsafeGuessKindToResource which pluralizes kinds such as Gateway as
+// "gatewaies", hence the mesh objects are added with the resource returned from here.
+func resourceFor(gvk schema.GroupVersionKind) schema.GroupVersionResource {
+	gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+	if strings.HasSuffix(gvr.Resource, "aies") {
+		gvr.Resource = strings.TrimSuffix(gvr.Resource, "ies") + "ys"
+	}
+	return gvr
+}
+
+func (f *Clients) FakeKubernetesClient() *kubefake.Clientset {
 	return f.fakeKubeClient
 }
 
-func (f *fake) FakeMeshClient() *meshfake.Clientset {
+func (f *Clients) FakeMeshClient() *meshfake.Clientset {
 	return f.fakeMeshClient
 }
 
-func (f *fake) ProcessActions() {
+// Serve makes the fake discovery report the resources of the group version as served by the cluster.
+func (f *Clients) Serve(groupVersion string, resources ...string) {
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, r := range resources {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: r})
+	}
+	f.fakeKubeClient.Resources = append(f.fakeKubeClient.Resources, list)
+}
+
+// PrependReactor adds the reactor to both the fake kubernetes and mesh clients.
+func (f *Clients) PrependReactor(verb, resource string, reaction clientgotesting.ReactionFunc) {
+	f.fakeKubeClient.PrependReactor(verb, resource, reaction)
+	f.fakeMeshClient.PrependReactor(verb, resource, reaction)
+}
+
+// ProcessActions sorts the recorded mutating actions by their type. Read only actions are ignored.
+func (f *Clients) ProcessActions() {
 	var actions []clientgotesting.Action
 	actions = append(actions, f.fakeMeshClient.Actions()...)
 	actions = append(actions, f.fakeKubeClient.Actions()...)
 
 	for _, action := range actions {
-		switch action.(type) {
-		case clientgotesting.CreateAction:
+		switch action.GetVerb() {
+		case "create":
 			f.createActions = append(f.createActions, action.(clientgotesting.CreateAction))
-		case clientgotesting.UpdateAction:
+		case "update":
 			f.updatesActions = append(f.updatesActions, action.(clientgotesting.UpdateAction))
+		case "delete":
+			f.deleteActions = append(f.deleteActions, action.(clientgotesting.DeleteAction))
+		case "get", "list", "watch":
 		default:
 			panic(fmt.Sprintf("unknown action type %+v", action))
 		}
 	}
 }
 
-func (f *fake) ClearActions() {
+func (f *Clients) ClearActions() {
 	f.fakeKubeClient.ClearActions()
 	f.fakeMeshClient.ClearActions()
 }
 
-func (f *fake) GetCreateActions() []clientgotesting.CreateAction {
+func (f *Clients) GetCreateActions() []clientgotesting.CreateAction {
 	return f.createActions
 }
 
-func (f *fake) GetUpdateActions() []clientgotesting.UpdateAction {
+func (f *Clients) GetUpdateActions() []clientgotesting.UpdateAction {
 	return f.updatesActions
 }
+
+func (f *Clients) GetDeleteActions() []clientgotesting.DeleteAction {
+	return f.deleteActions
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package cloudevents publishes the lifecycle events of the cellery resources to an HTTP sink as
+// structured CloudEvents (https://github.com/cloudevents/spec/blob/v1.0/spec.md).
+package cloudevents
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	SpecVersion = "1.0"
+	Source      = "cellery.io/cellery-controller"
+
+	TypeCellCreated         = "io.cellery.cell.created"
+	TypeCellReady           = "io.cellery.cell.ready"
+	TypeCellNotReady        = "io.cellery.cell.notready"
+	TypeCellReconcileFailed = "io.cellery.cell.reconcile.failed"
+	TypeCellDeleted         = "io.cellery.cell.deleted"
+	TypeComponentScaled     = "io.cellery.component.scaled"
+	TypeGatewayPublished    = "io.cellery.gateway.published"
+)
+
+// Event is a CloudEvent in the structured JSON format.
+type Event struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype,omitempty"`
+	Data            Data      `json:"data"`
+}
+
+// Data describes the resource the event is about.
+type Data struct {
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	Generation int64  `json:"generation,omitempty"`
+	// Reason is a machine readable explanation of the event, e.g. why a cell is not ready
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Replicas is the number of available replicas of a scaled component
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// NewEvent creates an event of the given type about the object.
+func NewEvent(eventType string, kind string, obj metav1.Object) Event {
+	return Event{
+		SpecVersion:     SpecVersion,
+		ID:              newID(),
+		Source:          Source,
+		Type:            eventType,
+		Subject:         fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName()),
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data: Data{
+			Kind:       kind,
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+			Generation: obj.GetGeneration(),
+		},
+	}
+}
+
+// WithReason sets the reason and the message of the event.
+func (e Event) WithReason(reason, message string) Event {
+	e.Data.Reason = reason
+	e.Data.Message = message
+	return e
+}
+
+// WithReplicas sets the number of replicas of the event.
+func (e Event) WithReplicas(replicas int32) Event {
+	e.Data.Replicas = &replicas
+	return e
+}
+
+// newID returns a random (version 4) UUID.
+func newID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
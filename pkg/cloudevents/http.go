@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// Sender delivers a single event to the sink.
+type Sender interface {
+	Send(ctx context.Context, event Event) error
+}
+
+type httpSender struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSender creates a sender which posts the events to the sink URL in the structured
+// content mode of the CloudEvents HTTP binding.
+func NewHTTPSender(url string) Sender {
+	return &httpSender{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// statusError is returned when the sink responds with an unsuccessful status.
+type statusError struct {
+	url    string
+	status string
+	code   int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("unexpected response from %s: %s", e.url, e.status)
+}
+
+// retriable returns false for the errors which are not resolved by sending the event again, i.e.
+// the client errors other than throttling.
+func retriable(err error) bool {
+	if e, ok := err.(*statusError); ok {
+		return e.code >= 500 || e.code == http.StatusTooManyRequests || e.code == http.StatusRequestTimeout
+	}
+	return true
+}
+
+func (s *httpSender) Send(ctx context.Context, event Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json; charset=UTF-8")
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &statusError{url: s.url, status: resp.Status, code: resp.StatusCode}
+	}
+	return nil
+}
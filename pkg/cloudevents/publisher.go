@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cloudevents
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultBufferSize is the number of events buffered when the size is not configured.
+	DefaultBufferSize = 1000
+
+	maxRetries  = 5
+	sendTimeout = 10 * time.Second
+)
+
+// retryBackoff is the delay before the first retry of an event. The delay is doubled for each retry.
+var retryBackoff = 500 * time.Millisecond
+
+// Interface publishes the lifecycle events of the reconciled objects.
+type Interface interface {
+	Publish(event Event)
+}
+
+// Publisher sends the events to the sink in the background, in the order they are published.
+// Failed sends are retried with an exponential backoff. Events are dropped if the buffer is full,
+// so that publishing never blocks a reconcile.
+type Publisher struct {
+	sender Sender
+	logger *zap.SugaredLogger
+	queue  chan Event
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// NewPublisher creates a publisher which buffers up to bufferSize events and starts the send loop.
+func NewPublisher(sender Sender, bufferSize int, logger *zap.SugaredLogger) *Publisher {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	p := &Publisher{
+		sender: sender,
+		logger: logger,
+		queue:  make(chan Event, bufferSize),
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+// Publish adds the event to the buffer.
+func (p *Publisher) Publish(event Event) {
+	select {
+	case p.queue <- event:
+	default:
+		p.logger.Warnf("Dropping event %s of %s since the event buffer is full", event.Type, event.Subject)
+	}
+}
+
+// Shutdown sends the buffered events and stops the send loop. Events which cannot be sent before
+// the context is done are dropped.
+func (p *Publisher) Shutdown(ctx context.Context) error {
+	close(p.stopCh)
+	select {
+	case <-p.doneCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Deferred forwards the events to a target which is set after the controllers are created, since
+// the sink is only known once the configuration is loaded. Events are dropped while the target is unset.
+type Deferred struct {
+	mu     sync.RWMutex
+	target Interface
+}
+
+// SetTarget sets the publisher the events are forwarded to. Publishing is disabled if the target is nil.
+func (d *Deferred) SetTarget(target Interface) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.target = target
+}
+
+// Publish forwards the event to the target.
+func (d *Deferred) Publish(event Event) {
+	d.mu.RLock()
+	target := d.target
+	d.mu.RUnlock()
+	if target != nil {
+		target.Publish(event)
+	}
+}
+
+func (p *Publisher) run() {
+	defer close(p.doneCh)
+	for {
+		select {
+		case event := <-p.queue:
+			p.send(event)
+		case <-p.stopCh:
+			for {
+				select {
+				case event := <-p.queue:
+					p.send(event)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *Publisher) send(event Event) {
+	backoff := retryBackoff
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+		err := p.sender.Send(ctx, event)
+		cancel()
+		if err == nil {
+			return
+		}
+		if !retriable(err) || attempt == maxRetries {
+			p.logger.Warnf("Failed to send event %s of %s: %v", event.Type, event.Subject, err)
+			return
+		}
+		p.logger.Debugf("Retrying event %s of %s in %v: %v", event.Type, event.Subject, backoff, err)
+		delay := backoff
+		select {
+		case <-p.stopCh:
+			// Do not delay the shutdown with the full backoff
+			delay = retryBackoff
+		default:
+		}
+		time.Sleep(delay)
+		backoff *= 2
+	}
+}
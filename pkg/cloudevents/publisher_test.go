@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// sink is a local stand-in for the HTTP sink which responds with the given statuses in order and
+// with 200 after they are exhausted.
+type sink struct {
+	mu       sync.Mutex
+	statuses []int
+	attempts int
+	events   []Event
+}
+
+func (s *sink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if ct := r.Header.Get("Content-Type"); ct != "application/cloudevents+json; charset=UTF-8" {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+	if len(s.statuses) > 0 {
+		status := s.statuses[0]
+		s.statuses = s.statuses[1:]
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+	}
+	var event Event
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.events = append(s.events, event)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func newTestPublisher(t *testing.T, s *sink, bufferSize int) (*Publisher, func()) {
+	backoff := retryBackoff
+	retryBackoff = time.Millisecond
+	server := httptest.NewServer(s)
+	p := NewPublisher(NewHTTPSender(server.URL), bufferSize, zap.NewNop().Sugar())
+	return p, func() {
+		if err := p.Shutdown(context.Background()); err != nil {
+			t.Fatalf("failed to shutdown the publisher: %v", err)
+		}
+		server.Close()
+		retryBackoff = backoff
+	}
+}
+
+func testEvent(eventType string) Event {
+	return NewEvent(eventType, "Cell", &metav1.ObjectMeta{Namespace: "foo", Name: "bar", Generation: 2})
+}
+
+func TestNewEvent(t *testing.T) {
+	event := testEvent(TypeCellNotReady).WithReason("GatewayNotReady", "Gateway of the cell is NotReady")
+
+	if event.SpecVersion != SpecVersion || event.Source != Source || event.Type != TypeCellNotReady {
+		t.Errorf("unexpected context attributes: %+v", event)
+	}
+	if event.Subject != "foo/bar" {
+		t.Errorf("got subject %q, want %q", event.Subject, "foo/bar")
+	}
+	if len(event.ID) != 36 || event.ID == testEvent(TypeCellNotReady).ID {
+		t.Errorf("got id %q, want a unique uuid", event.ID)
+	}
+	want := Data{
+		Kind:       "Cell",
+		Namespace:  "foo",
+		Name:       "bar",
+		Generation: 2,
+		Reason:     "GatewayNotReady",
+		Message:    "Gateway of the cell is NotReady",
+	}
+	if event.Data != want {
+		t.Errorf("got data %+v, want %+v", event.Data, want)
+	}
+}
+
+func TestPublisherDelivers(t *testing.T) {
+	s := &sink{}
+	p, done := newTestPublisher(t, s, 10)
+	p.Publish(testEvent(TypeCellCreated))
+	p.Publish(testEvent(TypeCellReady))
+	done()
+
+	if len(s.events) != 2 {
+		t.Fatalf("got %d events, want 2", len(s.events))
+	}
+	if s.events[0].Type != TypeCellCreated || s.events[1].Type != TypeCellReady {
+		t.Errorf("got events %s, %s out of order", s.events[0].Type, s.events[1].Type)
+	}
+}
+
+func TestPublisherRetries(t *testing.T) {
+	s := &sink{statuses: []int{http.StatusInternalServerError, http.StatusServiceUnavailable}}
+	p, done := newTestPublisher(t, s, 10)
+	p.Publish(testEvent(TypeCellDeleted))
+	done()
+
+	if s.attempts != 3 {
+		t.Errorf("got %d attempts, want 3", s.attempts)
+	}
+	if len(s.events) != 1 {
+		t.Errorf("got %d events, want 1", len(s.events))
+	}
+}
+
+func TestPublisherGivesUp(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		wantAttempts int
+	}{
+		{
+			name:         "client error",
+			statuses:     []int{http.StatusBadRequest},
+			wantAttempts: 1,
+		},
+		{
+			name: "retries exhausted",
+			statuses: []int{
+				http.StatusInternalServerError,
+				http.StatusInternalServerError,
+				http.StatusInternalServerError,
+				http.StatusInternalServerError,
+				http.StatusInternalServerError,
+				http.StatusInternalServerError,
+			},
+			wantAttempts: maxRetries + 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &sink{statuses: test.statuses}
+			p, done := newTestPublisher(t, s, 10)
+			p.Publish(testEvent(TypeCellReady))
+			done()
+
+			if s.attempts != test.wantAttempts {
+				t.Errorf("got %d attempts, want %d", s.attempts, test.wantAttempts)
+			}
+			if len(s.events) != 0 {
+				t.Errorf("got %d events, want none", len(s.events))
+			}
+		})
+	}
+}
+
+type blockingSender struct {
+	received chan Event
+	release  chan struct{}
+}
+
+func (s *blockingSender) Send(ctx context.Context, event Event) error {
+	s.received <- event
+	<-s.release
+	return nil
+}
+
+func TestPublisherDropsWhenBufferIsFull(t *testing.T) {
+	s := &blockingSender{received: make(chan Event, 3), release: make(chan struct{})}
+	p := NewPublisher(s, 1, zap.NewNop().Sugar())
+
+	p.Publish(testEvent(TypeCellCreated))
+	// Wait until the first event is taken out of the buffer
+	<-s.received
+	p.Publish(testEvent(TypeCellReady))
+	p.Publish(testEvent(TypeCellNotReady))
+	close(s.release)
+	if err := p.Shutdown(context.Background()); err != nil {
+		t.Fatalf("failed to shutdown the publisher: %v", err)
+	}
+
+	close(s.received)
+	var got []string
+	for event := range s.received {
+		got = append(got, event.Type)
+	}
+	if len(got) != 1 || got[0] != TypeCellReady {
+		t.Errorf("got remaining events %v, want [%s]", got, TypeCellReady)
+	}
+}
+
+type recordingPublisher struct {
+	events []Event
+}
+
+func (r *recordingPublisher) Publish(event Event) {
+	r.events = append(r.events, event)
+}
+
+func TestDeferred(t *testing.T) {
+	d := &Deferred{}
+	// Must not block or panic
+	d.Publish(testEvent(TypeCellCreated))
+
+	target := &recordingPublisher{}
+	d.SetTarget(target)
+	d.Publish(testEvent(TypeCellReady))
+	d.SetTarget(nil)
+	d.Publish(testEvent(TypeCellNotReady))
+
+	if len(target.events) != 1 || target.events[0].Type != TypeCellReady {
+		t.Errorf("got events %v, want [%s]", target.events, TypeCellReady)
+	}
+}
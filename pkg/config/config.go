@@ -28,11 +28,13 @@ import (
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 
 	"cellery.io/cellery-controller/pkg/crypto"
 	"cellery.io/cellery-controller/pkg/informers"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
 type Interface interface {
@@ -43,6 +45,23 @@ type Interface interface {
 	PrivateKey() (*rsa.PrivateKey, error)
 	Certificate() (*x509.Certificate, error)
 	CertificateBundle() []byte
+	// Hash returns a hash of the effective values, certificate and certificate bundle. Resources built
+	// from the configuration record it to be updated when the configuration changes.
+	Hash() string
+	// ForNamespace returns the effective configuration for the given namespace. Values found in the
+	// namespace level ConfigMap/Secret take precedence over the global ones.
+	ForNamespace(namespace string) Interface
+}
+
+// settings holds the parsed content of a ConfigMap/Secret pair. The keys of an override are
+// either all set or all empty.
+type settings struct {
+	configMapName string
+	secretName    string
+	configData    map[string]string
+	privateKey    *rsa.PrivateKey
+	certificate   *x509.Certificate
+	certBundle    []byte
 }
 
 type config struct {
@@ -52,11 +71,9 @@ type config struct {
 	configMapLister corev1listers.ConfigMapLister
 	secretLister    corev1listers.SecretLister
 
-	rwlock      sync.RWMutex
-	configData  map[string]string
-	privateKey  *rsa.PrivateKey
-	certificate *x509.Certificate
-	certBundle  []byte
+	rwlock    sync.RWMutex
+	global    settings
+	overrides map[string]*settings
 
 	logger *zap.SugaredLogger
 }
@@ -68,7 +85,12 @@ func NewWatcher(inf informers.Interface, configMapName string, secretName string
 		namespace:       namespace,
 		configMapLister: inf.ConfigMaps().Lister(),
 		secretLister:    inf.Secrets().Lister(),
-		logger:          logger.Named("config-watcher"),
+		global: settings{
+			configMapName: configMapName,
+			secretName:    secretName,
+		},
+		overrides: make(map[string]*settings),
+		logger:    logger.Named("config-watcher"),
 	}
 	inf.ConfigMaps().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithNameAndNamespace(cfg.configMapName, cfg.namespace),
@@ -79,6 +101,24 @@ func NewWatcher(inf informers.Interface, configMapName string, secretName string
 		FilterFunc: informers.FilterWithNameAndNamespace(cfg.secretName, cfg.namespace),
 		Handler:    informers.HandleAddUpdate(cfg.updateSecrets),
 	})
+
+	inf.ConfigMaps().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: cfg.isOverride,
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc:    cfg.updateOverrideConfigs,
+			UpdateFunc: informers.PassNew(cfg.updateOverrideConfigs),
+			DeleteFunc: cfg.deleteOverrideConfigs,
+		},
+	})
+
+	inf.Secrets().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: cfg.isOverride,
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc:    cfg.updateOverrideSecrets,
+			UpdateFunc: informers.PassNew(cfg.updateOverrideSecrets),
+			DeleteFunc: cfg.deleteOverrideSecrets,
+		},
+	})
 	return cfg
 }
 
@@ -102,74 +142,81 @@ func (c *config) CheckResources() error {
 func (c *config) Value(key string) (string, bool) {
 	c.rwlock.RLock()
 	defer c.rwlock.RUnlock()
-	v, ok := c.configData[key]
+	v, ok := c.global.configData[key]
 	return v, ok
 }
 
 func (c *config) StringValue(key string) string {
-	def := ""
-	if v, ok := c.Value(key); ok {
-		return v
-	}
-	c.logger.Warnf("No configuration is found for key %q in %s/%s. Defaulting to %s",
-		key, c.configMapName, c.namespace, def)
-	return def
+	return stringValue(c, key, c.namespace, c.logger)
 }
 
 func (c *config) BoolValue(key string) bool {
-	def := false
-	if v, ok := c.Value(key); ok {
-		b, err := strconv.ParseBool(v)
-		if err != nil {
-			c.logger.Warnf("Error while parsing bool value from key %q: %v. Defaulting to %t", key, err, def)
-			return def
-		}
-		return b
-	}
-	c.logger.Warnf("No configuration is found for key %q in %s/%s. Defaulting to %t",
-		key, c.configMapName, c.namespace, def)
-	return def
+	return boolValue(c, key, c.namespace, c.logger)
 }
 
 func (c *config) IntValue(key string) int64 {
-	var def int64 = 0
-	if v, ok := c.Value(key); ok {
-		i64, err := strconv.ParseInt(v, 10, 64)
-		if err != nil {
-			c.logger.Warnf("Error while parsing int value from key %q: %v. Defaulting to %d", key, err, def)
-			return def
-		}
-		return i64
-	}
-	c.logger.Warnf("No configuration is found for key %q in %s/%s. Defaulting to %d",
-		key, c.configMapName, c.namespace, def)
-	return def
+	return intValue(c, key, c.namespace, c.logger)
 }
 
 func (c *config) PrivateKey() (*rsa.PrivateKey, error) {
 	c.rwlock.RLock()
 	defer c.rwlock.RUnlock()
-	if c.privateKey == nil {
+	if c.global.privateKey == nil {
 		return nil, fmt.Errorf("no rsa private key is set with the key %q in secret %s/%s",
 			SecretKeyPrivateKey, c.namespace, c.secretName)
 	}
-	return c.privateKey, nil
+	return c.global.privateKey, nil
 }
 
 func (c *config) Certificate() (*x509.Certificate, error) {
 	c.rwlock.RLock()
 	defer c.rwlock.RUnlock()
-	if c.certificate == nil {
+	if c.global.certificate == nil {
 		return nil, fmt.Errorf("no x509 certificate is set with the key %q in secret %s/%s",
 			SecretKeyCertificate, c.namespace, c.secretName)
 	}
-	return c.certificate, nil
+	return c.global.certificate, nil
 }
 
 func (c *config) CertificateBundle() []byte {
 	c.rwlock.RLock()
 	defer c.rwlock.RUnlock()
-	return c.certBundle
+	return c.global.certBundle
+}
+
+func (c *config) Hash() string {
+	c.rwlock.RLock()
+	defer c.rwlock.RUnlock()
+	return hash(c.global.configData, c.global.certificate, c.global.certBundle)
+}
+
+func (c *config) ForNamespace(namespace string) Interface {
+	if namespace == c.namespace {
+		return c
+	}
+	return &namespacedConfig{
+		parent:    c,
+		namespace: namespace,
+	}
+}
+
+func (c *config) override(namespace string) (*settings, bool) {
+	c.rwlock.RLock()
+	defer c.rwlock.RUnlock()
+	s, ok := c.overrides[namespace]
+	return s, ok
+}
+
+func (c *config) isOverride(obj interface{}) bool {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	object, ok := obj.(metav1.Object)
+	if !ok || object.GetNamespace() == c.namespace {
+		return false
+	}
+	v, ok := object.GetLabels()[meta.ConfigOverrideLabelKey]
+	return ok && v == "true"
 }
 
 func (c *config) updateConfigs(obj interface{}) {
@@ -180,7 +227,7 @@ func (c *config) updateConfigs(obj interface{}) {
 	if !ok {
 		return
 	}
-	c.configData = configMap.DeepCopy().Data
+	c.global.configData = configMap.DeepCopy().Data
 }
 
 func (c *config) updateSecrets(obj interface{}) {
@@ -191,33 +238,303 @@ func (c *config) updateSecrets(obj interface{}) {
 	if !ok {
 		return
 	}
+	c.parseSecret(&c.global, secret, true)
+}
+
+func (c *config) updateOverrideConfigs(obj interface{}) {
+	c.rwlock.Lock()
+	defer c.rwlock.Unlock()
+
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return
+	}
+	s := c.overrideFor(configMap.Namespace)
+	if len(s.configMapName) > 0 && s.configMapName != configMap.Name {
+		c.logger.Warnf("Multiple configuration overrides found in namespace %q. Using %q instead of %q",
+			configMap.Namespace, configMap.Name, s.configMapName)
+	}
+	s.configMapName = configMap.Name
+	s.configData = configMap.DeepCopy().Data
+}
+
+func (c *config) updateOverrideSecrets(obj interface{}) {
+	c.rwlock.Lock()
+	defer c.rwlock.Unlock()
+
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return
+	}
+	s := c.overrideFor(secret.Namespace)
+	if len(s.secretName) > 0 && s.secretName != secret.Name {
+		c.logger.Warnf("Multiple secret overrides found in namespace %q. Using %q instead of %q",
+			secret.Namespace, secret.Name, s.secretName)
+	}
+	s.secretName = secret.Name
+	s.privateKey = nil
+	s.certificate = nil
+	s.certBundle = nil
+
+	// The signing key, certificate and the bundle are only overridden together since a partial
+	// override would pair the keys of the namespace with the ones of the global secret.
+	parsed := &settings{}
+	c.parseSecret(parsed, secret, false)
+	switch {
+	case parsed.privateKey == nil && parsed.certificate == nil && parsed.certBundle == nil:
+	case parsed.privateKey == nil || parsed.certificate == nil || parsed.certBundle == nil:
+		c.logger.Errorf("Ignoring the keys of the secret override %s/%s which must contain all of %q, %q and %q",
+			secret.Namespace, secret.Name, SecretKeyPrivateKey, SecretKeyCertificate, SecretKeyCertificateBundle)
+	case !matchesPrivateKey(parsed.certificate, parsed.privateKey):
+		c.logger.Errorf("Ignoring the keys of the secret override %s/%s since the certificate does not match the private key",
+			secret.Namespace, secret.Name)
+	default:
+		s.privateKey = parsed.privateKey
+		s.certificate = parsed.certificate
+		s.certBundle = parsed.certBundle
+	}
+}
+
+// matchesPrivateKey reports whether the public key of the certificate belongs to the private key.
+func matchesPrivateKey(cert *x509.Certificate, privateKey *rsa.PrivateKey) bool {
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	return ok && publicKey.N.Cmp(privateKey.N) == 0 && publicKey.E == privateKey.E
+}
+
+func (c *config) deleteOverrideConfigs(obj interface{}) {
+	c.rwlock.Lock()
+	defer c.rwlock.Unlock()
+
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return
+	}
+	s, ok := c.overrides[configMap.Namespace]
+	if !ok || s.configMapName != configMap.Name {
+		return
+	}
+	s.configMapName = ""
+	s.configData = nil
+	c.pruneOverride(configMap.Namespace)
+}
+
+func (c *config) deleteOverrideSecrets(obj interface{}) {
+	c.rwlock.Lock()
+	defer c.rwlock.Unlock()
+
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return
+	}
+	s, ok := c.overrides[secret.Namespace]
+	if !ok || s.secretName != secret.Name {
+		return
+	}
+	s.secretName = ""
+	s.privateKey = nil
+	s.certificate = nil
+	s.certBundle = nil
+	c.pruneOverride(secret.Namespace)
+}
+
+// overrideFor returns the override settings of the namespace, creating them if required.
+// Caller must hold the write lock.
+func (c *config) overrideFor(namespace string) *settings {
+	s, ok := c.overrides[namespace]
+	if !ok {
+		s = &settings{}
+		c.overrides[namespace] = s
+	}
+	return s
+}
 
+// pruneOverride removes the override settings of the namespace once both the ConfigMap and
+// the Secret are gone. Caller must hold the write lock.
+func (c *config) pruneOverride(namespace string) {
+	if s, ok := c.overrides[namespace]; ok && len(s.configMapName) == 0 && len(s.secretName) == 0 {
+		delete(c.overrides, namespace)
+	}
+}
+
+// parseSecret reads the signing key, certificate and the certificate bundle into s.
+// Missing keys are only reported for required secrets since the override secrets may
+// not override the keys at all. Caller must hold the write lock.
+func (c *config) parseSecret(s *settings, secret *corev1.Secret, required bool) {
 	if keyBytes, ok := secret.Data[SecretKeyPrivateKey]; ok {
 		privateKey, err := crypto.ParsePrivateKey(keyBytes)
 		if err != nil {
-			c.logger.Errorf("Error while parsing %q from the secret %s/%s: %v", SecretKeyPrivateKey, c.namespace, c.secretName, err)
+			c.logger.Errorf("Error while parsing %q from the secret %s/%s: %v", SecretKeyPrivateKey, secret.Namespace, secret.Name, err)
 		} else {
-			c.privateKey = privateKey
+			s.privateKey = privateKey
 		}
-	} else {
-		c.logger.Errorf("Missing key %q in secret %s/%s", SecretKeyPrivateKey, c.namespace, c.secretName)
+	} else if required {
+		c.logger.Errorf("Missing key %q in secret %s/%s", SecretKeyPrivateKey, secret.Namespace, secret.Name)
 	}
 
 	if certBytes, ok := secret.Data[SecretKeyCertificate]; ok {
 		cert, err := crypto.ParseCertificate(certBytes)
 		if err != nil {
-			c.logger.Errorf("Error while parsing %q from the secret %s/%s: %v", SecretKeyCertificate, c.namespace, c.secretName, err)
+			c.logger.Errorf("Error while parsing %q from the secret %s/%s: %v", SecretKeyCertificate, secret.Namespace, secret.Name, err)
 		} else {
-			c.certificate = cert
+			s.certificate = cert
 		}
-	} else {
-		c.logger.Errorf("Missing key %q in secret %s/%s", SecretKeyCertificate, c.namespace, c.secretName)
+	} else if required {
+		c.logger.Errorf("Missing key %q in secret %s/%s", SecretKeyCertificate, secret.Namespace, secret.Name)
 	}
 
 	if certBundle, ok := secret.Data[SecretKeyCertificateBundle]; ok {
-		c.certBundle = certBundle
-	} else {
-		c.logger.Errorf("Missing key %q in secret %s/%s", SecretKeyCertificateBundle, c.namespace, c.secretName)
+		s.certBundle = certBundle
+	} else if required {
+		c.logger.Errorf("Missing key %q in secret %s/%s", SecretKeyCertificateBundle, secret.Namespace, secret.Name)
 	}
+}
 
+// namespacedConfig resolves the configuration of a single namespace by looking at the
+// namespace level overrides first and falling back to the global configuration.
+type namespacedConfig struct {
+	parent    *config
+	namespace string
+}
+
+func (n *namespacedConfig) Value(key string) (string, bool) {
+	if s, ok := n.parent.override(n.namespace); ok {
+		n.parent.rwlock.RLock()
+		v, ok := s.configData[key]
+		n.parent.rwlock.RUnlock()
+		if ok {
+			return v, true
+		}
+	}
+	return n.parent.Value(key)
+}
+
+func (n *namespacedConfig) StringValue(key string) string {
+	return stringValue(n, key, n.namespace, n.parent.logger)
+}
+
+func (n *namespacedConfig) BoolValue(key string) bool {
+	return boolValue(n, key, n.namespace, n.parent.logger)
+}
+
+func (n *namespacedConfig) IntValue(key string) int64 {
+	return intValue(n, key, n.namespace, n.parent.logger)
+}
+
+func (n *namespacedConfig) PrivateKey() (*rsa.PrivateKey, error) {
+	if s, ok := n.parent.override(n.namespace); ok {
+		n.parent.rwlock.RLock()
+		privateKey := s.privateKey
+		n.parent.rwlock.RUnlock()
+		if privateKey != nil {
+			return privateKey, nil
+		}
+	}
+	return n.parent.PrivateKey()
+}
+
+func (n *namespacedConfig) Certificate() (*x509.Certificate, error) {
+	if s, ok := n.parent.override(n.namespace); ok {
+		n.parent.rwlock.RLock()
+		certificate := s.certificate
+		n.parent.rwlock.RUnlock()
+		if certificate != nil {
+			return certificate, nil
+		}
+	}
+	return n.parent.Certificate()
+}
+
+func (n *namespacedConfig) CertificateBundle() []byte {
+	if s, ok := n.parent.override(n.namespace); ok {
+		n.parent.rwlock.RLock()
+		certBundle := s.certBundle
+		n.parent.rwlock.RUnlock()
+		if certBundle != nil {
+			return certBundle
+		}
+	}
+	return n.parent.CertificateBundle()
+}
+
+func (n *namespacedConfig) Hash() string {
+	s, ok := n.parent.override(n.namespace)
+	if !ok {
+		return n.parent.Hash()
+	}
+	n.parent.rwlock.RLock()
+	defer n.parent.rwlock.RUnlock()
+	configData := make(map[string]string, len(n.parent.global.configData)+len(s.configData))
+	for k, v := range n.parent.global.configData {
+		configData[k] = v
+	}
+	for k, v := range s.configData {
+		configData[k] = v
+	}
+	certificate := n.parent.global.certificate
+	if s.certificate != nil {
+		certificate = s.certificate
+	}
+	certBundle := n.parent.global.certBundle
+	if s.certBundle != nil {
+		certBundle = s.certBundle
+	}
+	return hash(configData, certificate, certBundle)
+}
+
+func (n *namespacedConfig) ForNamespace(namespace string) Interface {
+	return n.parent.ForNamespace(namespace)
+}
+
+func hash(configData map[string]string, certificate *x509.Certificate, certBundle []byte) string {
+	var certRaw []byte
+	if certificate != nil {
+		certRaw = certificate.Raw
+	}
+	return meta.Hash(configData, certRaw, certBundle)
+}
+
+func stringValue(cfg Interface, key string, namespace string, logger *zap.SugaredLogger) string {
+	def := ""
+	if v, ok := cfg.Value(key); ok {
+		return v
+	}
+	logger.Warnf("No configuration is found for key %q in namespace %q. Defaulting to %s",
+		key, namespace, def)
+	return def
+}
+
+func boolValue(cfg Interface, key string, namespace string, logger *zap.SugaredLogger) bool {
+	def := false
+	if v, ok := cfg.Value(key); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			logger.Warnf("Error while parsing bool value from key %q: %v. Defaulting to %t", key, err, def)
+			return def
+		}
+		return b
+	}
+	logger.Warnf("No configuration is found for key %q in namespace %q. Defaulting to %t",
+		key, namespace, def)
+	return def
+}
+
+func intValue(cfg Interface, key string, namespace string, logger *zap.SugaredLogger) int64 {
+	var def int64 = 0
+	if v, ok := cfg.Value(key); ok {
+		i64, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			logger.Warnf("Error while parsing int value from key %q: %v. Defaulting to %d", key, err, def)
+			return def
+		}
+		return i64
+	}
+	logger.Warnf("No configuration is found for key %q in namespace %q. Defaulting to %d",
+		key, namespace, def)
+	return def
 }
@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package config
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+func newTestConfig() *config {
+	return &config{
+		configMapName: "cellery-config",
+		secretName:    "cellery-secret",
+		namespace:     "cellery-system",
+		global: settings{
+			configMapName: "cellery-config",
+			secretName:    "cellery-secret",
+		},
+		overrides: make(map[string]*settings),
+		logger:    zap.NewNop().Sugar(),
+	}
+}
+
+func overrideConfigMap(namespace, name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels: map[string]string{
+				meta.ConfigOverrideLabelKey: "true",
+			},
+		},
+		Data: data,
+	}
+}
+
+func TestForNamespace(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.updateConfigs(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "cellery-system", Name: "cellery-config"},
+		Data: map[string]string{
+			ConfigMapKeyTokenServiceImage:    "global-sts",
+			ConfigMapKeyApiPublisherImage:    "global-publisher",
+			ConfigMapKeySkipTlsVerification:  "false",
+			ConfigMapKeyTokenServiceOpaImage: "global-opa",
+		},
+	})
+	cfg.updateOverrideConfigs(overrideConfigMap("team-a", "team-config", map[string]string{
+		ConfigMapKeyTokenServiceImage:   "team-a-sts",
+		ConfigMapKeySkipTlsVerification: "true",
+	}))
+
+	tests := []struct {
+		name      string
+		namespace string
+		key       string
+		want      string
+	}{{
+		name:      "overridden key",
+		namespace: "team-a",
+		key:       ConfigMapKeyTokenServiceImage,
+		want:      "team-a-sts",
+	}, {
+		name:      "key falls back to global",
+		namespace: "team-a",
+		key:       ConfigMapKeyApiPublisherImage,
+		want:      "global-publisher",
+	}, {
+		name:      "namespace without override",
+		namespace: "team-b",
+		key:       ConfigMapKeyTokenServiceImage,
+		want:      "global-sts",
+	}, {
+		name:      "system namespace",
+		namespace: "cellery-system",
+		key:       ConfigMapKeyTokenServiceImage,
+		want:      "global-sts",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := cfg.ForNamespace(test.namespace).StringValue(test.key)
+			if got != test.want {
+				t.Errorf("StringValue(%q) in namespace %q = %q, want %q", test.key, test.namespace, got, test.want)
+			}
+		})
+	}
+
+	if !cfg.ForNamespace("team-a").BoolValue(ConfigMapKeySkipTlsVerification) {
+		t.Errorf("BoolValue(%q) in namespace %q = false, want true", ConfigMapKeySkipTlsVerification, "team-a")
+	}
+}
+
+func TestDeleteOverride(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.updateConfigs(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "cellery-system", Name: "cellery-config"},
+		Data:       map[string]string{ConfigMapKeyOidcImage: "global-oidc"},
+	})
+	override := overrideConfigMap("team-a", "team-config", map[string]string{ConfigMapKeyOidcImage: "team-a-oidc"})
+	cfg.updateOverrideConfigs(override)
+
+	if got := cfg.ForNamespace("team-a").StringValue(ConfigMapKeyOidcImage); got != "team-a-oidc" {
+		t.Fatalf("StringValue before delete = %q, want %q", got, "team-a-oidc")
+	}
+
+	cfg.deleteOverrideConfigs(override)
+
+	if got := cfg.ForNamespace("team-a").StringValue(ConfigMapKeyOidcImage); got != "global-oidc" {
+		t.Errorf("StringValue after delete = %q, want %q", got, "global-oidc")
+	}
+	if _, ok := cfg.overrides["team-a"]; ok {
+		t.Errorf("override settings for namespace %q are not removed", "team-a")
+	}
+}
+
+func TestHash(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.updateConfigs(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "cellery-system", Name: "cellery-config"},
+		Data:       map[string]string{ConfigMapKeyOidcImage: "global-oidc"},
+	})
+	global := cfg.Hash()
+	if got := cfg.ForNamespace("team-a").Hash(); got != global {
+		t.Errorf("Hash without an override = %q, want the global hash %q", got, global)
+	}
+
+	override := overrideConfigMap("team-a", "team-config", map[string]string{ConfigMapKeyOidcImage: "team-a-oidc"})
+	cfg.updateOverrideConfigs(override)
+	overridden := cfg.ForNamespace("team-a").Hash()
+	if overridden == global {
+		t.Errorf("Hash with an override = global hash %q, want a different hash", global)
+	}
+	if got := cfg.ForNamespace("team-b").Hash(); got != global {
+		t.Errorf("Hash of another namespace = %q, want the global hash %q", got, global)
+	}
+
+	cfg.updateOverrideConfigs(overrideConfigMap("team-a", "team-config", map[string]string{ConfigMapKeyOidcImage: "team-a-oidc-v2"}))
+	if got := cfg.ForNamespace("team-a").Hash(); got == overridden {
+		t.Errorf("Hash after the override changed = previous hash %q, want a different hash", overridden)
+	}
+
+	cfg.deleteOverrideConfigs(override)
+	if got := cfg.ForNamespace("team-a").Hash(); got != global {
+		t.Errorf("Hash after delete = %q, want the global hash %q", got, global)
+	}
+}
+
+// testKeys returns a PEM encoded PKCS8 private key and a self signed certificate of it.
+func testKeys(t *testing.T) ([]byte, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "cellery"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
+}
+
+func TestOverrideSecretKeys(t *testing.T) {
+	globalKey, globalCert := testKeys(t)
+	teamKey, teamCert := testKeys(t)
+	_, otherCert := testKeys(t)
+
+	tests := []struct {
+		name       string
+		data       map[string][]byte
+		wantGlobal bool
+	}{{
+		name: "complete override",
+		data: map[string][]byte{
+			SecretKeyPrivateKey:        teamKey,
+			SecretKeyCertificate:       teamCert,
+			SecretKeyCertificateBundle: []byte("team-bundle"),
+		},
+	}, {
+		name: "partial override",
+		data: map[string][]byte{
+			SecretKeyPrivateKey: teamKey,
+		},
+		wantGlobal: true,
+	}, {
+		name: "certificate of another key",
+		data: map[string][]byte{
+			SecretKeyPrivateKey:        teamKey,
+			SecretKeyCertificate:       otherCert,
+			SecretKeyCertificateBundle: []byte("team-bundle"),
+		},
+		wantGlobal: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTestConfig()
+			cfg.updateSecrets(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "cellery-system", Name: "cellery-secret"},
+				Data: map[string][]byte{
+					SecretKeyPrivateKey:        globalKey,
+					SecretKeyCertificate:       globalCert,
+					SecretKeyCertificateBundle: []byte("global-bundle"),
+				},
+			})
+			cfg.updateOverrideSecrets(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "team-a",
+					Name:      "team-secret",
+					Labels:    map[string]string{meta.ConfigOverrideLabelKey: "true"},
+				},
+				Data: test.data,
+			})
+
+			want, source := cfg.global, "global"
+			if !test.wantGlobal {
+				want, source = *cfg.overrides["team-a"], "override"
+			}
+			namespaced := cfg.ForNamespace("team-a")
+			if privateKey, _ := namespaced.PrivateKey(); privateKey != want.privateKey {
+				t.Errorf("PrivateKey() does not return the %s key", source)
+			}
+			if certificate, _ := namespaced.Certificate(); certificate != want.certificate {
+				t.Errorf("Certificate() does not return the %s certificate", source)
+			}
+			if got := string(namespaced.CertificateBundle()); got != string(want.certBundle) {
+				t.Errorf("CertificateBundle() = %q, want %q", got, want.certBundle)
+			}
+		})
+	}
+}
+
+func TestIsOverride(t *testing.T) {
+	cfg := newTestConfig()
+	tests := []struct {
+		name string
+		obj  interface{}
+		want bool
+	}{{
+		name: "labeled configmap",
+		obj:  overrideConfigMap("team-a", "team-config", nil),
+		want: true,
+	}, {
+		name: "labeled configmap in system namespace",
+		obj:  overrideConfigMap("cellery-system", "team-config", nil),
+		want: false,
+	}, {
+		name: "configmap without label",
+		obj:  &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "foo"}},
+		want: false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := cfg.isOverride(test.obj); got != test.want {
+				t.Errorf("isOverride() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
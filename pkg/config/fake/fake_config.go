@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package fake
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+var (
+	caOnce        sync.Once
+	caPrivateKey  *rsa.PrivateKey
+	caCertificate *x509.Certificate
+	caErr         error
+)
+
+// Config is a static config.Interface for tests. The private key and the certificate are a self
+// signed CA which is generated once and shared by all the fake configs.
+type Config struct {
+	Data       map[string]string
+	CertBundle []byte
+}
+
+// New creates a fake config with the given ConfigMap data.
+func New(data map[string]string) *Config {
+	if data == nil {
+		data = map[string]string{}
+	}
+	return &Config{Data: data}
+}
+
+func (c *Config) Value(key string) (string, bool) {
+	v, ok := c.Data[key]
+	return v, ok
+}
+
+func (c *Config) StringValue(key string) string {
+	return c.Data[key]
+}
+
+func (c *Config) BoolValue(key string) bool {
+	b, _ := strconv.ParseBool(c.Data[key])
+	return b
+}
+
+func (c *Config) IntValue(key string) int64 {
+	i, _ := strconv.ParseInt(c.Data[key], 10, 64)
+	return i
+}
+
+func (c *Config) PrivateKey() (*rsa.PrivateKey, error) {
+	caOnce.Do(generateCA)
+	return caPrivateKey, caErr
+}
+
+func (c *Config) Certificate() (*x509.Certificate, error) {
+	caOnce.Do(generateCA)
+	return caCertificate, caErr
+}
+
+func (c *Config) CertificateBundle() []byte {
+	return c.CertBundle
+}
+
+func (c *Config) Hash() string {
+	return meta.Hash(c.Data, c.CertBundle)
+}
+
+func (c *Config) ForNamespace(namespace string) config.Interface {
+	return c
+}
+
+func generateCA() {
+	caPrivateKey, caErr = rsa.GenerateKey(rand.Reader, 2048)
+	if caErr != nil {
+		return
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "cellery-test-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caPrivateKey.PublicKey, caPrivateKey)
+	if err != nil {
+		caErr = err
+		return
+	}
+	caCertificate, caErr = x509.ParseCertificate(der)
+}
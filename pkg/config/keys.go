@@ -30,6 +30,16 @@ const (
 	ConfigMapKeyApiPublisherImage            = "api-publisher-image"
 	ConfigMapKeyApiPublisherConfig           = "api-publisher-config"
 	ConfigMapKeySkipTlsVerification          = "skip-tls-verification"
+	ConfigMapKeyTracingExporter              = "tracing-exporter"
+	ConfigMapKeyOtlpEndpoint                 = "otlp-endpoint"
+	ConfigMapKeyRevisionHistoryLimit         = "revision-history-limit"
+	ConfigMapKeyCloudEventsSink              = "cloudevents-sink"
+	ConfigMapKeyCloudEventsBufferSize        = "cloudevents-buffer-size"
+	ConfigMapKeyJwtIssuer                    = "jwt-issuer"
+	ConfigMapKeyJwksUri                      = "jwks-uri"
+	ConfigMapKeyGatewayApiParent             = "gateway-api-parent"
+	ConfigMapKeyCertificateIssuer            = "certificate-issuer"
+	ConfigMapKeyTrafficPolicy                = "traffic-policy"
 
 	SecretKeyPrivateKey        = "tls.key"
 	SecretKeyCertificate       = "tls.crt"
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	v1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+// AdoptionPolicy decides which existing resources an object can take the ownership of.
+type AdoptionPolicy string
+
+const (
+	AdoptNever   AdoptionPolicy = meta.AdoptNeverValue
+	AdoptOrphans AdoptionPolicy = meta.AdoptOrphansValue
+	AdoptAlways  AdoptionPolicy = meta.AdoptAlwaysValue
+)
+
+// GetAdoptionPolicy returns the adoption policy set with the adopt annotation of the object.
+// Unknown values are treated as never.
+func GetAdoptionPolicy(obj metav1.Object) AdoptionPolicy {
+	switch policy := AdoptionPolicy(obj.GetAnnotations()[meta.AdoptAnnotationKey]); policy {
+	case AdoptOrphans, AdoptAlways:
+		return policy
+	}
+	return AdoptNever
+}
+
+// GetOwnerAdoptionPolicy returns the adoption policy of the object if it is annotated, otherwise the
+// policy of the Cell or the Composite which controls the object.
+func GetOwnerAdoptionPolicy(obj metav1.Object, cellLister v1alpha2listers.CellLister, compositeLister v1alpha2listers.CompositeLister) AdoptionPolicy {
+	if _, ok := obj.GetAnnotations()[meta.AdoptAnnotationKey]; ok {
+		return GetAdoptionPolicy(obj)
+	}
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.APIVersion != v1alpha2.SchemeGroupVersion.String() {
+		return AdoptNever
+	}
+	switch owner.Kind {
+	case "Cell":
+		cell, err := cellLister.Cells(obj.GetNamespace()).Get(owner.Name)
+		if err == nil && cell.UID == owner.UID {
+			return GetAdoptionPolicy(cell)
+		}
+	case "Composite":
+		composite, err := compositeLister.Composites(obj.GetNamespace()).Get(owner.Name)
+		if err == nil && composite.UID == owner.UID {
+			return GetAdoptionPolicy(composite)
+		}
+	}
+	return AdoptNever
+}
+
+// CanAdopt returns true if the existing resource can be adopted under the policy. Resources
+// controlled by another object are never adopted.
+func CanAdopt(policy AdoptionPolicy, resource metav1.Object) bool {
+	if metav1.GetControllerOf(resource) != nil {
+		return false
+	}
+	switch policy {
+	case AdoptAlways:
+		return true
+	case AdoptOrphans:
+		return len(resource.GetOwnerReferences()) == 0
+	}
+	return false
+}
+
+// Adopt sets the controller reference of the desired resource in the owner references of the
+// existing resource.
+func Adopt(existing metav1.Object, desired metav1.Object) {
+	if ref := metav1.GetControllerOf(desired); ref != nil {
+		AdoptWithOwnerRef(existing, ref)
+	}
+}
+
+// AdoptWithOwnerRef sets the controller reference in the owner references of the existing resource,
+// replacing a non controller reference to the same owner.
+func AdoptWithOwnerRef(existing metav1.Object, ref *metav1.OwnerReference) {
+	var refs []metav1.OwnerReference
+	for _, r := range existing.GetOwnerReferences() {
+		if r.UID != ref.UID || r.Kind != ref.Kind || r.Name != ref.Name {
+			refs = append(refs, r)
+		}
+	}
+	existing.SetOwnerReferences(append(refs, *ref))
+}
+
+// AddAdoptedResource adds the resource to the list of adopted resources if it is not in the list.
+func AddAdoptedResource(adopted []string, kind string, name string) []string {
+	resource := kind + "/" + name
+	for _, r := range adopted {
+		if r == resource {
+			return adopted
+		}
+	}
+	return append(adopted, resource)
+}
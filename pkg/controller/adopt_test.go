@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	v1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+func adoptAnnotations(policy string) map[string]string {
+	return map[string]string{meta.AdoptAnnotationKey: policy}
+}
+
+func TestGetOwnerAdoptionPolicy(t *testing.T) {
+	cellIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	compositeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+
+	orphansCell := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{Name: "orphans", Namespace: "foo", UID: types.UID("1"), Annotations: adoptAnnotations("orphans")}}
+	defaultCell := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "foo", UID: types.UID("2")}}
+	alwaysComposite := &v1alpha2.Composite{ObjectMeta: metav1.ObjectMeta{Name: "always", Namespace: "foo", UID: types.UID("3"), Annotations: adoptAnnotations("always")}}
+	cellIndexer.Add(orphansCell)
+	cellIndexer.Add(defaultCell)
+	compositeIndexer.Add(alwaysComposite)
+
+	cellLister := v1alpha2listers.NewCellLister(cellIndexer)
+	compositeLister := v1alpha2listers.NewCompositeLister(compositeIndexer)
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		owner       *metav1.OwnerReference
+		want        AdoptionPolicy
+	}{
+		{
+			name: "no owner",
+			want: AdoptNever,
+		},
+		{
+			name:        "own annotation",
+			annotations: adoptAnnotations("always"),
+			owner:       CreateCellOwnerRef(orphansCell),
+			want:        AdoptAlways,
+		},
+		{
+			name:        "unknown policy",
+			annotations: adoptAnnotations("sometimes"),
+			want:        AdoptNever,
+		},
+		{
+			name:  "cell policy",
+			owner: CreateCellOwnerRef(orphansCell),
+			want:  AdoptOrphans,
+		},
+		{
+			name:  "cell without a policy",
+			owner: CreateCellOwnerRef(defaultCell),
+			want:  AdoptNever,
+		},
+		{
+			name:  "composite policy",
+			owner: CreateCompositeOwnerRef(alwaysComposite),
+			want:  AdoptAlways,
+		},
+		{
+			name: "recreated cell",
+			owner: CreateCellOwnerRef(&v1alpha2.Cell{
+				ObjectMeta: metav1.ObjectMeta{Name: "orphans", Namespace: "foo", UID: types.UID("4")},
+			}),
+			want: AdoptNever,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			component := &v1alpha2.Component{ObjectMeta: metav1.ObjectMeta{Name: "component", Namespace: "foo", Annotations: test.annotations}}
+			if test.owner != nil {
+				component.OwnerReferences = []metav1.OwnerReference{*test.owner}
+			}
+			if got := GetOwnerAdoptionPolicy(component, cellLister, compositeLister); got != test.want {
+				t.Errorf("GetOwnerAdoptionPolicy() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCanAdopt(t *testing.T) {
+	cell := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{Name: "cell", Namespace: "foo", UID: types.UID("1")}}
+	owner := metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "owner", UID: types.UID("2")}
+
+	tests := []struct {
+		name   string
+		policy AdoptionPolicy
+		owners []metav1.OwnerReference
+		want   bool
+	}{
+		{
+			name:   "never",
+			policy: AdoptNever,
+		},
+		{
+			name:   "orphan with orphans",
+			policy: AdoptOrphans,
+			want:   true,
+		},
+		{
+			name:   "owned with orphans",
+			policy: AdoptOrphans,
+			owners: []metav1.OwnerReference{owner},
+		},
+		{
+			name:   "owned with always",
+			policy: AdoptAlways,
+			owners: []metav1.OwnerReference{owner},
+			want:   true,
+		},
+		{
+			name:   "controlled with always",
+			policy: AdoptAlways,
+			owners: []metav1.OwnerReference{*CreateCellOwnerRef(cell)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resource := &metav1.ObjectMeta{Name: "resource", Namespace: "foo", OwnerReferences: test.owners}
+			if got := CanAdopt(test.policy, resource); got != test.want {
+				t.Errorf("CanAdopt() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAdopt(t *testing.T) {
+	cell := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{Name: "cell", Namespace: "foo", UID: types.UID("1")}}
+	other := metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "owner", UID: types.UID("2")}
+	ref := *CreateCellOwnerRef(cell)
+	nonController := ref
+	nonController.Controller = nil
+
+	existing := &metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{other, nonController}}
+	desired := &metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{ref}}
+	Adopt(existing, desired)
+
+	want := []metav1.OwnerReference{other, ref}
+	if !reflect.DeepEqual(existing.OwnerReferences, want) {
+		t.Errorf("got owner references %v, want %v", existing.OwnerReferences, want)
+	}
+	if !metav1.IsControlledBy(existing, cell) {
+		t.Errorf("adopted resource is not controlled by the cell")
+	}
+}
+
+func TestAddAdoptedResource(t *testing.T) {
+	adopted := AddAdoptedResource(nil, "Secret", "foo")
+	adopted = AddAdoptedResource(adopted, "Service", "foo")
+	adopted = AddAdoptedResource(adopted, "Secret", "foo")
+	want := []string{"Secret/foo", "Service/foo"}
+	if !reflect.DeepEqual(adopted, want) {
+		t.Errorf("got %v, want %v", adopted, want)
+	}
+}
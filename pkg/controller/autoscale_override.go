@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	v1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
+)
+
+// GetAutoscaleOverride returns the AutoscaleOverride which targets the object of the given kind, or nil
+// if there is none. The override with the lowest name is returned if many of them target the object.
+func GetAutoscaleOverride(obj metav1.Object, kind string, lister v1alpha2listers.AutoscaleOverrideLister) (*v1alpha2.AutoscaleOverride, error) {
+	overrides, err := lister.AutoscaleOverrides(obj.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var found *v1alpha2.AutoscaleOverride
+	for _, override := range overrides {
+		if override.TargetKind() == kind && override.TargetName() == obj.GetName() && (found == nil || override.Name < found.Name) {
+			found = override
+		}
+	}
+	return found, nil
+}
+
+// HandleAutoscaleOverride returns an event handler which calls the given handler with the key of the
+// object targeted by an AutoscaleOverride if the target is of the given kind. Both the previous and
+// the current targets are handled when an override is updated.
+func HandleAutoscaleOverride(kind string, h func(string)) cache.ResourceEventHandler {
+	handleTarget := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		override, ok := obj.(*v1alpha2.AutoscaleOverride)
+		if !ok || override.TargetKind() != kind {
+			return
+		}
+		h(override.Namespace + "/" + override.TargetName())
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: handleTarget,
+		UpdateFunc: func(first, second interface{}) {
+			handleTarget(first)
+			handleTarget(second)
+		},
+		DeleteFunc: handleTarget,
+	}
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	v1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
+)
+
+func autoscaleOverride(name, instance, component string) *v1alpha2.AutoscaleOverride {
+	return &v1alpha2.AutoscaleOverride{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "foo"},
+		Spec:       v1alpha2.AutoscaleOverrideSpec{Instance: instance, Component: component},
+	}
+}
+
+func TestGetAutoscaleOverride(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	indexer.Add(autoscaleOverride("salary-b", "employee", "salary"))
+	indexer.Add(autoscaleOverride("salary-a", "employee", "salary"))
+	indexer.Add(autoscaleOverride("gateway", "employee", ""))
+	lister := v1alpha2listers.NewAutoscaleOverrideLister(indexer)
+
+	tests := []struct {
+		name   string
+		target string
+		kind   string
+		want   string
+	}{
+		{
+			name:   "lowest name of many overrides",
+			target: "employee--salary",
+			kind:   "Component",
+			want:   "salary-a",
+		},
+		{
+			name:   "gateway",
+			target: "employee--gateway",
+			kind:   "Gateway",
+			want:   "gateway",
+		},
+		{
+			name:   "other kind",
+			target: "employee--gateway",
+			kind:   "Component",
+		},
+		{
+			name:   "no override",
+			target: "stock--salary",
+			kind:   "Component",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			obj := &metav1.ObjectMeta{Name: test.target, Namespace: "foo"}
+			override, err := GetAutoscaleOverride(obj, test.kind, lister)
+			if err != nil {
+				t.Fatalf("GetAutoscaleOverride() returned an error: %v", err)
+			}
+			var got string
+			if override != nil {
+				got = override.Name
+			}
+			if got != test.want {
+				t.Errorf("GetAutoscaleOverride() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestHandleAutoscaleOverride(t *testing.T) {
+	var keys []string
+	h := HandleAutoscaleOverride("Component", func(key string) { keys = append(keys, key) })
+
+	h.OnAdd(autoscaleOverride("salary", "employee", "salary"))
+	h.OnAdd(autoscaleOverride("gateway", "employee", ""))
+	h.OnUpdate(autoscaleOverride("salary", "employee", "salary"), autoscaleOverride("salary", "employee", "payroll"))
+	h.OnDelete(cache.DeletedFinalStateUnknown{Key: "foo/salary", Obj: autoscaleOverride("salary", "employee", "payroll")})
+
+	want := []string{"foo/employee--salary", "foo/employee--salary", "foo/employee--payroll", "foo/employee--payroll"}
+	if len(keys) != len(want) {
+		t.Fatalf("handled keys %v, want %v", keys, want)
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Errorf("handled keys %v, want %v", keys, want)
+			break
+		}
+	}
+}
@@ -19,9 +19,12 @@
 package cell
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
@@ -29,9 +32,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	networkingv1listers "k8s.io/client-go/listers/networking/v1"
 	"k8s.io/client-go/tools/cache"
@@ -39,6 +44,7 @@ import (
 
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/clients"
+	"cellery.io/cellery-controller/pkg/cloudevents"
 	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
 	"cellery.io/cellery-controller/pkg/controller/cell/resources"
@@ -47,11 +53,14 @@ import (
 	v1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
 	istiov1alpha1listers "cellery.io/cellery-controller/pkg/generated/listers/networking/v1alpha3"
 	"cellery.io/cellery-controller/pkg/informers"
+	"cellery.io/cellery-controller/pkg/logging"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
 type reconciler struct {
 	kubeClient                kubernetes.Interface
 	meshClient                meshclientset.Interface
+	controllerRevisionLister  appsv1listers.ControllerRevisionLister
 	secretLister              corev1listers.SecretLister
 	networkPolicyLister       networkingv1listers.NetworkPolicyLister
 	istioVirtualServiceLister istiov1alpha1listers.VirtualServiceLister
@@ -62,6 +71,7 @@ type reconciler struct {
 	tokenServiceLister        v1alpha2listers.TokenServiceLister
 	componentLister           v1alpha2listers.ComponentLister
 	cfg                       config.Interface
+	publisher                 cloudevents.Interface
 	logger                    *zap.SugaredLogger
 	recorder                  record.EventRecorder
 }
@@ -70,23 +80,10 @@ func NewController(
 	clientset clients.Interface,
 	informerset informers.Interface,
 	cfg config.Interface,
+	publisher cloudevents.Interface,
 	logger *zap.SugaredLogger,
 ) *controller.Controller {
-	r := &reconciler{
-		kubeClient:                clientset.Kubernetes(),
-		meshClient:                clientset.Mesh(),
-		cellLister:                informerset.Cells().Lister(),
-		compositeLister:           informerset.Composites().Lister(),
-		componentLister:           informerset.Components().Lister(),
-		gatewayLister:             informerset.Gateways().Lister(),
-		tokenServiceLister:        informerset.TokenServices().Lister(),
-		networkPolicyLister:       informerset.NetworkPolicies().Lister(),
-		secretLister:              informerset.Secrets().Lister(),
-		istioEnvoyFilterLister:    informerset.IstioEnvoyFilters().Lister(),
-		istioVirtualServiceLister: informerset.IstioVirtualServices().Lister(),
-		cfg:                       cfg,
-		logger:                    logger.Named("cell-controller"),
-	}
+	r := newReconciler(clientset, informerset, cfg, publisher, logger)
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(r.logger.Named("events").Infof)
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: r.kubeClient.CoreV1().Events("")})
@@ -96,6 +93,9 @@ func NewController(
 
 	r.logger.Info("Setting up event handlers")
 	informerset.Cells().Informer().AddEventHandler(informers.HandleAll(c.Enqueue))
+	informerset.Cells().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: r.publishDeleted,
+	})
 
 	informerset.Components().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Cell")),
@@ -122,58 +122,150 @@ func NewController(
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
+	informerset.ControllerRevisions().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Cell")),
+		Handler:    informers.HandleAll(c.EnqueueControllerOf),
+	})
+
+	// The configuration of a cell depends on the config overrides of its namespace
+	enqueueNamespace := func(namespace string) {
+		cells, err := r.cellLister.Cells(namespace).List(labels.Everything())
+		if err != nil {
+			r.logger.Errorf("Failed to list the cells of namespace %q: %v", namespace, err)
+			return
+		}
+		for _, cell := range cells {
+			c.Enqueue(cell)
+		}
+	}
+	informerset.ConfigMaps().Informer().AddEventHandler(controller.HandleConfigOverride(enqueueNamespace))
+	informerset.Secrets().Informer().AddEventHandler(controller.HandleConfigOverride(enqueueNamespace))
+
 	return c
 }
 
-func (r *reconciler) Reconcile(key string) error {
-	r.logger.Infof("Reconcile called with %s", key)
+func newReconciler(
+	clientset clients.Interface,
+	informerset informers.Interface,
+	cfg config.Interface,
+	publisher cloudevents.Interface,
+	logger *zap.SugaredLogger,
+) *reconciler {
+	return &reconciler{
+		kubeClient:                clientset.Kubernetes(),
+		meshClient:                clientset.Mesh(),
+		cellLister:                informerset.Cells().Lister(),
+		compositeLister:           informerset.Composites().Lister(),
+		componentLister:           informerset.Components().Lister(),
+		gatewayLister:             informerset.Gateways().Lister(),
+		tokenServiceLister:        informerset.TokenServices().Lister(),
+		networkPolicyLister:       informerset.NetworkPolicies().Lister(),
+		controllerRevisionLister:  informerset.ControllerRevisions().Lister(),
+		secretLister:              informerset.Secrets().Lister(),
+		istioEnvoyFilterLister:    informerset.IstioEnvoyFilters().Lister(),
+		istioVirtualServiceLister: informerset.IstioVirtualServices().Lister(),
+		cfg:                       cfg,
+		publisher:                 publisher,
+		logger:                    logger.Named("cell-controller"),
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, key string) (_ controller.Result, err error) {
+	logger := logging.FromContext(ctx)
+	logger.Infof("Reconcile called with %s", key)
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
-		r.logger.Errorf("invalid resource key: %s", key)
-		return nil
+		logger.Errorf("invalid resource key: %s", key)
+		return controller.Result{}, nil
 	}
 	original, err := r.cellLister.Cells(namespace).Get(name)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			r.logger.Errorf("cell '%s' in work queue no longer exists", key)
-			return nil
+			logger.Errorf("cell '%s' in work queue no longer exists", key)
+			return controller.Result{}, nil
 		}
-		return err
+		return controller.Result{}, err
 	}
 
 	cell := original.DeepCopy()
 
-	if err = r.reconcile(cell); err != nil {
-		r.recorder.Eventf(cell, corev1.EventTypeWarning, "InternalError", "Failed to update cluster: %v", err)
-		return err
+	ctx, span := controller.StartReconcileSpan(ctx, "Cell", cell, cell.Generation != cell.Status.ObservedGeneration)
+	defer func() { span.Finish(err) }()
+
+	if controller.IsPaused(cell) {
+		if cell.Status.SetCondition(v1alpha2.CellReconcilePaused, corev1.ConditionTrue) {
+			r.recorder.Eventf(cell, corev1.EventTypeNormal, "ReconcilePaused", "Reconciliation of Cell %q is paused", cell.Name)
+		}
+	} else {
+		if cell.Status.RemoveCondition(v1alpha2.CellReconcilePaused) {
+			r.recorder.Eventf(cell, corev1.EventTypeNormal, "ReconcileResumed", "Reconciliation of Cell %q is resumed", cell.Name)
+		}
+		if _, ok := cell.Annotations[meta.RollbackToAnnotationKey]; ok {
+			return controller.Result{}, r.rollback(ctx, cell)
+		}
+		if err = r.reconcile(ctx, cell); err != nil {
+			r.recorder.Eventf(cell, corev1.EventTypeWarning, "InternalError", "Failed to update cluster: %v", err)
+			return controller.Result{}, err
+		}
+		cell.Status.RemoveCondition(v1alpha2.CellReconcileFailed)
+	}
+
+	// Do not update the status from a cancelled or timed out reconcile
+	if err = ctx.Err(); err != nil {
+		return controller.Result{}, err
 	}
 
 	if equality.Semantic.DeepEqual(original.Status, cell.Status) {
-		return nil
+		return controller.Result{}, nil
 	}
 
 	if _, err = r.updateStatus(cell); err != nil {
 		r.recorder.Eventf(cell, corev1.EventTypeWarning, "UpdateFailed", "Failed to update status: %v", err)
-		return err
+		return controller.Result{}, err
 	}
 	r.recorder.Eventf(cell, corev1.EventTypeNormal, "Updated", "Updated Cell status %q", cell.GetName())
-	return nil
+	r.publishStatusEvents(original, cell)
+	return controller.Result{}, nil
 }
 
-func (r *reconciler) reconcile(cell *v1alpha2.Cell) error {
-	cell.Default()
+// RecordFailure marks the Cell as failed once its key has exhausted the retries.
+func (r *reconciler) RecordFailure(ctx context.Context, key string, reconcileErr error) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	original, err := r.cellLister.Cells(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+
+	cell := original.DeepCopy()
+	if !cell.Status.SetCondition(v1alpha2.CellReconcileFailed, corev1.ConditionTrue) {
+		return nil
+	}
+	r.recorder.Eventf(cell, corev1.EventTypeWarning, "ReconcileFailed", "Reconciliation of Cell %q failed after retries: %v", name, reconcileErr)
+	r.publisher.Publish(cloudevents.NewEvent(cloudevents.TypeCellReconcileFailed, "Cell", cell).
+		WithReason("ReconcileFailed", reconcileErr.Error()))
+	_, err = r.updateStatus(cell)
+	return err
+}
+
+func (r *reconciler) reconcile(ctx context.Context, cell *v1alpha2.Cell) error {
 	rErrs := &controller.ReconcileErrors{}
+	// The revision holds the spec as applied by the user rather than the defaulted spec
+	rErrs.Add(r.reconcileRevision(ctx, cell))
+	cell.Default()
 
-	rErrs.Add(r.reconcileNetworkPolicy(cell))
-	rErrs.Add(r.reconcileSecret(cell))
-	rErrs.Add(r.reconcileGateway(cell))
-	rErrs.Add(r.reconcileTokenService(cell))
+	rErrs.Add(r.reconcileNetworkPolicy(ctx, cell))
+	rErrs.Add(r.reconcileSecret(ctx, cell))
+	rErrs.Add(r.reconcileGateway(ctx, cell))
+	rErrs.Add(r.reconcileTokenService(ctx, cell))
 
 	for i, _ := range cell.Spec.Components {
-		rErrs.Add(r.reconcileComponent(cell, &cell.Spec.Components[i]))
+		rErrs.Add(r.reconcileComponent(ctx, cell, &cell.Spec.Components[i]))
 	}
 
-	rErrs.Add(r.reconcileRoutingVirtualService(cell))
+	rErrs.Add(r.reconcileRoutingVirtualService(ctx, cell))
 
 	if !rErrs.Empty() {
 		return rErrs
@@ -188,36 +280,93 @@ func (r *reconciler) reconcile(cell *v1alpha2.Cell) error {
 
 	cell.Status.ActiveComponentCount = activeCount
 	cell.Status.ComponentCount = len(cell.Spec.Components)
-	if cell.Status.GatewayStatus == v1alpha2.GatewayCurrentStatusReady &&
-		cell.Status.TokenServiceStatus == v1alpha2.TokenServiceCurrentStatusReady &&
-		cell.Status.ActiveComponentCount == cell.Status.ComponentCount {
-		cell.Status.Status = v1alpha2.CellCurrentStatusReady
-		c := []v1alpha2.CellCondition{
-			{
-				Type:   v1alpha2.CellReady,
-				Status: corev1.ConditionTrue,
-			},
-		}
-		cell.Status.Conditions = c
+	if controller.IsSuspended(cell) {
+		cell.Status.Status = v1alpha2.CellCurrentStatusSuspended
+		cell.Status.SetCondition(v1alpha2.CellReady, corev1.ConditionFalse)
+		if cell.Status.SetCondition(v1alpha2.CellSuspended, corev1.ConditionTrue) {
+			r.recorder.Eventf(cell, corev1.EventTypeNormal, "Suspended", "Cell %q is suspended", cell.Name)
+		}
 	} else {
-		cell.Status.Status = v1alpha2.CellCurrentStatusNotReady
-		c := []v1alpha2.CellCondition{
-			{
-				Type:   v1alpha2.CellReady,
-				Status: corev1.ConditionFalse,
-			},
+		if cell.Status.RemoveCondition(v1alpha2.CellSuspended) {
+			r.recorder.Eventf(cell, corev1.EventTypeNormal, "Resumed", "Cell %q is resumed", cell.Name)
+		}
+		if cell.Status.GatewayStatus == v1alpha2.GatewayCurrentStatusReady &&
+			cell.Status.TokenServiceStatus == v1alpha2.TokenServiceCurrentStatusReady &&
+			cell.Status.ActiveComponentCount == cell.Status.ComponentCount {
+			cell.Status.Status = v1alpha2.CellCurrentStatusReady
+			cell.Status.SetCondition(v1alpha2.CellReady, corev1.ConditionTrue)
+		} else {
+			cell.Status.Status = v1alpha2.CellCurrentStatusNotReady
+			cell.Status.SetCondition(v1alpha2.CellReady, corev1.ConditionFalse)
 		}
-		cell.Status.Conditions = c
 	}
 	cell.Status.ObservedGeneration = cell.Generation
 
 	return nil
 }
 
-func (r *reconciler) reconcileNetworkPolicy(cell *v1alpha2.Cell) error {
+func (r *reconciler) reconcileRevision(ctx context.Context, cell *v1alpha2.Cell) (err error) {
+	revisionName := controller.RevisionName(cell, cell.Spec)
+	_, span := controller.StartStepSpan(ctx, "ControllerRevision", revisionName)
+	defer func() { span.Finish(err) }()
+	revisions, err := controller.ListRevisions(r.controllerRevisionLister, cell, meta.CellLabelKey)
+	if err != nil {
+		return err
+	}
+	revision, created, err := controller.SnapshotRevision(r.kubeClient, revisions, cell, controller.CreateCellOwnerRef(cell),
+		meta.CellLabelKey, cell.Spec, controller.RevisionHistoryLimit(r.cfg.ForNamespace(cell.Namespace)))
+	if err != nil {
+		r.recorder.Eventf(cell, corev1.EventTypeWarning, "CreationFailed", "Failed to create ControllerRevision %q: %v", revisionName, err)
+		return err
+	}
+	if created {
+		controller.SetAction(span, controller.ActionCreate)
+		r.recorder.Eventf(cell, corev1.EventTypeNormal, "Created", "Created ControllerRevision %q", revisionName)
+	} else {
+		controller.SetAction(span, controller.ActionSkip)
+	}
+	cell.Status.CurrentRevision = revision.Name
+	return nil
+}
+
+// rollback restores the spec of the Cell from the revision requested with the rollback annotation.
+// The annotation is removed with the same update so that the restored spec is reconciled next.
+func (r *reconciler) rollback(ctx context.Context, cell *v1alpha2.Cell) (err error) {
+	_, span := controller.StartStepSpan(ctx, "Rollback", cell.Name)
+	defer func() { span.Finish(err) }()
+	revisions, err := controller.ListRevisions(r.controllerRevisionLister, cell, meta.CellLabelKey)
+	if err != nil {
+		return err
+	}
+	desired := cell.DeepCopy()
+	delete(desired.Annotations, meta.RollbackToAnnotationKey)
+	revision, rollbackErr := controller.RollbackRevision(revisions, cell, cell.Status.CurrentRevision)
+	if rollbackErr == nil {
+		desired.Spec = v1alpha2.CellSpec{}
+		rollbackErr = controller.DecodeRevision(revision, &desired.Spec)
+	}
+	if rollbackErr != nil {
+		// Only the annotation is removed so that the current spec is kept
+		desired.Spec = cell.Spec
+		r.recorder.Eventf(cell, corev1.EventTypeWarning, "RollbackFailed", "Failed to roll back Cell %q: %v", cell.Name, rollbackErr)
+	}
+	if _, err = r.meshClient.MeshV1alpha2().Cells(cell.Namespace).Update(desired); err != nil {
+		return err
+	}
+	controller.SetAction(span, controller.ActionUpdate)
+	if rollbackErr == nil {
+		r.recorder.Eventf(cell, corev1.EventTypeNormal, "RolledBack", "Rolled back Cell %q to revision %d", cell.Name, revision.Revision)
+	}
+	return nil
+}
+
+func (r *reconciler) reconcileNetworkPolicy(ctx context.Context, cell *v1alpha2.Cell) (err error) {
 	networkPolicyName := resources.NetworkPolicyName(cell)
+	_, span := controller.StartStepSpan(ctx, "NetworkPolicy", networkPolicyName)
+	defer func() { span.Finish(err) }()
 	networkPolicy, err := r.networkPolicyLister.NetworkPolicies(cell.Namespace).Get(networkPolicyName)
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		networkPolicy, err = r.kubeClient.NetworkingV1().NetworkPolicies(cell.Namespace).Create(resources.MakeNetworkPolicy(cell))
 		if err != nil {
 			r.logger.Errorf("Failed to create NetworkPolicy %q: %v", networkPolicyName, err)
@@ -228,34 +377,46 @@ func (r *reconciler) reconcileNetworkPolicy(cell *v1alpha2.Cell) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve NetworkPolicy %q: %v", networkPolicyName, err)
 		return err
-	} else if !metav1.IsControlledBy(networkPolicy, cell) {
-		return fmt.Errorf("cell: %q does not own the NetworkPolicy: %q", cell.Name, networkPolicyName)
+	} else if !metav1.IsControlledBy(networkPolicy, cell) && !controller.CanAdopt(controller.GetAdoptionPolicy(cell), networkPolicy) {
+		return controller.NewPermanentError(fmt.Errorf("cell: %q does not own the NetworkPolicy: %q", cell.Name, networkPolicyName))
 	} else {
+		adopt := !metav1.IsControlledBy(networkPolicy, cell)
 		networkPolicy, err = func(cell *v1alpha2.Cell, networkPolicy *networkv1.NetworkPolicy) (*networkv1.NetworkPolicy, error) {
-			if !resources.RequireNetworkPolicyUpdate(cell, networkPolicy) {
+			if !adopt && !resources.RequireNetworkPolicyUpdate(cell, networkPolicy) {
+				controller.SetAction(span, controller.ActionSkip)
 				return networkPolicy, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredNetworkPolicy := resources.MakeNetworkPolicy(cell)
 			existingNetworkPolicy := networkPolicy.DeepCopy()
 			resources.CopyNetworkPolicy(desiredNetworkPolicy, existingNetworkPolicy)
+			if adopt {
+				controller.Adopt(existingNetworkPolicy, desiredNetworkPolicy)
+			}
 			return r.kubeClient.NetworkingV1().NetworkPolicies(cell.Namespace).Update(existingNetworkPolicy)
 		}(cell, networkPolicy)
 		if err != nil {
 			r.logger.Errorf("Failed to update NetworkPolicy %q: %v", networkPolicyName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(cell, "NetworkPolicy", networkPolicyName)
+		}
 	}
 	resources.StatusFromNetworkPolicy(cell, networkPolicy)
 	return nil
 }
 
-func (r *reconciler) reconcileSecret(cell *v1alpha2.Cell) error {
+func (r *reconciler) reconcileSecret(ctx context.Context, cell *v1alpha2.Cell) (err error) {
 	secretName := resources.SecretName(cell)
+	_, span := controller.StartStepSpan(ctx, "Secret", secretName)
+	defer func() { span.Finish(err) }()
 	secret, err := r.secretLister.Secrets(cell.Namespace).Get(resources.SecretName(cell))
 
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		secret, err = func(cell *v1alpha2.Cell) (*corev1.Secret, error) {
-			desiredSecret, err := resources.MakeSecret(cell, r.cfg)
+			desiredSecret, err := resources.MakeSecret(cell, r.cfg.ForNamespace(cell.Namespace))
 			if err != nil {
 				return nil, err
 			}
@@ -270,18 +431,50 @@ func (r *reconciler) reconcileSecret(cell *v1alpha2.Cell) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Secret %q: %v", secretName, err)
 		return err
+	} else if !metav1.IsControlledBy(secret, cell) && !controller.CanAdopt(controller.GetAdoptionPolicy(cell), secret) {
+		return controller.NewPermanentError(fmt.Errorf("cell: %q does not own the Secret: %q", cell.Name, secretName))
 	} else if !metav1.IsControlledBy(secret, cell) {
-		return fmt.Errorf("cell: %q does not own the Secret: %q", cell.Name, secretName)
+		controller.SetAction(span, controller.ActionUpdate)
+		existingSecret := secret.DeepCopy()
+		controller.AdoptWithOwnerRef(existingSecret, controller.CreateCellOwnerRef(cell))
+		secret, err = r.kubeClient.CoreV1().Secrets(cell.Namespace).Update(existingSecret)
+		if err != nil {
+			r.logger.Errorf("Failed to adopt Secret %q: %v", secretName, err)
+			return err
+		}
+		r.recordAdoption(cell, "Secret", secretName)
+	} else if cfg := r.cfg.ForNamespace(cell.Namespace); !controller.ConfigHashEqual(secret, controller.CertificateHash(cfg)) {
+		controller.SetAction(span, controller.ActionUpdate)
+		secret, err = func(cell *v1alpha2.Cell, secret *corev1.Secret) (*corev1.Secret, error) {
+			desiredSecret, err := resources.MakeSecret(cell, cfg)
+			if err != nil {
+				return nil, err
+			}
+			existingSecret := secret.DeepCopy()
+			resources.CopySecret(desiredSecret, existingSecret)
+			return r.kubeClient.CoreV1().Secrets(cell.Namespace).Update(existingSecret)
+		}(cell, secret)
+		if err != nil {
+			r.logger.Errorf("Failed to update Secret %q: %v", secretName, err)
+			return err
+		}
+		r.recorder.Eventf(cell, corev1.EventTypeNormal, "Updated", "Updated Secret %q with the keys signed by the current certificate", secretName)
+	} else {
+		controller.SetAction(span, controller.ActionSkip)
 	}
 	resources.StatusFromSecret(cell, secret)
 	return nil
 }
 
-func (r *reconciler) reconcileGateway(cell *v1alpha2.Cell) error {
+func (r *reconciler) reconcileGateway(ctx context.Context, cell *v1alpha2.Cell) (err error) {
 	gatewayName := resources.GatewayName(cell)
+	ctx, span := controller.StartStepSpan(ctx, "Gateway", gatewayName)
+	defer func() { span.Finish(err) }()
 	gateway, err := r.gatewayLister.Gateways(cell.Namespace).Get(gatewayName)
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		gateway = resources.MakeGateway(cell)
+		controller.InjectTraceParent(ctx, gateway)
 		lastAppliedConfig, err := json.Marshal(buildLastAppliedConfig(gateway))
 		if err != nil {
 			r.logger.Errorf("Failed to build Gateway last applied config %v", err)
@@ -298,22 +491,32 @@ func (r *reconciler) reconcileGateway(cell *v1alpha2.Cell) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Gateway %q: %v", gatewayName, err)
 		return err
-	} else if !metav1.IsControlledBy(gateway, cell) {
-		return fmt.Errorf("cell: %q does not own the Gateway: %q", cell.Name, gatewayName)
+	} else if !metav1.IsControlledBy(gateway, cell) && !controller.CanAdopt(controller.GetAdoptionPolicy(cell), gateway) {
+		return controller.NewPermanentError(fmt.Errorf("cell: %q does not own the Gateway: %q", cell.Name, gatewayName))
 	} else {
+		adopt := !metav1.IsControlledBy(gateway, cell)
 		gateway, err = func(cell *v1alpha2.Cell, gateway *v1alpha2.Gateway) (*v1alpha2.Gateway, error) {
-			if !resources.RequireGatewayUpdate(cell, gateway) {
+			if !adopt && !resources.RequireGatewayUpdate(cell, gateway) {
+				controller.SetAction(span, controller.ActionSkip)
 				return gateway, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredGateway := resources.MakeGateway(cell)
+			controller.InjectTraceParent(ctx, desiredGateway)
 			existingGateway := gateway.DeepCopy()
 			resources.CopyGateway(desiredGateway, existingGateway)
+			if adopt {
+				controller.Adopt(existingGateway, desiredGateway)
+			}
 			return r.meshClient.MeshV1alpha2().Gateways(cell.Namespace).Update(existingGateway)
 		}(cell, gateway)
 		if err != nil {
 			r.logger.Errorf("Failed to update Gateway %q: %v", gatewayName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(cell, "Gateway", gatewayName)
+		}
 	}
 	resources.StatusFromGateway(cell, gateway)
 	return nil
@@ -367,11 +570,16 @@ func annotate(gw *v1alpha2.Gateway, name string, value string) {
 	gw.Annotations = annotations
 }
 
-func (r *reconciler) reconcileTokenService(cell *v1alpha2.Cell) error {
+func (r *reconciler) reconcileTokenService(ctx context.Context, cell *v1alpha2.Cell) (err error) {
 	tokenServiceName := resources.TokenServiceName(cell)
+	ctx, span := controller.StartStepSpan(ctx, "TokenService", tokenServiceName)
+	defer func() { span.Finish(err) }()
 	tokenService, err := r.tokenServiceLister.TokenServices(cell.Namespace).Get(tokenServiceName)
 	if errors.IsNotFound(err) {
-		tokenService, err = r.meshClient.MeshV1alpha2().TokenServices(cell.Namespace).Create(resources.MakeTokenService(cell))
+		controller.SetAction(span, controller.ActionCreate)
+		desiredTokenService := resources.MakeTokenService(cell)
+		controller.InjectTraceParent(ctx, desiredTokenService)
+		tokenService, err = r.meshClient.MeshV1alpha2().TokenServices(cell.Namespace).Create(desiredTokenService)
 		if err != nil {
 			r.logger.Errorf("Failed to create TokenService %q: %v", tokenServiceName, err)
 			r.recorder.Eventf(cell, corev1.EventTypeWarning, "CreationFailed", "Failed to create TokenService %q: %v", tokenServiceName, err)
@@ -381,32 +589,47 @@ func (r *reconciler) reconcileTokenService(cell *v1alpha2.Cell) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve TokenService %q: %v", tokenServiceName, err)
 		return err
-	} else if !metav1.IsControlledBy(tokenService, cell) {
-		return fmt.Errorf("cell: %q does not own the TokenService: %q", cell.Name, tokenServiceName)
+	} else if !metav1.IsControlledBy(tokenService, cell) && !controller.CanAdopt(controller.GetAdoptionPolicy(cell), tokenService) {
+		return controller.NewPermanentError(fmt.Errorf("cell: %q does not own the TokenService: %q", cell.Name, tokenServiceName))
 	} else {
+		adopt := !metav1.IsControlledBy(tokenService, cell)
 		tokenService, err = func(cell *v1alpha2.Cell, tokenService *v1alpha2.TokenService) (*v1alpha2.TokenService, error) {
-			if !resources.RequireTokenServiceUpdate(cell, tokenService) {
+			if !adopt && !resources.RequireTokenServiceUpdate(cell, tokenService) {
+				controller.SetAction(span, controller.ActionSkip)
 				return tokenService, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredTokenService := resources.MakeTokenService(cell)
+			controller.InjectTraceParent(ctx, desiredTokenService)
 			existingTokenService := tokenService.DeepCopy()
 			resources.CopyTokenService(desiredTokenService, existingTokenService)
+			if adopt {
+				controller.Adopt(existingTokenService, desiredTokenService)
+			}
 			return r.meshClient.MeshV1alpha2().TokenServices(cell.Namespace).Update(existingTokenService)
 		}(cell, tokenService)
 		if err != nil {
 			r.logger.Errorf("Failed to update TokenService %q: %v", tokenServiceName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(cell, "TokenService", tokenServiceName)
+		}
 	}
 	resources.StatusFromTokenService(cell, tokenService)
 	return nil
 }
 
-func (r *reconciler) reconcileComponent(cell *v1alpha2.Cell, componentTemplate *v1alpha2.Component) error {
+func (r *reconciler) reconcileComponent(ctx context.Context, cell *v1alpha2.Cell, componentTemplate *v1alpha2.Component) (err error) {
 	componentName := resources.ComponentName(cell, componentTemplate)
+	ctx, span := controller.StartStepSpan(ctx, "Component", componentName)
+	defer func() { span.Finish(err) }()
 	component, err := r.componentLister.Components(cell.Namespace).Get(componentName)
 	if errors.IsNotFound(err) {
-		component, err = r.meshClient.MeshV1alpha2().Components(cell.Namespace).Create(resources.MakeComponent(cell, componentTemplate))
+		controller.SetAction(span, controller.ActionCreate)
+		desiredComponent := resources.MakeComponent(cell, componentTemplate)
+		controller.InjectTraceParent(ctx, desiredComponent)
+		component, err = r.meshClient.MeshV1alpha2().Components(cell.Namespace).Create(desiredComponent)
 		if err != nil {
 			r.logger.Errorf("Failed to create Component %q: %v", componentName, err)
 			r.recorder.Eventf(cell, corev1.EventTypeWarning, "CreationFailed", "Failed to create Component %q: %v", componentName, err)
@@ -416,22 +639,32 @@ func (r *reconciler) reconcileComponent(cell *v1alpha2.Cell, componentTemplate *
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Component %q: %v", componentName, err)
 		return err
-	} else if !metav1.IsControlledBy(component, cell) {
-		return fmt.Errorf("cell: %q does not own the Component: %q", cell.Name, componentName)
+	} else if !metav1.IsControlledBy(component, cell) && !controller.CanAdopt(controller.GetAdoptionPolicy(cell), component) {
+		return controller.NewPermanentError(fmt.Errorf("cell: %q does not own the Component: %q", cell.Name, componentName))
 	} else {
+		adopt := !metav1.IsControlledBy(component, cell)
 		component, err = func(cell *v1alpha2.Cell, component *v1alpha2.Component) (*v1alpha2.Component, error) {
-			if !resources.RequireComponentUpdate(cell, component) {
+			if !adopt && !resources.RequireComponentUpdate(cell, component) {
+				controller.SetAction(span, controller.ActionSkip)
 				return component, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredComponent := resources.MakeComponent(cell, componentTemplate)
+			controller.InjectTraceParent(ctx, desiredComponent)
 			existingComponent := component.DeepCopy()
 			resources.CopyComponent(desiredComponent, existingComponent)
+			if adopt {
+				controller.Adopt(existingComponent, desiredComponent)
+			}
 			return r.meshClient.MeshV1alpha2().Components(cell.Namespace).Update(existingComponent)
 		}(cell, component)
 		if err != nil {
 			r.logger.Errorf("Failed to update Component %q: %v", componentName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(cell, "Component", componentName)
+		}
 	}
 	resources.StatusFromComponent(cell, component)
 	return nil
@@ -473,10 +706,13 @@ func (r *reconciler) reconcileComponent(cell *v1alpha2.Cell, componentTemplate *
 // 	return nil
 // }
 
-func (r *reconciler) reconcileRoutingVirtualService(cell *v1alpha2.Cell) error {
+func (r *reconciler) reconcileRoutingVirtualService(ctx context.Context, cell *v1alpha2.Cell) (err error) {
 	name := routing.RoutingVirtualServiceName(cell.Name)
+	_, span := controller.StartStepSpan(ctx, "VirtualService", name)
+	defer func() { span.Finish(err) }()
 	routingVs, err := r.istioVirtualServiceLister.VirtualServices(cell.Namespace).Get(name)
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		routingVs, err = resources.MakeRoutingVirtualService(cell, r.cellLister, r.compositeLister)
 		if err != nil {
 			r.logger.Errorf("Failed to create Cell VS object %v for instance %s", err, cell.Name)
@@ -504,9 +740,20 @@ func (r *reconciler) reconcileRoutingVirtualService(cell *v1alpha2.Cell) error {
 		r.recorder.Eventf(cell, corev1.EventTypeNormal, "Created", "Created Virtual Service %q", name)
 	} else if err != nil {
 		return err
+	} else if !metav1.IsControlledBy(routingVs, cell) && !controller.CanAdopt(controller.GetAdoptionPolicy(cell), routingVs) {
+		return controller.NewPermanentError(fmt.Errorf("cell: %q does not own the VS: %q", cell.Name, routingVs.Name))
 	} else if !metav1.IsControlledBy(routingVs, cell) {
-		return fmt.Errorf("cell: %q does not own the VS: %q", cell.Name, routingVs)
+		controller.SetAction(span, controller.ActionUpdate)
+		existingRoutingVs := routingVs.DeepCopy()
+		controller.AdoptWithOwnerRef(existingRoutingVs, controller.CreateCellOwnerRef(cell))
+		routingVs, err = r.meshClient.NetworkingV1alpha3().VirtualServices(cell.Namespace).Update(existingRoutingVs)
+		if err != nil {
+			r.logger.Errorf("Failed to adopt VirtualService %q: %v", existingRoutingVs.Name, err)
+			return err
+		}
+		r.recordAdoption(cell, "VirtualService", routingVs.Name)
 	} else {
+		controller.SetAction(span, controller.ActionSkip)
 		// TODO: find a better solution
 		//routingVs, err = func(cell *v1alpha2.Cell, routingVs *v1alpha3.VirtualService) (*v1alpha3.VirtualService, error) {
 		//	if !resources.RequireRoutingVsUpdate(cell, routingVs) {
@@ -535,6 +782,12 @@ func isEqual(oldService *v1alpha2.Component, newService *v1alpha2.Component) boo
 	return reflect.DeepEqual(oldService.Spec, newService.Spec)
 }
 
+// recordAdoption records the adoption of an existing resource in the events and the status of the cell.
+func (r *reconciler) recordAdoption(cell *v1alpha2.Cell, kind string, name string) {
+	r.recorder.Eventf(cell, corev1.EventTypeNormal, "Adopted", "Adopted %s %q", kind, name)
+	cell.Status.AdoptedResources = controller.AddAdoptedResource(cell.Status.AdoptedResources, kind, name)
+}
+
 func (r *reconciler) updateStatus(desired *v1alpha2.Cell) (*v1alpha2.Cell, error) {
 	cell, err := r.cellLister.Cells(desired.Namespace).Get(desired.Name)
 	if err != nil {
@@ -547,3 +800,52 @@ func (r *reconciler) updateStatus(desired *v1alpha2.Cell) (*v1alpha2.Cell, error
 	}
 	return desired, nil
 }
+
+// publishStatusEvents publishes the lifecycle events of the cell derived from the status change.
+func (r *reconciler) publishStatusEvents(original *v1alpha2.Cell, cell *v1alpha2.Cell) {
+	if original.Status.ObservedGeneration == 0 && cell.Status.ObservedGeneration != 0 {
+		r.publisher.Publish(cloudevents.NewEvent(cloudevents.TypeCellCreated, "Cell", cell))
+	}
+	if original.Status.Status == cell.Status.Status {
+		return
+	}
+	switch cell.Status.Status {
+	case v1alpha2.CellCurrentStatusReady:
+		r.publisher.Publish(cloudevents.NewEvent(cloudevents.TypeCellReady, "Cell", cell))
+	case v1alpha2.CellCurrentStatusNotReady:
+		reason, message := notReadyReason(cell)
+		r.publisher.Publish(cloudevents.NewEvent(cloudevents.TypeCellNotReady, "Cell", cell).WithReason(reason, message))
+	}
+}
+
+// publishDeleted publishes the deleted event of a cell removed from the informer cache.
+func (r *reconciler) publishDeleted(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	cell, ok := obj.(*v1alpha2.Cell)
+	if !ok {
+		return
+	}
+	r.publisher.Publish(cloudevents.NewEvent(cloudevents.TypeCellDeleted, "Cell", cell))
+}
+
+// notReadyReason returns why the cell is not ready.
+func notReadyReason(cell *v1alpha2.Cell) (string, string) {
+	switch {
+	case cell.Status.GatewayStatus != v1alpha2.GatewayCurrentStatusReady:
+		return "GatewayNotReady", fmt.Sprintf("Gateway of the cell is %s", cell.Status.GatewayStatus)
+	case cell.Status.TokenServiceStatus != v1alpha2.TokenServiceCurrentStatusReady:
+		return "TokenServiceNotReady", fmt.Sprintf("Token service of the cell is %s", cell.Status.TokenServiceStatus)
+	default:
+		var notReady []string
+		for name, status := range cell.Status.ComponentStatuses {
+			if status != v1alpha2.ComponentCurrentStatusReady && status != v1alpha2.ComponentCurrentStatusIdle {
+				notReady = append(notReady, name)
+			}
+		}
+		sort.Strings(notReady)
+		return "ComponentsNotReady", fmt.Sprintf("%d of %d components are active, not ready: %s",
+			cell.Status.ActiveComponentCount, cell.Status.ComponentCount, strings.Join(notReady, ", "))
+	}
+}
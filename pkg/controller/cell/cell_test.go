@@ -0,0 +1,440 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cell
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	fakeclients "cellery.io/cellery-controller/pkg/clients/fake"
+	"cellery.io/cellery-controller/pkg/cloudevents"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	"cellery.io/cellery-controller/pkg/controller"
+	fakeinformers "cellery.io/cellery-controller/pkg/informers/fake"
+	"cellery.io/cellery-controller/pkg/meta"
+	. "cellery.io/cellery-controller/pkg/testing/apis/apps/v1"
+	. "cellery.io/cellery-controller/pkg/testing/apis/core/v1"
+	. "cellery.io/cellery-controller/pkg/testing/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/testing/table"
+)
+
+func newTestReconciler(clients *fakeclients.Clients, informers *fakeinformers.Informers, cfg config.Interface,
+	recorder record.EventRecorder) controller.Reconciler {
+	r := newReconciler(clients, informers, cfg, &cloudevents.Deferred{}, zap.NewNop().Sugar())
+	r.recorder = recorder
+	return r
+}
+
+func testCell(opt ...CellOption) *v1alpha2.Cell {
+	return Cell("foo", "bar", append([]CellOption{
+		WithCellGateway(Gateway("", "", WithGatewayHTTPRoute("/hello", true, "hello", 80))),
+		WithCellComponent(Component("hello", "",
+			WithComponentPodSpec(PodSpec(WithPodSpecContainer(Container(WithContainerImage("busybox:v1.2.3"))))),
+			WithComponentPortMaping("http", "HTTP", 80, "", 8080),
+		)),
+	}, opt...)...)
+}
+
+// previousCell returns the Cell with an additional component of the given image.
+func previousCell(image string) *v1alpha2.Cell {
+	return testCell(WithCellComponent(Component("legacy", "",
+		WithComponentPodSpec(PodSpec(WithPodSpecContainer(Container(WithContainerImage(image))))),
+	)))
+}
+
+func testRevision(cell *v1alpha2.Cell, number int64) *appsv1.ControllerRevision {
+	return ControllerRevision(controller.RevisionName(cell, cell.Spec), cell.Namespace,
+		WithControllerRevisionLabels(map[string]string{meta.CellLabelKey: cell.Name}),
+		WithControllerRevisionOwnerReference(OwnerReferenceCellFunc(cell.Name)),
+		WithControllerRevisionNumber(number),
+		WithControllerRevisionSpec(cell.Spec),
+	)
+}
+
+func TestReconcile(t *testing.T) {
+	currentRevision := controller.RevisionName(testCell(), testCell().Spec)
+	createdStatus := v1alpha2.CellStatus{
+		ComponentCount:       1,
+		Status:               v1alpha2.CellCurrentStatusNotReady,
+		ComponentStatuses:    map[string]v1alpha2.ComponentCurrentStatus{"foo--hello": ""},
+		ComponentGenerations: map[string]int64{"foo--hello": 0},
+		CurrentRevision:      currentRevision,
+		Conditions: []v1alpha2.CellCondition{
+			{Type: v1alpha2.CellReady, Status: corev1.ConditionFalse},
+		},
+	}
+	suspendedStatus := *createdStatus.DeepCopy()
+	suspendedStatus.Status = v1alpha2.CellCurrentStatusSuspended
+	suspendedStatus.Conditions = append(suspendedStatus.Conditions,
+		v1alpha2.CellCondition{Type: v1alpha2.CellSuspended, Status: corev1.ConditionTrue})
+	adoptedStatus := *createdStatus.DeepCopy()
+	adoptedStatus.AdoptedResources = []string{"Secret/foo--secret"}
+	legacyOwner := metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "legacy"}
+	otherOwner := OwnerReferenceCellFunc("baz")
+	otherOwner.UID = "baz"
+	createdEvents := []string{
+		fmt.Sprintf(`Normal Created Created ControllerRevision %q`, currentRevision),
+		`Normal Created Created NetworkPolicy "foo--network"`,
+		`Normal Created Created Secret "foo--secret"`,
+		`Normal Created Created Gateway "foo--gateway"`,
+		`Normal Created Created TokenService "foo--sts"`,
+		`Normal Created Created Component "foo--hello"`,
+		`Normal Updated Updated Cell status "foo"`,
+	}
+
+	table.Table{
+		{
+			Name: "invalid key",
+			Key:  "foo/bar/baz",
+		},
+		{
+			Name: "non existing key",
+			Key:  "bar/foo",
+		},
+		{
+			Name:    "create cell resources",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testCell()},
+			WantStatusUpdates: []runtime.Object{
+				testCell(WithCellStatus(createdStatus)),
+			},
+			WantEvents: createdEvents,
+			Golden:     "create-cell-resources",
+		},
+		{
+			Name:   "prune revisions beyond the history limit",
+			Key:    "bar/foo",
+			Config: map[string]string{config.ConfigMapKeyRevisionHistoryLimit: "1"},
+			Objects: []runtime.Object{
+				testCell(),
+				testRevision(previousCell("busybox:v1.0.0"), 1),
+				testRevision(previousCell("busybox:v1.1.0"), 2),
+			},
+			WantStatusUpdates: []runtime.Object{
+				testCell(WithCellStatus(createdStatus)),
+			},
+			WantDeletes: []table.Delete{{
+				Resource:  "controllerrevisions",
+				Namespace: "bar",
+				Name:      controller.RevisionName(previousCell("busybox:v1.0.0"), previousCell("busybox:v1.0.0").Spec),
+			}},
+			WantEvents: createdEvents,
+			Golden:     "prune-cell-revisions",
+		},
+		{
+			Name:    "suspend a cell",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testCell(WithCellAnnotation(meta.SuspendedAnnotationKey, meta.SuspendedValue))},
+			WantStatusUpdates: []runtime.Object{
+				testCell(WithCellAnnotation(meta.SuspendedAnnotationKey, meta.SuspendedValue), WithCellStatus(suspendedStatus)),
+			},
+			WantEvents: []string{
+				fmt.Sprintf(`Normal Created Created ControllerRevision %q`, currentRevision),
+				`Normal Created Created NetworkPolicy "foo--network"`,
+				`Normal Created Created Secret "foo--secret"`,
+				`Normal Created Created Gateway "foo--gateway"`,
+				`Normal Created Created TokenService "foo--sts"`,
+				`Normal Created Created Component "foo--hello"`,
+				`Normal Suspended Cell "foo" is suspended`,
+				`Normal Updated Updated Cell status "foo"`,
+			},
+			// The suspension is not copied to the components
+			Golden: "create-cell-resources",
+		},
+		{
+			Name: "adopt a secret without a controller",
+			Key:  "bar/foo",
+			Objects: []runtime.Object{
+				testCell(WithCellAnnotation(meta.AdoptAnnotationKey, meta.AdoptAlwaysValue)),
+				Secret("foo--secret", "bar", WithSecretOwnerReference(legacyOwner)),
+			},
+			WantUpdates: []runtime.Object{
+				Secret("foo--secret", "bar", WithSecretOwnerReference(legacyOwner), WithSecretOwnerReference(OwnerReferenceCellFunc("foo"))),
+			},
+			WantStatusUpdates: []runtime.Object{
+				testCell(WithCellAnnotation(meta.AdoptAnnotationKey, meta.AdoptAlwaysValue), WithCellStatus(adoptedStatus)),
+			},
+			WantEvents: []string{
+				fmt.Sprintf(`Normal Created Created ControllerRevision %q`, currentRevision),
+				`Normal Created Created NetworkPolicy "foo--network"`,
+				`Normal Adopted Adopted Secret "foo--secret"`,
+				`Normal Created Created Gateway "foo--gateway"`,
+				`Normal Created Created TokenService "foo--sts"`,
+				`Normal Created Created Component "foo--hello"`,
+				`Normal Updated Updated Cell status "foo"`,
+			},
+			Golden: "adopt-cell-resources",
+		},
+		{
+			Name: "never adopt a secret controlled by another object",
+			Key:  "bar/foo",
+			Objects: []runtime.Object{
+				testCell(WithCellAnnotation(meta.AdoptAnnotationKey, meta.AdoptAlwaysValue)),
+				Secret("foo--secret", "bar", WithSecretOwnerReference(otherOwner)),
+			},
+			WantErr: true,
+			WantEvents: []string{
+				fmt.Sprintf(`Normal Created Created ControllerRevision %q`, currentRevision),
+				`Normal Created Created NetworkPolicy "foo--network"`,
+				`Normal Created Created Gateway "foo--gateway"`,
+				`Normal Created Created TokenService "foo--sts"`,
+				`Normal Created Created Component "foo--hello"`,
+				`Warning InternalError Failed to update cluster: cell: "foo" does not own the Secret: "foo--secret"`,
+			},
+			Golden: "adopt-cell-resources",
+		},
+		{
+			Name: "rollback to a previous revision",
+			Key:  "bar/foo",
+			Objects: []runtime.Object{
+				testCell(
+					WithCellAnnotation(meta.RollbackToAnnotationKey, "1"),
+					WithCellStatus(v1alpha2.CellStatus{CurrentRevision: currentRevision}),
+				),
+				testRevision(previousCell("busybox:v1.0.0"), 1),
+				testRevision(testCell(), 2),
+			},
+			WantUpdates: []runtime.Object{
+				CellWith(previousCell("busybox:v1.0.0"), WithCellStatus(v1alpha2.CellStatus{CurrentRevision: currentRevision})),
+			},
+			WantEvents: []string{
+				`Normal RolledBack Rolled back Cell "foo" to revision 1`,
+			},
+		},
+		{
+			Name: "rollback to the revision before the current one",
+			Key:  "bar/foo",
+			Objects: []runtime.Object{
+				testCell(
+					WithCellAnnotation(meta.RollbackToAnnotationKey, "0"),
+					WithCellStatus(v1alpha2.CellStatus{CurrentRevision: currentRevision}),
+				),
+				testRevision(previousCell("busybox:v1.0.0"), 1),
+				testRevision(previousCell("busybox:v1.1.0"), 2),
+				testRevision(testCell(), 3),
+			},
+			WantUpdates: []runtime.Object{
+				CellWith(previousCell("busybox:v1.1.0"), WithCellStatus(v1alpha2.CellStatus{CurrentRevision: currentRevision})),
+			},
+			WantEvents: []string{
+				`Normal RolledBack Rolled back Cell "foo" to revision 2`,
+			},
+		},
+		{
+			Name: "rollback to a missing revision",
+			Key:  "bar/foo",
+			Objects: []runtime.Object{
+				testCell(WithCellAnnotation(meta.RollbackToAnnotationKey, "5")),
+				testRevision(testCell(), 1),
+			},
+			WantUpdates: []runtime.Object{
+				testCell(),
+			},
+			WantEvents: []string{
+				`Warning RollbackFailed Failed to roll back Cell "foo": revision 5 is not found`,
+			},
+		},
+		{
+			Name:    "pause reconciliation",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testCell(WithCellAnnotation(meta.ReconcileAnnotationKey, meta.ReconcilePausedValue))},
+			WantStatusUpdates: []runtime.Object{
+				testCell(
+					WithCellAnnotation(meta.ReconcileAnnotationKey, meta.ReconcilePausedValue),
+					WithCellStatus(v1alpha2.CellStatus{
+						Conditions: []v1alpha2.CellCondition{
+							{Type: v1alpha2.CellReconcilePaused, Status: corev1.ConditionTrue},
+						},
+					}),
+				),
+			},
+			WantEvents: []string{
+				`Normal ReconcilePaused Reconciliation of Cell "foo" is paused`,
+				`Normal Updated Updated Cell status "foo"`,
+			},
+		},
+		{
+			Name:    "gateway creation failure",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testCell()},
+			WithReactors: []table.Reactor{{
+				Verb:     "create",
+				Resource: "gateways",
+				Reaction: func(action clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("inducing failure for create gateways")
+				},
+			}},
+			WantErr: true,
+			WantEvents: []string{
+				fmt.Sprintf(`Normal Created Created ControllerRevision %q`, currentRevision),
+				`Normal Created Created NetworkPolicy "foo--network"`,
+				`Normal Created Created Secret "foo--secret"`,
+				`Warning CreationFailed Failed to create Gateway "foo--gateway": inducing failure for create gateways`,
+				`Normal Created Created TokenService "foo--sts"`,
+				`Normal Created Created Component "foo--hello"`,
+				"Warning InternalError Failed to update cluster: inducing failure for create gateways",
+			},
+			// The failed create of the Gateway is recorded as well
+			Golden: "create-cell-resources",
+		},
+	}.Test(t, newTestReconciler)
+}
+
+func TestReconcileSecretConfigHash(t *testing.T) {
+	cfg := fakeconfig.New(nil)
+	tests := []struct {
+		name       string
+		hash       string
+		wantUpdate bool
+	}{
+		{
+			name:       "secret signed with the current certificate",
+			hash:       controller.CertificateHash(cfg),
+			wantUpdate: false,
+		},
+		{
+			name:       "secret signed with a previous certificate",
+			hash:       "previous",
+			wantUpdate: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			secret := Secret("foo--secret", "bar", WithSecretOwnerReference(OwnerReferenceCellFunc("foo")),
+				WithSecretData("key.pem", []byte("previous")))
+			secret.Annotations = map[string]string{meta.ConfigHashAnnotationKey: test.hash}
+			clients := fakeclients.New(secret)
+			informers := fakeinformers.New(clients, 0, secret)
+			r := newReconciler(clients, informers, cfg, &cloudevents.Deferred{}, zap.NewNop().Sugar())
+			r.recorder = record.NewFakeRecorder(1)
+
+			if err := r.reconcileSecret(context.Background(), testCell()); err != nil {
+				t.Fatalf("reconcileSecret() error = %v", err)
+			}
+
+			clients.ProcessActions()
+			updates := clients.GetUpdateActions()
+			if got := len(updates) > 0; got != test.wantUpdate {
+				t.Fatalf("secret updated = %v, want %v", got, test.wantUpdate)
+			}
+			if !test.wantUpdate {
+				return
+			}
+			updated := updates[0].GetObject().(*corev1.Secret)
+			if !controller.ConfigHashEqual(updated, controller.CertificateHash(cfg)) {
+				t.Errorf("config hash of the updated secret = %q, want %q",
+					updated.Annotations[meta.ConfigHashAnnotationKey], controller.CertificateHash(cfg))
+			}
+			if string(updated.Data["key.pem"]) == "previous" {
+				t.Errorf("key of the updated secret is not regenerated")
+			}
+		})
+	}
+}
+
+func TestNotReadyReason(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      v1alpha2.CellStatus
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name: "gateway not ready",
+			status: v1alpha2.CellStatus{
+				GatewayStatus:      v1alpha2.GatewayCurrentStatusNotReady,
+				TokenServiceStatus: v1alpha2.TokenServiceCurrentStatusReady,
+			},
+			wantReason:  "GatewayNotReady",
+			wantMessage: "Gateway of the cell is NotReady",
+		},
+		{
+			name: "token service not ready",
+			status: v1alpha2.CellStatus{
+				GatewayStatus:      v1alpha2.GatewayCurrentStatusReady,
+				TokenServiceStatus: v1alpha2.TokenServiceCurrentStatusNotReady,
+			},
+			wantReason:  "TokenServiceNotReady",
+			wantMessage: "Token service of the cell is NotReady",
+		},
+		{
+			name: "components not ready",
+			status: v1alpha2.CellStatus{
+				GatewayStatus:        v1alpha2.GatewayCurrentStatusReady,
+				TokenServiceStatus:   v1alpha2.TokenServiceCurrentStatusReady,
+				ComponentCount:       3,
+				ActiveComponentCount: 1,
+				ComponentStatuses: map[string]v1alpha2.ComponentCurrentStatus{
+					"hello":   v1alpha2.ComponentCurrentStatusReady,
+					"world":   v1alpha2.ComponentCurrentStatusNotReady,
+					"another": v1alpha2.ComponentCurrentStatusNotReady,
+				},
+			},
+			wantReason:  "ComponentsNotReady",
+			wantMessage: "1 of 3 components are active, not ready: another, world",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reason, message := notReadyReason(&v1alpha2.Cell{Status: test.status})
+			if reason != test.wantReason || message != test.wantMessage {
+				t.Errorf("got (%q, %q), want (%q, %q)", reason, message, test.wantReason, test.wantMessage)
+			}
+		})
+	}
+}
+
+type recordingPublisher struct {
+	events []cloudevents.Event
+}
+
+func (p *recordingPublisher) Publish(event cloudevents.Event) {
+	p.events = append(p.events, event)
+}
+
+func TestPublishDeleted(t *testing.T) {
+	publisher := &recordingPublisher{}
+	r := &reconciler{publisher: publisher}
+
+	r.publishDeleted(testCell())
+	r.publishDeleted(cache.DeletedFinalStateUnknown{Key: "bar/foo", Obj: testCell()})
+	r.publishDeleted(cache.DeletedFinalStateUnknown{Key: "bar/foo"})
+
+	if len(publisher.events) != 2 {
+		t.Fatalf("got %d events, want 2", len(publisher.events))
+	}
+	for _, event := range publisher.events {
+		if event.Type != cloudevents.TypeCellDeleted || event.Subject != "bar/foo" {
+			t.Errorf("got event %s of %s, want %s of bar/foo", event.Type, event.Subject, cloudevents.TypeCellDeleted)
+		}
+	}
+}
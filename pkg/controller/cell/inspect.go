@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package cell
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/controller/cell/resources"
+	routing "cellery.io/cellery-controller/pkg/controller/routing"
+)
+
+// Inspect returns the desired child resources of the cell along with the ones held by the listers.
+func (r *reconciler) Inspect(key string) ([]controller.ResourceState, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, err
+	}
+	original, err := r.cellLister.Cells(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	cell := original.DeepCopy()
+	cell.Default()
+
+	var states []controller.ResourceState
+
+	networkPolicyName := resources.NetworkPolicyName(cell)
+	networkPolicy, err := r.networkPolicyLister.NetworkPolicies(cell.Namespace).Get(networkPolicyName)
+	states = append(states, controller.NewResourceState("NetworkPolicy", networkPolicyName,
+		resources.MakeNetworkPolicy(cell), nil, networkPolicy, err))
+
+	secretName := resources.SecretName(cell)
+	desiredSecret, desiredErr := resources.MakeSecret(cell, r.cfg.ForNamespace(cell.Namespace))
+	secret, err := r.secretLister.Secrets(cell.Namespace).Get(secretName)
+	states = append(states, controller.NewResourceState("Secret", secretName,
+		controller.RedactSecret(desiredSecret), desiredErr, controller.RedactSecret(secret), err))
+
+	gatewayName := resources.GatewayName(cell)
+	gateway, err := r.gatewayLister.Gateways(cell.Namespace).Get(gatewayName)
+	states = append(states, controller.NewResourceState("Gateway", gatewayName,
+		resources.MakeGateway(cell), nil, gateway, err))
+
+	tokenServiceName := resources.TokenServiceName(cell)
+	tokenService, err := r.tokenServiceLister.TokenServices(cell.Namespace).Get(tokenServiceName)
+	states = append(states, controller.NewResourceState("TokenService", tokenServiceName,
+		resources.MakeTokenService(cell), nil, tokenService, err))
+
+	for i := range cell.Spec.Components {
+		componentTemplate := &cell.Spec.Components[i]
+		componentName := resources.ComponentName(cell, componentTemplate)
+		component, err := r.componentLister.Components(cell.Namespace).Get(componentName)
+		states = append(states, controller.NewResourceState("Component", componentName,
+			resources.MakeComponent(cell, componentTemplate), nil, component, err))
+	}
+
+	virtualServiceName := routing.RoutingVirtualServiceName(cell.Name)
+	desiredVirtualService, desiredErr := resources.MakeRoutingVirtualService(cell, r.cellLister, r.compositeLister)
+	virtualService, err := r.istioVirtualServiceLister.VirtualServices(cell.Namespace).Get(virtualServiceName)
+	states = append(states, controller.NewResourceState("VirtualService", virtualServiceName,
+		desiredVirtualService, desiredErr, virtualService, err))
+
+	return states, nil
+}
@@ -56,12 +56,16 @@ func makeLabels(cell *v1alpha2.Cell) map[string]string {
 }
 
 func makeAnnotations(cell *v1alpha2.Cell) map[string]string {
-	return UnionMaps(
+	annotations := UnionMaps(
 		map[string]string{
 			IstioSidecarInjectAnnotationKey: "false",
 		},
 		cell.Annotations,
 	)
+	// Components follow the suspension of the cell, the annotation is not copied since the components
+	// are not updated when it is removed
+	delete(annotations, SuspendedAnnotationKey)
+	return annotations
 }
 
 func NetworkPolicyName(cell *v1alpha2.Cell) string {
@@ -35,6 +35,7 @@ import (
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
 func MakeSecret(cell *v1alpha2.Cell, cfg config.Interface) (*corev1.Secret, error) {
@@ -84,6 +85,9 @@ func MakeSecret(cell *v1alpha2.Cell, cfg config.Interface) (*corev1.Secret, erro
 			Name:      SecretName(cell),
 			Namespace: cell.Namespace,
 			Labels:    makeLabels(cell),
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: controller.CertificateHash(cfg),
+			},
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateCellOwnerRef(cell),
 			},
@@ -98,6 +102,12 @@ func MakeSecret(cell *v1alpha2.Cell, cfg config.Interface) (*corev1.Secret, erro
 	}, nil
 }
 
+func CopySecret(source, destination *corev1.Secret) {
+	destination.Data = source.Data
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
 func StatusFromSecret(cell *v1alpha2.Cell, secret *corev1.Secret) {
 	cell.Status.SecretGeneration = secret.Generation
 }
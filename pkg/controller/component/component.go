@@ -19,86 +19,96 @@
 package component
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
-	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	appsv1listers "k8s.io/client-go/listers/apps/v1"
-	autoscalingv2beta1lister "k8s.io/client-go/listers/autoscaling/v2beta1"
 	batchv1listers "k8s.io/client-go/listers/batch/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
 	istioauthenticationv1alpha1 "cellery.io/cellery-controller/pkg/apis/istio/authentication/v1alpha1"
 	istionetworkingv1alpha3 "cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	istiosecurityv1beta1 "cellery.io/cellery-controller/pkg/apis/istio/security/v1beta1"
+	kedav1alpha1 "cellery.io/cellery-controller/pkg/apis/keda/v1alpha1"
+	servingv1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/clients"
+	"cellery.io/cellery-controller/pkg/cloudevents"
 	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
 	"cellery.io/cellery-controller/pkg/controller/component/resources"
 	meshclient "cellery.io/cellery-controller/pkg/generated/clientset/versioned"
 	istioauthenticationv1alpha1listers "cellery.io/cellery-controller/pkg/generated/listers/authentication/v1alpha1"
+	autoscalingv2listers "cellery.io/cellery-controller/pkg/generated/listers/autoscaling/v2"
+	kedav1alpha1listers "cellery.io/cellery-controller/pkg/generated/listers/keda/v1alpha1"
 	v1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
 	istionetworkv1alpha3listers "cellery.io/cellery-controller/pkg/generated/listers/networking/v1alpha3"
-	kservingv1alpha1listers "cellery.io/cellery-controller/pkg/generated/listers/serving/v1alpha1"
+	istiosecurityv1beta1listers "cellery.io/cellery-controller/pkg/generated/listers/security/v1beta1"
+	kservingv1listers "cellery.io/cellery-controller/pkg/generated/listers/serving/v1"
 	"cellery.io/cellery-controller/pkg/informers"
+	"cellery.io/cellery-controller/pkg/logging"
 	"cellery.io/cellery-controller/pkg/meta"
 )
 
 type reconciler struct {
-	kubeClient                  kubeclient.Interface
-	meshClient                  meshclient.Interface
-	componentLister             v1alpha2listers.ComponentLister
-	serviceLister               corev1listers.ServiceLister
-	deploymentLister            appsv1listers.DeploymentLister
-	statefulSetLister           appsv1listers.StatefulSetLister
-	persistentVolumeClaimLister corev1listers.PersistentVolumeClaimLister
-	configMapLister             corev1listers.ConfigMapLister
-	secretLister                corev1listers.SecretLister
-	jobLister                   batchv1listers.JobLister
-	hpaLister                   autoscalingv2beta1lister.HorizontalPodAutoscalerLister
-	istioVirtualServiceLister   istionetworkv1alpha3listers.VirtualServiceLister
-	istioPolicyLister           istioauthenticationv1alpha1listers.PolicyLister
-	servingConfigurationLister  kservingv1alpha1listers.ConfigurationLister
-	cfg                         config.Interface
-	logger                      *zap.SugaredLogger
-	recorder                    record.EventRecorder
+	kubeClient                    kubeclient.Interface
+	meshClient                    meshclient.Interface
+	componentLister               v1alpha2listers.ComponentLister
+	cellLister                    v1alpha2listers.CellLister
+	compositeLister               v1alpha2listers.CompositeLister
+	serviceLister                 corev1listers.ServiceLister
+	deploymentLister              appsv1listers.DeploymentLister
+	statefulSetLister             appsv1listers.StatefulSetLister
+	persistentVolumeClaimLister   corev1listers.PersistentVolumeClaimLister
+	configMapLister               corev1listers.ConfigMapLister
+	secretLister                  corev1listers.SecretLister
+	jobLister                     batchv1listers.JobLister
+	hpaLister                     autoscalingv2listers.HorizontalPodAutoscalerLister
+	scaledObjectLister            kedav1alpha1listers.ScaledObjectLister
+	triggerAuthenticationLister   kedav1alpha1listers.TriggerAuthenticationLister
+	istioVirtualServiceLister     istionetworkv1alpha3listers.VirtualServiceLister
+	istioPeerAuthenticationLister istiosecurityv1beta1listers.PeerAuthenticationLister
+	istioPolicyLister             istioauthenticationv1alpha1listers.PolicyLister
+	istioDestinationRuleLister    istionetworkv1alpha3listers.DestinationRuleLister
+	servingServiceLister          kservingv1listers.ServiceLister
+	autoscaleOverrideLister       v1alpha2listers.AutoscaleOverrideLister
+
+	// kedaAvailable is set if KEDA is installed in the cluster
+	kedaAvailable bool
+	// istioSecurityAvailable is set if the cluster serves the security.istio.io/v1beta1 API
+	istioSecurityAvailable bool
+	// istioAuthenticationAvailable is set if the cluster serves the authentication.istio.io/v1alpha1 API
+	istioAuthenticationAvailable bool
+
+	cfg       config.Interface
+	publisher cloudevents.Interface
+	logger    *zap.SugaredLogger
+	recorder  record.EventRecorder
 }
 
 func NewController(
 	clientset clients.Interface,
 	informerset informers.Interface,
 	cfg config.Interface,
+	publisher cloudevents.Interface,
 	logger *zap.SugaredLogger,
 ) *controller.Controller {
-	r := &reconciler{
-		kubeClient:                  clientset.Kubernetes(),
-		meshClient:                  clientset.Mesh(),
-		componentLister:             informerset.Components().Lister(),
-		serviceLister:               informerset.Services().Lister(),
-		deploymentLister:            informerset.Deployments().Lister(),
-		statefulSetLister:           informerset.StatefulSets().Lister(),
-		persistentVolumeClaimLister: informerset.PersistentVolumeClaims().Lister(),
-		configMapLister:             informerset.ConfigMaps().Lister(),
-		secretLister:                informerset.Secrets().Lister(),
-		jobLister:                   informerset.Jobs().Lister(),
-		hpaLister:                   informerset.HorizontalPodAutoscalers().Lister(),
-		istioVirtualServiceLister:   informerset.IstioVirtualServices().Lister(),
-		istioPolicyLister:           informerset.IstioPolicy().Lister(),
-		servingConfigurationLister:  informerset.KnativeServingConfigurations().Lister(),
-		cfg:                         cfg,
-		logger:                      logger.Named("component-controller"),
-	}
+	r := newReconciler(clientset, informerset, cfg, publisher, logger)
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(r.logger.Named("events").Infof)
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: r.kubeClient.CoreV1().Events("")})
@@ -109,6 +119,14 @@ func NewController(
 	r.logger.Info("Setting up event handlers")
 	informerset.Components().Informer().AddEventHandler(informers.HandleAll(c.Enqueue))
 
+	informerset.Cells().Informer().AddEventHandler(controller.HandlePauseChange(c.EnqueueControlledBy(informerset.Components().Informer().GetIndexer())))
+	informerset.Cells().Informer().AddEventHandler(controller.HandleSuspendChange(c.EnqueueControlledBy(informerset.Components().Informer().GetIndexer())))
+
+	informerset.Composites().Informer().AddEventHandler(controller.HandlePauseChange(c.EnqueueControlledBy(informerset.Components().Informer().GetIndexer())))
+	informerset.Composites().Informer().AddEventHandler(controller.HandleSuspendChange(c.EnqueueControlledBy(informerset.Components().Informer().GetIndexer())))
+
+	informerset.AutoscaleOverrides().Informer().AddEventHandler(controller.HandleAutoscaleOverride("Component", c.EnqueueKey))
+
 	informerset.Services().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Component")),
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
@@ -149,97 +167,311 @@ func NewController(
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
+	if r.kedaAvailable {
+		informerset.KedaScaledObjects().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Component")),
+			Handler:    informers.HandleAll(c.EnqueueControllerOf),
+		})
+
+		informerset.KedaTriggerAuthentications().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Component")),
+			Handler:    informers.HandleAll(c.EnqueueControllerOf),
+		})
+	}
+
 	informerset.IstioVirtualServices().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Component")),
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
-	informerset.IstioPolicy().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+	if r.istioSecurityAvailable {
+		informerset.IstioPeerAuthentications().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Component")),
+			Handler:    informers.HandleAll(c.EnqueueControllerOf),
+		})
+	}
+
+	if r.istioAuthenticationAvailable {
+		informerset.IstioPolicies().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Component")),
+			Handler:    informers.HandleAll(c.EnqueueControllerOf),
+		})
+	}
+
+	informerset.IstioDestinationRules().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Component")),
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
-	informerset.KnativeServingConfigurations().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+	informerset.KnativeServingServices().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Component")),
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
+	// The configuration of a component depends on the config overrides of its namespace
+	enqueueNamespace := func(namespace string) {
+		components, err := r.componentLister.Components(namespace).List(labels.Everything())
+		if err != nil {
+			r.logger.Errorf("Failed to list the components of namespace %q: %v", namespace, err)
+			return
+		}
+		for _, component := range components {
+			c.Enqueue(component)
+		}
+	}
+	informerset.ConfigMaps().Informer().AddEventHandler(controller.HandleConfigOverride(enqueueNamespace))
+	informerset.Secrets().Informer().AddEventHandler(controller.HandleConfigOverride(enqueueNamespace))
+
 	return c
 }
 
-func (r *reconciler) Reconcile(key string) error {
-	r.logger.Infof("Reconcile called with %s", key)
+func newReconciler(
+	clientset clients.Interface,
+	informerset informers.Interface,
+	cfg config.Interface,
+	publisher cloudevents.Interface,
+	logger *zap.SugaredLogger,
+) *reconciler {
+	r := &reconciler{
+		kubeClient:                   clientset.Kubernetes(),
+		meshClient:                   clientset.Mesh(),
+		componentLister:              informerset.Components().Lister(),
+		cellLister:                   informerset.Cells().Lister(),
+		compositeLister:              informerset.Composites().Lister(),
+		serviceLister:                informerset.Services().Lister(),
+		deploymentLister:             informerset.Deployments().Lister(),
+		statefulSetLister:            informerset.StatefulSets().Lister(),
+		persistentVolumeClaimLister:  informerset.PersistentVolumeClaims().Lister(),
+		configMapLister:              informerset.ConfigMaps().Lister(),
+		secretLister:                 informerset.Secrets().Lister(),
+		jobLister:                    informerset.Jobs().Lister(),
+		hpaLister:                    informerset.HorizontalPodAutoscalers().Lister(),
+		istioVirtualServiceLister:    informerset.IstioVirtualServices().Lister(),
+		istioDestinationRuleLister:   informerset.IstioDestinationRules().Lister(),
+		servingServiceLister:         informerset.KnativeServingServices().Lister(),
+		autoscaleOverrideLister:      informerset.AutoscaleOverrides().Lister(),
+		kedaAvailable:                clients.KedaAvailable(clientset),
+		istioSecurityAvailable:       clients.IstioSecurityAvailable(clientset),
+		istioAuthenticationAvailable: clients.IstioAuthenticationAvailable(clientset),
+		cfg:                          cfg,
+		publisher:                    publisher,
+		logger:                       logger.Named("component-controller"),
+	}
+	if r.kedaAvailable {
+		r.scaledObjectLister = informerset.KedaScaledObjects().Lister()
+		r.triggerAuthenticationLister = informerset.KedaTriggerAuthentications().Lister()
+	}
+	if r.istioSecurityAvailable {
+		r.istioPeerAuthenticationLister = informerset.IstioPeerAuthentications().Lister()
+	}
+	if r.istioAuthenticationAvailable {
+		r.istioPolicyLister = informerset.IstioPolicies().Lister()
+	}
+	return r
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, key string) (_ controller.Result, err error) {
+	logger := logging.FromContext(ctx)
+	logger.Infof("Reconcile called with %s", key)
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
-		r.logger.Errorf("invalid resource key: %s", key)
-		return nil
+		logger.Errorf("invalid resource key: %s", key)
+		return controller.Result{}, nil
 	}
 	original, err := r.componentLister.Components(namespace).Get(name)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			r.logger.Errorf("component '%s' in work queue no longer exists", key)
-			return nil
+			logger.Errorf("component '%s' in work queue no longer exists", key)
+			return controller.Result{}, nil
 		}
-		return err
+		return controller.Result{}, err
 	}
 
 	component := original.DeepCopy()
 
-	if err = r.reconcile(component); err != nil {
-		r.recorder.Eventf(component, corev1.EventTypeWarning, "InternalError", "Failed to update cluster: %v", err)
-		return err
+	ctx, span := controller.StartReconcileSpan(ctx, "Component", component, component.Generation != component.Status.ObservedGeneration)
+	defer func() { span.Finish(err) }()
+
+	var result controller.Result
+	if controller.IsPaused(component) || controller.IsOwnerPaused(component, r.cellLister, r.compositeLister) {
+		if component.Status.SetCondition(v1alpha2.ComponentReconcilePaused, corev1.ConditionTrue) {
+			r.recorder.Eventf(component, corev1.EventTypeNormal, "ReconcilePaused", "Reconciliation of Component %q is paused", component.Name)
+		}
+	} else {
+		if component.Status.RemoveCondition(v1alpha2.ComponentReconcilePaused) {
+			r.recorder.Eventf(component, corev1.EventTypeNormal, "ReconcileResumed", "Reconciliation of Component %q is resumed", component.Name)
+		}
+		result, err = r.prepare(component, r.recorder)
+		if err == nil {
+			err = r.reconcile(ctx, component)
+		}
+		if err != nil {
+			r.recorder.Eventf(component, corev1.EventTypeWarning, "InternalError", "Failed to update cluster: %v", err)
+			return controller.Result{}, err
+		}
+		component.Status.RemoveCondition(v1alpha2.ComponentReconcileFailed)
+	}
+
+	// Do not update the status from a cancelled or timed out reconcile
+	if err = ctx.Err(); err != nil {
+		return controller.Result{}, err
 	}
 
 	if equality.Semantic.DeepEqual(original.Status, component.Status) {
-		return nil
+		return result, nil
 	}
 
 	if _, err = r.updateStatus(component); err != nil {
 		r.recorder.Eventf(component, corev1.EventTypeWarning, "UpdateFailed", "Failed to update status: %v", err)
-		return err
+		return controller.Result{}, err
 	}
 	r.recorder.Eventf(component, corev1.EventTypeNormal, "Updated", "Updated Component status %q", component.GetName())
-	return nil
+	if original.Status.AvailableReplicas != component.Status.AvailableReplicas {
+		r.publisher.Publish(cloudevents.NewEvent(cloudevents.TypeComponentScaled, "Component", component).
+			WithReplicas(component.Status.AvailableReplicas))
+	}
+	return result, nil
 }
 
-func (r *reconciler) reconcile(component *v1alpha2.Component) error {
-	component.Default()
+// RecordFailure marks the Component as failed once its key has exhausted the retries.
+func (r *reconciler) RecordFailure(ctx context.Context, key string, reconcileErr error) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	original, err := r.componentLister.Components(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+
+	component := original.DeepCopy()
+	if !component.Status.SetCondition(v1alpha2.ComponentReconcileFailed, corev1.ConditionTrue) {
+		return nil
+	}
+	r.recorder.Eventf(component, corev1.EventTypeWarning, "ReconcileFailed", "Reconciliation of Component %q failed after retries: %v", name, reconcileErr)
+	_, err = r.updateStatus(component)
+	return err
+}
+
+func (r *reconciler) reconcile(ctx context.Context, component *v1alpha2.Component) error {
 	rErrs := &controller.ReconcileErrors{}
 
-	rErrs.Add(r.reconcileService(component))
-	rErrs.Add(r.reconcileDeployment(component))
-	rErrs.Add(r.reconcileStatefulSet(component))
-	rErrs.Add(r.reconcileJob(component))
-	rErrs.Add(r.reconcileHpa(component))
-	rErrs.Add(r.reconcileServingConfiguration(component))
-	rErrs.Add(r.reconcileServingVirtualService(component))
-	rErrs.Add(r.reconcileTlsPolicy(component))
+	rErrs.Add(r.reconcileService(ctx, component))
+	rErrs.Add(r.reconcileDeployment(ctx, component))
+	rErrs.Add(r.reconcileStatefulSet(ctx, component))
+	rErrs.Add(r.reconcileJob(ctx, component))
+	rErrs.Add(r.reconcileHpa(ctx, component))
+	rErrs.Add(r.reconcileScaledObject(ctx, component))
+	rErrs.Add(r.removeLegacyServing(ctx, component))
+	rErrs.Add(r.reconcileServingService(ctx, component))
+	rErrs.Add(r.reconcileTlsPolicy(ctx, component))
+	rErrs.Add(r.reconcilePeerAuthentication(ctx, component))
+	rErrs.Add(r.reconcileDestinationRule(ctx, component))
 
 	for i, _ := range component.Spec.VolumeClaims {
-		rErrs.Add(r.reconcilePersistentVolumeClaim(component, &component.Spec.VolumeClaims[i]))
+		rErrs.Add(r.reconcilePersistentVolumeClaim(ctx, component, &component.Spec.VolumeClaims[i]))
 	}
 
 	for i, _ := range component.Spec.Configurations {
-		rErrs.Add(r.reconcileConfiguration(component, &component.Spec.Configurations[i]))
+		rErrs.Add(r.reconcileConfiguration(ctx, component, &component.Spec.Configurations[i]))
 	}
 
 	for i, _ := range component.Spec.Secrets {
-		rErrs.Add(r.reconcileSecret(component, &component.Spec.Secrets[i]))
+		rErrs.Add(r.reconcileSecret(ctx, component, &component.Spec.Secrets[i]))
+	}
+
+	if component.Spec.ScalingPolicy.EventDriven != nil {
+		for i, _ := range component.Spec.ScalingPolicy.EventDriven.Triggers {
+			rErrs.Add(r.reconcileTriggerAuthentication(ctx, component, &component.Spec.ScalingPolicy.EventDriven.Triggers[i]))
+		}
 	}
 
 	if !rErrs.Empty() {
 		return rErrs
 	}
 
+	if r.isSuspended(component) {
+		component.Status.Status = v1alpha2.ComponentCurrentStatusSuspended
+	}
 	component.Status.ObservedGeneration = component.Generation
 	return nil
 }
 
-func (r *reconciler) reconcileService(component *v1alpha2.Component) error {
+// isSuspended returns true if the component or the Cell or the Composite which controls it is suspended.
+func (r *reconciler) isSuspended(component *v1alpha2.Component) bool {
+	return controller.IsSuspended(component) || controller.IsOwnerSuspended(component, r.cellLister, r.compositeLister)
+}
+
+// prepare applies the active scaling schedule, the defaults and the AutoscaleOverride to the component
+// before its child resources are built. Both the reconcile and Inspect prepare the component so that
+// the inspected desired state matches the reconciled one.
+func (r *reconciler) prepare(component *v1alpha2.Component, recorder record.EventRecorder) (controller.Result, error) {
+	result := r.applySchedule(component, recorder)
+	component.Default()
+	if err := r.applyAutoscaleOverride(component, recorder); err != nil {
+		return controller.Result{}, err
+	}
+	return result, nil
+}
+
+// applySchedule applies the active scaling schedule to the scaling policy of the component and returns
+// the result which requeues the component when the active schedule changes.
+func (r *reconciler) applySchedule(component *v1alpha2.Component, recorder record.EventRecorder) controller.Result {
+	active, next, err := controller.ActiveSchedule(component.Spec.ScalingPolicy.Schedules, time.Now())
+	if err != nil {
+		recorder.Eventf(component, corev1.EventTypeWarning, "InvalidSchedule", "Failed to evaluate the scaling schedules: %v", err)
+		return controller.Result{}
+	}
+	var activeSchedule string
+	if active != nil {
+		activeSchedule = active.Name
+		component.Spec.ScalingPolicy.ApplySchedule(active)
+	}
+	if activeSchedule != component.Status.ActiveSchedule {
+		if len(activeSchedule) > 0 {
+			recorder.Eventf(component, corev1.EventTypeNormal, "ScheduleActivated", "Applied scaling schedule %q", activeSchedule)
+		} else {
+			recorder.Eventf(component, corev1.EventTypeNormal, "ScheduleDeactivated", "Removed scaling schedule %q", component.Status.ActiveSchedule)
+		}
+		component.Status.ActiveSchedule = activeSchedule
+	}
+	return controller.Result{RequeueAfter: next}
+}
+
+// applyAutoscaleOverride merges the AutoscaleOverride which targets the component into its HPA unless
+// the HPA is not overridable.
+func (r *reconciler) applyAutoscaleOverride(component *v1alpha2.Component, recorder record.EventRecorder) error {
+	override, err := controller.GetAutoscaleOverride(component, "Component", r.autoscaleOverrideLister)
+	if err != nil {
+		r.logger.Errorf("Failed to retrieve the AutoscaleOverrides of Component %q: %v", component.Name, err)
+		return err
+	}
+	hpa := component.Spec.ScalingPolicy.Hpa
+	if override == nil || hpa == nil || !hpa.IsOverridable() {
+		if len(component.Status.AutoscaleOverride) > 0 {
+			recorder.Eventf(component, corev1.EventTypeNormal, "AutoscaleOverrideRemoved", "Removed AutoscaleOverride %q", component.Status.AutoscaleOverride)
+		}
+		component.Status.AutoscaleOverride = ""
+		component.Status.AutoscaleOverrideGeneration = 0
+		return nil
+	}
+	override.DeepCopy().ApplyTo(hpa)
+	if override.Name != component.Status.AutoscaleOverride || override.Generation != component.Status.AutoscaleOverrideGeneration {
+		recorder.Eventf(component, corev1.EventTypeNormal, "AutoscaleOverrideApplied", "Applied AutoscaleOverride %q", override.Name)
+	}
+	component.Status.AutoscaleOverride = override.Name
+	component.Status.AutoscaleOverrideGeneration = override.Generation
+	return nil
+}
+
+func (r *reconciler) reconcileService(ctx context.Context, component *v1alpha2.Component) (err error) {
 	serviceName := resources.ServiceName(component)
+	_, span := controller.StartStepSpan(ctx, "Service", serviceName)
+	defer func() { span.Finish(err) }()
 	service, err := r.serviceLister.Services(component.Namespace).Get(serviceName)
 	if !resources.RequireService(component) {
 		if err == nil && metav1.IsControlledBy(service, component) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.CoreV1().Services(component.Namespace).Delete(serviceName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete Service %q: %v", serviceName, err)
@@ -251,6 +483,7 @@ func (r *reconciler) reconcileService(component *v1alpha2.Component) error {
 	}
 
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		service, err = r.kubeClient.CoreV1().Services(component.Namespace).Create(resources.MakeService(component))
 		if err != nil {
 			r.logger.Errorf("Failed to create Service %q: %v", serviceName, err)
@@ -261,32 +494,45 @@ func (r *reconciler) reconcileService(component *v1alpha2.Component) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Service %q: %v", serviceName, err)
 		return err
-	} else if !metav1.IsControlledBy(service, component) {
-		return fmt.Errorf("component: %q does not own the Service: %q", component.Name, serviceName)
+	} else if !metav1.IsControlledBy(service, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), service) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the Service: %q", component.Name, serviceName))
 	} else {
+		adopt := !metav1.IsControlledBy(service, component)
 		service, err = func(component *v1alpha2.Component, service *corev1.Service) (*corev1.Service, error) {
-			if !resources.RequireServiceUpdate(component, service) {
+			if !adopt && !resources.RequireServiceUpdate(component, service) {
+				controller.SetAction(span, controller.ActionSkip)
 				return service, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredService := resources.MakeService(component)
 			existingService := service.DeepCopy()
 			resources.CopyService(desiredService, existingService)
+			if adopt {
+				controller.Adopt(existingService, desiredService)
+			}
 			return r.kubeClient.CoreV1().Services(component.Namespace).Update(existingService)
 		}(component, service)
 		if err != nil {
 			r.logger.Errorf("Failed to update Service %q: %v", serviceName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(component, "Service", serviceName)
+		}
 	}
 	resources.StatusFromService(component, service)
 	return nil
 }
 
-func (r *reconciler) reconcileDeployment(component *v1alpha2.Component) error {
+func (r *reconciler) reconcileDeployment(ctx context.Context, component *v1alpha2.Component) (err error) {
 	deploymentName := resources.DeploymentName(component)
+	_, span := controller.StartStepSpan(ctx, "Deployment", deploymentName)
+	defer func() { span.Finish(err) }()
 	deployment, err := r.deploymentLister.Deployments(component.Namespace).Get(deploymentName)
+	suspended := r.isSuspended(component)
 	if !resources.RequireDeployment(component) {
 		if err == nil && metav1.IsControlledBy(deployment, component) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.AppsV1().Deployments(component.Namespace).Delete(deploymentName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete Deployment %q: %v", deploymentName, err)
@@ -297,7 +543,12 @@ func (r *reconciler) reconcileDeployment(component *v1alpha2.Component) error {
 	}
 
 	if errors.IsNotFound(err) {
-		deployment, err = r.kubeClient.AppsV1().Deployments(component.Namespace).Create(resources.MakeDeployment(component))
+		controller.SetAction(span, controller.ActionCreate)
+		desiredDeployment := resources.MakeDeployment(component)
+		if suspended {
+			desiredDeployment.Spec.Replicas = controller.SuspendReplicas(desiredDeployment, desiredDeployment.Spec.Replicas, desiredDeployment)
+		}
+		deployment, err = r.kubeClient.AppsV1().Deployments(component.Namespace).Create(desiredDeployment)
 		if err != nil {
 			r.logger.Errorf("Failed to create Deployment %q: %v", deploymentName, err)
 			r.recorder.Eventf(component, corev1.EventTypeWarning, "CreationFailed", "Failed to create Deployment %q: %v", deploymentName, err)
@@ -307,32 +558,51 @@ func (r *reconciler) reconcileDeployment(component *v1alpha2.Component) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Deployment %q: %v", deploymentName, err)
 		return err
-	} else if !metav1.IsControlledBy(deployment, component) {
-		return fmt.Errorf("component: %q does not own the Deployment: %q", component.Name, deploymentName)
+	} else if !metav1.IsControlledBy(deployment, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), deployment) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the Deployment: %q", component.Name, deploymentName))
 	} else {
+		adopt := !metav1.IsControlledBy(deployment, component)
 		deployment, err = func(component *v1alpha2.Component, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
-			if !resources.RequireDeploymentUpdate(component, deployment) {
+			if !adopt && !resources.RequireDeploymentUpdate(component, deployment) && suspended == controller.IsWorkloadSuspended(deployment) {
+				controller.SetAction(span, controller.ActionSkip)
 				return deployment, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredDeployment := resources.MakeDeployment(component)
 			existingDeployment := deployment.DeepCopy()
 			resources.CopyDeployment(desiredDeployment, existingDeployment, component)
+			if suspended {
+				existingDeployment.Spec.Replicas = controller.SuspendReplicas(deployment, deployment.Spec.Replicas, existingDeployment)
+			} else if component.Spec.ScalingPolicy.IsAutoscaled() {
+				// The HPA does not scale a workload with zero replicas
+				existingDeployment.Spec.Replicas = controller.ResumeReplicas(deployment, existingDeployment.Spec.Replicas)
+			}
+			if adopt {
+				controller.Adopt(existingDeployment, desiredDeployment)
+			}
 			return r.kubeClient.AppsV1().Deployments(component.Namespace).Update(existingDeployment)
 		}(component, deployment)
 		if err != nil {
 			r.logger.Errorf("Failed to update Deployment %q: %v", deploymentName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(component, "Deployment", deploymentName)
+		}
 	}
 	resources.StatusFromDeployment(component, deployment)
 	return nil
 }
 
-func (r *reconciler) reconcileStatefulSet(component *v1alpha2.Component) error {
+func (r *reconciler) reconcileStatefulSet(ctx context.Context, component *v1alpha2.Component) (err error) {
 	statefulSetName := resources.StatefulSetName(component)
+	_, span := controller.StartStepSpan(ctx, "StatefulSet", statefulSetName)
+	defer func() { span.Finish(err) }()
 	statefulSet, err := r.statefulSetLister.StatefulSets(component.Namespace).Get(statefulSetName)
+	suspended := r.isSuspended(component)
 	if !resources.RequireStatefulSet(component) {
 		if err == nil && metav1.IsControlledBy(statefulSet, component) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.AppsV1().StatefulSets(component.Namespace).Delete(statefulSetName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete StatefulSet %q: %v", statefulSetName, err)
@@ -343,7 +613,12 @@ func (r *reconciler) reconcileStatefulSet(component *v1alpha2.Component) error {
 	}
 
 	if errors.IsNotFound(err) {
-		statefulSet, err = r.kubeClient.AppsV1().StatefulSets(component.Namespace).Create(resources.MakeStatefulSet(component))
+		controller.SetAction(span, controller.ActionCreate)
+		desiredStatefulSet := resources.MakeStatefulSet(component)
+		if suspended {
+			desiredStatefulSet.Spec.Replicas = controller.SuspendReplicas(desiredStatefulSet, desiredStatefulSet.Spec.Replicas, desiredStatefulSet)
+		}
+		statefulSet, err = r.kubeClient.AppsV1().StatefulSets(component.Namespace).Create(desiredStatefulSet)
 		if err != nil {
 			r.logger.Errorf("Failed to create StatefulSet %q: %v", statefulSetName, err)
 			r.recorder.Eventf(component, corev1.EventTypeWarning, "CreationFailed", "Failed to create StatefulSet %q: %v", statefulSetName, err)
@@ -353,32 +628,50 @@ func (r *reconciler) reconcileStatefulSet(component *v1alpha2.Component) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve StatefulSet %q: %v", statefulSetName, err)
 		return err
-	} else if !metav1.IsControlledBy(statefulSet, component) {
-		return fmt.Errorf("component: %q does not own the StatefulSet: %q", component.Name, statefulSetName)
+	} else if !metav1.IsControlledBy(statefulSet, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), statefulSet) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the StatefulSet: %q", component.Name, statefulSetName))
 	} else {
+		adopt := !metav1.IsControlledBy(statefulSet, component)
 		statefulSet, err = func(component *v1alpha2.Component, statefulSet *appsv1.StatefulSet) (*appsv1.StatefulSet, error) {
-			if !resources.RequireStatefulSetUpdate(component, statefulSet) {
+			if !adopt && !resources.RequireStatefulSetUpdate(component, statefulSet) && suspended == controller.IsWorkloadSuspended(statefulSet) {
+				controller.SetAction(span, controller.ActionSkip)
 				return statefulSet, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredStatefulSet := resources.MakeStatefulSet(component)
 			existingStatefulSet := statefulSet.DeepCopy()
 			resources.CopyStatefulSet(desiredStatefulSet, existingStatefulSet, component)
+			if suspended {
+				existingStatefulSet.Spec.Replicas = controller.SuspendReplicas(statefulSet, statefulSet.Spec.Replicas, existingStatefulSet)
+			} else if component.Spec.ScalingPolicy.IsAutoscaled() {
+				// The HPA does not scale a workload with zero replicas
+				existingStatefulSet.Spec.Replicas = controller.ResumeReplicas(statefulSet, existingStatefulSet.Spec.Replicas)
+			}
+			if adopt {
+				controller.Adopt(existingStatefulSet, desiredStatefulSet)
+			}
 			return r.kubeClient.AppsV1().StatefulSets(component.Namespace).Update(existingStatefulSet)
 		}(component, statefulSet)
 		if err != nil {
 			r.logger.Errorf("Failed to update StatefulSet %q: %v", statefulSetName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(component, "StatefulSet", statefulSetName)
+		}
 	}
 	resources.StatusFromStatefulSet(component, statefulSet)
 	return nil
 }
 
-func (r *reconciler) reconcileJob(component *v1alpha2.Component) error {
+func (r *reconciler) reconcileJob(ctx context.Context, component *v1alpha2.Component) (err error) {
 	jobName := resources.JobName(component)
+	_, span := controller.StartStepSpan(ctx, "Job", jobName)
+	defer func() { span.Finish(err) }()
 	job, err := r.jobLister.Jobs(component.Namespace).Get(jobName)
 	if !resources.RequireJob(component) {
 		if err == nil && metav1.IsControlledBy(job, component) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.BatchV1().Jobs(component.Namespace).Delete(jobName, meta.DeleteWithPropagationBackground())
 			if err != nil {
 				r.logger.Errorf("Failed to delete Job %q: %v", jobName, err)
@@ -389,6 +682,7 @@ func (r *reconciler) reconcileJob(component *v1alpha2.Component) error {
 	}
 
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		job, err = r.kubeClient.BatchV1().Jobs(component.Namespace).Create(resources.MakeJob(component))
 		if err != nil {
 			r.logger.Errorf("Failed to create Job %q: %v", jobName, err)
@@ -399,10 +693,23 @@ func (r *reconciler) reconcileJob(component *v1alpha2.Component) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Job %q: %v", jobName, err)
 		return err
+	} else if !metav1.IsControlledBy(job, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), job) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the Job: %q", component.Name, jobName))
 	} else if !metav1.IsControlledBy(job, component) {
-		return fmt.Errorf("component: %q does not own the Job: %q", component.Name, jobName)
+		controller.SetAction(span, controller.ActionUpdate)
+		existingJob := job.DeepCopy()
+		controller.AdoptWithOwnerRef(existingJob, controller.CreateComponentOwnerRef(component))
+		job, err = r.kubeClient.BatchV1().Jobs(component.Namespace).Update(existingJob)
+		if err != nil {
+			r.logger.Errorf("Failed to adopt Job %q: %v", jobName, err)
+			return err
+		}
+		r.recordAdoption(component, "Job", jobName)
 	} else {
-		if resources.RequireJobUpdate(component, job) {
+		if !resources.RequireJobUpdate(component, job) {
+			controller.SetAction(span, controller.ActionSkip)
+		} else {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.BatchV1().Jobs(component.Namespace).Delete(jobName, meta.DeleteWithPropagationBackground())
 			if err != nil {
 				r.logger.Errorf("Failed to delete Job %q: %v", jobName, err)
@@ -415,13 +722,17 @@ func (r *reconciler) reconcileJob(component *v1alpha2.Component) error {
 	return nil
 }
 
-func (r *reconciler) reconcileHpa(component *v1alpha2.Component) error {
+func (r *reconciler) reconcileHpa(ctx context.Context, component *v1alpha2.Component) (err error) {
 	hpaName := resources.HpaName(component)
+	_, span := controller.StartStepSpan(ctx, "Hpa", hpaName)
+	defer func() { span.Finish(err) }()
 	hpa, err := r.hpaLister.HorizontalPodAutoscalers(component.Namespace).Get(hpaName)
 
-	if !resources.RequireHpa(component) {
+	// The HPA is removed while the component is suspended and created again on resume
+	if !resources.RequireHpa(component) || r.isSuspended(component) {
 		if err == nil && metav1.IsControlledBy(hpa, component) {
-			err = r.kubeClient.AutoscalingV2beta1().HorizontalPodAutoscalers(component.Namespace).Delete(hpaName, &metav1.DeleteOptions{})
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.AutoscalingV2().HorizontalPodAutoscalers(component.Namespace).Delete(hpaName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete HPA %q: %v", hpaName, err)
 				return err
@@ -431,7 +742,8 @@ func (r *reconciler) reconcileHpa(component *v1alpha2.Component) error {
 	}
 
 	if errors.IsNotFound(err) {
-		hpa, err = r.kubeClient.AutoscalingV2beta1().HorizontalPodAutoscalers(component.Namespace).Create(resources.MakeHpa(component))
+		controller.SetAction(span, controller.ActionCreate)
+		hpa, err = r.meshClient.AutoscalingV2().HorizontalPodAutoscalers(component.Namespace).Create(resources.MakeHpa(component))
 		if err != nil {
 			r.logger.Errorf("Failed to create HPA %q: %v", hpaName, err)
 			r.recorder.Eventf(component, corev1.EventTypeWarning, "CreationFailed", "Failed to create HPA %q: %v", hpaName, err)
@@ -441,36 +753,56 @@ func (r *reconciler) reconcileHpa(component *v1alpha2.Component) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve HPA %q: %v", hpaName, err)
 		return err
-	} else if !metav1.IsControlledBy(hpa, component) {
-		return fmt.Errorf("component: %q does not own the HPA: %q", component.Name, hpaName)
+	} else if !metav1.IsControlledBy(hpa, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), hpa) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the HPA: %q", component.Name, hpaName))
 	} else {
-		hpa, err = func(component *v1alpha2.Component, hpa *autoscalingv2beta1.HorizontalPodAutoscaler) (*autoscalingv2beta1.HorizontalPodAutoscaler, error) {
-			if !resources.RequireHpaUpdate(component, hpa) {
+		adopt := !metav1.IsControlledBy(hpa, component)
+		hpa, err = func(component *v1alpha2.Component, hpa *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+			if !adopt && !resources.RequireHpaUpdate(component, hpa) {
+				controller.SetAction(span, controller.ActionSkip)
 				return hpa, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredHpa := resources.MakeHpa(component)
 			existingHpa := hpa.DeepCopy()
 			resources.CopyHpa(desiredHpa, existingHpa)
-			return r.kubeClient.AutoscalingV2beta1().HorizontalPodAutoscalers(component.Namespace).Update(existingHpa)
+			if adopt {
+				controller.Adopt(existingHpa, desiredHpa)
+			}
+			return r.meshClient.AutoscalingV2().HorizontalPodAutoscalers(component.Namespace).Update(existingHpa)
 		}(component, hpa)
 		if err != nil {
 			r.logger.Errorf("Failed to update HPA %q: %v", hpaName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(component, "HorizontalPodAutoscaler", hpaName)
+		}
 	}
 	resources.StatusFromHpa(component, hpa)
 	return nil
 }
 
-func (r *reconciler) reconcileServingConfiguration(component *v1alpha2.Component) error {
-	configurationName := resources.ServingConfigurationName(component)
-	configuration, err := r.servingConfigurationLister.Configurations(component.Namespace).Get(configurationName)
+func (r *reconciler) reconcileScaledObject(ctx context.Context, component *v1alpha2.Component) (err error) {
+	scaledObjectName := resources.ScaledObjectName(component)
+	_, span := controller.StartStepSpan(ctx, "ScaledObject", scaledObjectName)
+	defer func() { span.Finish(err) }()
+	if !r.kedaAvailable {
+		if resources.RequireScaledObject(component) {
+			return controller.NewPermanentError(fmt.Errorf("component: %q uses event driven scaling but KEDA is not installed in the cluster", component.Name))
+		}
+		controller.SetAction(span, controller.ActionSkip)
+		return nil
+	}
+	scaledObject, err := r.scaledObjectLister.ScaledObjects(component.Namespace).Get(scaledObjectName)
 
-	if !resources.RequireKnativeServing(component) {
-		if err == nil && metav1.IsControlledBy(configuration, component) {
-			err = r.meshClient.ServingV1alpha1().Configurations(component.Namespace).Delete(configurationName, &metav1.DeleteOptions{})
+	// The ScaledObject is removed while the component is suspended and created again on resume
+	if !resources.RequireScaledObject(component) || r.isSuspended(component) {
+		if err == nil && metav1.IsControlledBy(scaledObject, component) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.KedaV1alpha1().ScaledObjects(component.Namespace).Delete(scaledObjectName, &metav1.DeleteOptions{})
 			if err != nil {
-				r.logger.Errorf("Failed to delete Serving Configuration %q: %v", configurationName, err)
+				r.logger.Errorf("Failed to delete ScaledObject %q: %v", scaledObjectName, err)
 				return err
 			}
 		}
@@ -478,94 +810,246 @@ func (r *reconciler) reconcileServingConfiguration(component *v1alpha2.Component
 	}
 
 	if errors.IsNotFound(err) {
-		configuration, err = r.meshClient.ServingV1alpha1().Configurations(component.Namespace).Create(resources.MakeServingConfiguration(component))
+		controller.SetAction(span, controller.ActionCreate)
+		scaledObject, err = r.meshClient.KedaV1alpha1().ScaledObjects(component.Namespace).Create(resources.MakeScaledObject(component))
 		if err != nil {
-			r.logger.Errorf("Failed to create Serving Configuration %q: %v", configurationName, err)
-			r.recorder.Eventf(component, corev1.EventTypeWarning, "CreationFailed", "Failed to create Serving Configuration %q: %v", configurationName, err)
+			r.logger.Errorf("Failed to create ScaledObject %q: %v", scaledObjectName, err)
+			r.recorder.Eventf(component, corev1.EventTypeWarning, "CreationFailed", "Failed to create ScaledObject %q: %v", scaledObjectName, err)
 			return err
 		}
-		r.recorder.Eventf(component, corev1.EventTypeNormal, "Created", "Created Serving Configuration %q", configurationName)
+		r.recorder.Eventf(component, corev1.EventTypeNormal, "Created", "Created ScaledObject %q", scaledObjectName)
 	} else if err != nil {
-		r.logger.Errorf("Failed to retrieve Serving Configuration %q: %v", configurationName, err)
+		r.logger.Errorf("Failed to retrieve ScaledObject %q: %v", scaledObjectName, err)
 		return err
-	} else if !metav1.IsControlledBy(configuration, component) {
-		return fmt.Errorf("component: %q does not own the Serving Configuration: %q", component.Name, configurationName)
+	} else if !metav1.IsControlledBy(scaledObject, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), scaledObject) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the ScaledObject: %q", component.Name, scaledObjectName))
 	} else {
-		if resources.RequireServingConfigurationUpdate(component, configuration) {
-			err = r.meshClient.ServingV1alpha1().Configurations(component.Namespace).Delete(configurationName, &metav1.DeleteOptions{})
+		adopt := !metav1.IsControlledBy(scaledObject, component)
+		scaledObject, err = func(component *v1alpha2.Component, scaledObject *kedav1alpha1.ScaledObject) (*kedav1alpha1.ScaledObject, error) {
+			if !adopt && !resources.RequireScaledObjectUpdate(component, scaledObject) {
+				controller.SetAction(span, controller.ActionSkip)
+				return scaledObject, nil
+			}
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredScaledObject := resources.MakeScaledObject(component)
+			existingScaledObject := scaledObject.DeepCopy()
+			resources.CopyScaledObject(desiredScaledObject, existingScaledObject)
+			if adopt {
+				controller.Adopt(existingScaledObject, desiredScaledObject)
+			}
+			return r.meshClient.KedaV1alpha1().ScaledObjects(component.Namespace).Update(existingScaledObject)
+		}(component, scaledObject)
+		if err != nil {
+			r.logger.Errorf("Failed to update ScaledObject %q: %v", scaledObjectName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(component, "ScaledObject", scaledObjectName)
+		}
+	}
+	resources.StatusFromScaledObject(component, scaledObject)
+	return nil
+}
+
+func (r *reconciler) reconcileTriggerAuthentication(ctx context.Context, component *v1alpha2.Component, trigger *v1alpha2.EventDrivenTrigger) (err error) {
+	triggerAuthenticationName := resources.TriggerAuthenticationName(component, trigger)
+	_, span := controller.StartStepSpan(ctx, "TriggerAuthentication", triggerAuthenticationName)
+	defer func() { span.Finish(err) }()
+	if !r.kedaAvailable {
+		// The missing KEDA installation is reported while reconciling the ScaledObject
+		controller.SetAction(span, controller.ActionSkip)
+		return nil
+	}
+	triggerAuthentication, err := r.triggerAuthenticationLister.TriggerAuthentications(component.Namespace).Get(triggerAuthenticationName)
+
+	if !resources.RequireTriggerAuthentication(component, trigger) {
+		if err == nil && metav1.IsControlledBy(triggerAuthentication, component) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.KedaV1alpha1().TriggerAuthentications(component.Namespace).Delete(triggerAuthenticationName, &metav1.DeleteOptions{})
 			if err != nil {
-				r.logger.Errorf("Failed to delete Serving Configuration %q: %v", configurationName, err)
+				r.logger.Errorf("Failed to delete TriggerAuthentication %q: %v", triggerAuthenticationName, err)
 				return err
 			}
 		}
+		return nil
 	}
-	resources.StatusFromServingConfiguration(component, configuration, r.deploymentLister.List)
 
-	component.Status.ServiceName = configuration.Name
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		triggerAuthentication, err = r.meshClient.KedaV1alpha1().TriggerAuthentications(component.Namespace).Create(resources.MakeTriggerAuthentication(component, trigger))
+		if err != nil {
+			r.logger.Errorf("Failed to create TriggerAuthentication %q: %v", triggerAuthenticationName, err)
+			r.recorder.Eventf(component, corev1.EventTypeWarning, "CreationFailed", "Failed to create TriggerAuthentication %q: %v", triggerAuthenticationName, err)
+			return err
+		}
+		r.recorder.Eventf(component, corev1.EventTypeNormal, "Created", "Created TriggerAuthentication %q", triggerAuthenticationName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve TriggerAuthentication %q: %v", triggerAuthenticationName, err)
+		return err
+	} else if !metav1.IsControlledBy(triggerAuthentication, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), triggerAuthentication) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the TriggerAuthentication: %q", component.Name, triggerAuthenticationName))
+	} else {
+		adopt := !metav1.IsControlledBy(triggerAuthentication, component)
+		triggerAuthentication, err = func(component *v1alpha2.Component, triggerAuthentication *kedav1alpha1.TriggerAuthentication) (*kedav1alpha1.TriggerAuthentication, error) {
+			if !adopt && !resources.RequireTriggerAuthenticationUpdate(component, triggerAuthentication) {
+				controller.SetAction(span, controller.ActionSkip)
+				return triggerAuthentication, nil
+			}
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredTriggerAuthentication := resources.MakeTriggerAuthentication(component, trigger)
+			existingTriggerAuthentication := triggerAuthentication.DeepCopy()
+			resources.CopyTriggerAuthentication(desiredTriggerAuthentication, existingTriggerAuthentication)
+			if adopt {
+				controller.Adopt(existingTriggerAuthentication, desiredTriggerAuthentication)
+			}
+			return r.meshClient.KedaV1alpha1().TriggerAuthentications(component.Namespace).Update(existingTriggerAuthentication)
+		}(component, triggerAuthentication)
+		if err != nil {
+			r.logger.Errorf("Failed to update TriggerAuthentication %q: %v", triggerAuthenticationName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(component, "TriggerAuthentication", triggerAuthenticationName)
+		}
+	}
+	resources.StatusFromTriggerAuthentication(component, triggerAuthentication)
 	return nil
 }
 
-func (r *reconciler) reconcileServingVirtualService(component *v1alpha2.Component) error {
-	virtualServiceName := resources.ServingVirtualServiceName(component)
-	virtualService, err := r.istioVirtualServiceLister.VirtualServices(component.Namespace).Get(virtualServiceName)
+func (r *reconciler) reconcileServingService(ctx context.Context, component *v1alpha2.Component) (err error) {
+	serviceName := resources.ServingServiceName(component)
+	_, span := controller.StartStepSpan(ctx, "ServingService", serviceName)
+	defer func() { span.Finish(err) }()
+	service, err := r.servingServiceLister.Services(component.Namespace).Get(serviceName)
+
 	if !resources.RequireKnativeServing(component) {
-		if err == nil && metav1.IsControlledBy(virtualService, component) {
-			err = r.meshClient.NetworkingV1alpha3().VirtualServices(component.Namespace).Delete(virtualServiceName, &metav1.DeleteOptions{})
+		if err == nil && metav1.IsControlledBy(service, component) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.ServingV1().Services(component.Namespace).Delete(serviceName, &metav1.DeleteOptions{})
 			if err != nil {
-				r.logger.Errorf("Failed to delete Serving VirtualService %q: %v", virtualServiceName, err)
+				r.logger.Errorf("Failed to delete Serving Service %q: %v", serviceName, err)
 				return err
 			}
 		}
+		resources.ResetServingConditions(component)
 		return nil
 	}
 
 	if errors.IsNotFound(err) {
-		virtualService, err = r.meshClient.NetworkingV1alpha3().VirtualServices(component.Namespace).Create(resources.MakeServingVirtualService(component))
+		controller.SetAction(span, controller.ActionCreate)
+		service, err = r.meshClient.ServingV1().Services(component.Namespace).Create(resources.MakeServingService(component))
 		if err != nil {
-			r.logger.Errorf("Failed to create Serving VirtualService %q: %v", virtualServiceName, err)
-			r.recorder.Eventf(component, corev1.EventTypeWarning, "CreationFailed", "Failed to create Serving VirtualService %q: %v", virtualServiceName, err)
+			r.logger.Errorf("Failed to create Serving Service %q: %v", serviceName, err)
+			r.recorder.Eventf(component, corev1.EventTypeWarning, "CreationFailed", "Failed to create Serving Service %q: %v", serviceName, err)
 			return err
 		}
-		r.recorder.Eventf(component, corev1.EventTypeNormal, "Created", "Created Serving VirtualService %q", virtualServiceName)
+		r.recorder.Eventf(component, corev1.EventTypeNormal, "Created", "Created Serving Service %q", serviceName)
 	} else if err != nil {
-		r.logger.Errorf("Failed to retrieve Serving VirtualService %q: %v", virtualServiceName, err)
+		r.logger.Errorf("Failed to retrieve Serving Service %q: %v", serviceName, err)
 		return err
-	} else if !metav1.IsControlledBy(virtualService, component) {
-		return fmt.Errorf("component: %q does not own the Serving VirtualService: %q", component.Name, virtualServiceName)
+	} else if !metav1.IsControlledBy(service, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), service) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the Serving Service: %q", component.Name, serviceName))
 	} else {
-		virtualService, err = func(component *v1alpha2.Component, virtualService *istionetworkingv1alpha3.VirtualService) (*istionetworkingv1alpha3.VirtualService, error) {
-			if !resources.RequireServingVirtualServiceUpdate(component, virtualService) {
-				return virtualService, nil
-			}
-			desiredVirtualService := resources.MakeServingVirtualService(component)
-			existingVirtualService := virtualService.DeepCopy()
-			resources.CopyServingVirtualService(desiredVirtualService, existingVirtualService)
-			return r.meshClient.NetworkingV1alpha3().VirtualServices(component.Namespace).Update(existingVirtualService)
-		}(component, virtualService)
+		adopt := !metav1.IsControlledBy(service, component)
+		service, err = func(component *v1alpha2.Component, service *servingv1.Service) (*servingv1.Service, error) {
+			if !adopt && !resources.RequireServingServiceUpdate(component, service) {
+				controller.SetAction(span, controller.ActionSkip)
+				return service, nil
+			}
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredService := resources.MakeServingService(component)
+			existingService := service.DeepCopy()
+			resources.CopyServingService(desiredService, existingService)
+			if adopt {
+				controller.Adopt(existingService, desiredService)
+			}
+			return r.meshClient.ServingV1().Services(component.Namespace).Update(existingService)
+		}(component, service)
 		if err != nil {
-			r.logger.Errorf("Failed to update Serving VirtualService %q: %v", virtualServiceName, err)
+			r.logger.Errorf("Failed to update Serving Service %q: %v", serviceName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(component, "Service", serviceName)
+		}
 	}
-	resources.StatusFromServingVirtualService(component, virtualService)
+	resources.StatusFromServingService(component, service, r.deploymentLister.List)
 	return nil
 }
 
-func (r *reconciler) reconcileTlsPolicy(component *v1alpha2.Component) error {
+// removeLegacyServing removes the serving.knative.dev/v1alpha1 Configuration and the Istio VirtualService
+// which served the component before it was migrated to a serving.knative.dev/v1 Service. The v1alpha1 API
+// is not watched since recent Knative versions no longer serve it, so the legacy resources are only looked
+// up for the components which recorded their generations.
+func (r *reconciler) removeLegacyServing(ctx context.Context, component *v1alpha2.Component) (err error) {
+	if component.Status.ServingConfigurationGeneration == 0 && component.Status.ServingVirtualServiceGeneration == 0 {
+		return nil
+	}
+	configurationName := resources.ServingConfigurationName(component)
+	_, span := controller.StartStepSpan(ctx, "LegacyServing", configurationName)
+	defer func() { span.Finish(err) }()
+
+	virtualServiceName := resources.ServingVirtualServiceName(component)
+	virtualService, err := r.istioVirtualServiceLister.VirtualServices(component.Namespace).Get(virtualServiceName)
+	if err == nil && metav1.IsControlledBy(virtualService, component) {
+		controller.SetAction(span, controller.ActionDelete)
+		err = r.meshClient.NetworkingV1alpha3().VirtualServices(component.Namespace).Delete(virtualServiceName, &metav1.DeleteOptions{})
+		if err != nil {
+			r.logger.Errorf("Failed to delete Serving VirtualService %q: %v", virtualServiceName, err)
+			return err
+		}
+	} else if err != nil && !errors.IsNotFound(err) {
+		r.logger.Errorf("Failed to retrieve Serving VirtualService %q: %v", virtualServiceName, err)
+		return err
+	}
+	component.Status.ServingVirtualServiceGeneration = 0
+
+	configuration, err := r.meshClient.ServingV1alpha1().Configurations(component.Namespace).Get(configurationName, metav1.GetOptions{})
+	if err == nil && metav1.IsControlledBy(configuration, component) {
+		controller.SetAction(span, controller.ActionDelete)
+		err = r.meshClient.ServingV1alpha1().Configurations(component.Namespace).Delete(configurationName, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			r.logger.Errorf("Failed to delete Serving Configuration %q: %v", configurationName, err)
+			return err
+		}
+	} else if err != nil && !errors.IsNotFound(err) {
+		r.logger.Errorf("Failed to retrieve Serving Configuration %q: %v", configurationName, err)
+		return err
+	}
+	component.Status.ServingConfigurationGeneration = 0
+	return nil
+}
+
+// reconcileTlsPolicy manages the authentication.istio.io/v1alpha1 Policy used with istio versions prior to 1.5.
+func (r *reconciler) reconcileTlsPolicy(ctx context.Context, component *v1alpha2.Component) (err error) {
 	policyName := resources.TlsPolicyName(component)
+	_, span := controller.StartStepSpan(ctx, "TlsPolicy", policyName)
+	defer func() { span.Finish(err) }()
+	if !r.istioAuthenticationAvailable {
+		if resources.RequireTlsPolicy(component, r.cfg.ForNamespace(component.Namespace)) {
+			return controller.NewPermanentError(fmt.Errorf("component: %q requires Tls Policies which are not served by the cluster, "+
+				"the configured istio-version must match the installed istio version", component.Name))
+		}
+		controller.SetAction(span, controller.ActionSkip)
+		component.Status.TlsPolicyGeneration = 0
+		return nil
+	}
 	policy, err := r.istioPolicyLister.Policies(component.Namespace).Get(policyName)
-	if !resources.RequireTlsPolicy(component) {
+	if !resources.RequireTlsPolicy(component, r.cfg.ForNamespace(component.Namespace)) {
 		if err == nil && metav1.IsControlledBy(policy, component) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.meshClient.AuthenticationV1alpha1().Policies(component.Namespace).Delete(policyName, &metav1.DeleteOptions{})
-			if err != nil {
+			if err != nil && !errors.IsNotFound(err) {
 				r.logger.Errorf("Failed to delete Tls Policy %q: %v", policyName, err)
 				return err
 			}
 		}
+		component.Status.TlsPolicyGeneration = 0
 		return nil
 	}
 
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		policy, err = r.meshClient.AuthenticationV1alpha1().Policies(component.Namespace).Create(resources.MakeTlsPolicy(component))
 		if err != nil {
 			r.logger.Errorf("Failed to create Tls Policy %q: %v", policyName, err)
@@ -576,31 +1060,192 @@ func (r *reconciler) reconcileTlsPolicy(component *v1alpha2.Component) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Tls Policy %q: %v", policyName, err)
 		return err
-	} else if !metav1.IsControlledBy(policy, component) {
-		return fmt.Errorf("component: %q does not own the Tls Policy: %q", component.Name, policyName)
+	} else if !metav1.IsControlledBy(policy, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), policy) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the Tls Policy: %q", component.Name, policyName))
 	} else {
+		adopt := !metav1.IsControlledBy(policy, component)
 		policy, err = func(component *v1alpha2.Component, policy *istioauthenticationv1alpha1.Policy) (*istioauthenticationv1alpha1.Policy, error) {
-			if !resources.RequireTlsPolicyUpdate(component, policy) {
+			if !adopt && !resources.RequireTlsPolicyUpdate(component, policy) {
+				controller.SetAction(span, controller.ActionSkip)
 				return policy, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredPolicy := resources.MakeTlsPolicy(component)
 			existingPolicy := policy.DeepCopy()
 			resources.CopyTlsPolicy(desiredPolicy, existingPolicy)
+			if adopt {
+				controller.Adopt(existingPolicy, desiredPolicy)
+			}
 			return r.meshClient.AuthenticationV1alpha1().Policies(component.Namespace).Update(existingPolicy)
 		}(component, policy)
 		if err != nil {
 			r.logger.Errorf("Failed to update Tls Policy %q: %v", policyName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(component, "Policy", policyName)
+		}
 	}
 	resources.StatusFromTlsPolicy(component, policy)
 	return nil
 }
 
-func (r *reconciler) reconcilePersistentVolumeClaim(component *v1alpha2.Component, volumeClaim *v1alpha2.VolumeClaim) error {
+func (r *reconciler) reconcilePeerAuthentication(ctx context.Context, component *v1alpha2.Component) (err error) {
+	peerAuthenticationName := resources.PeerAuthenticationName(component)
+	_, span := controller.StartStepSpan(ctx, "PeerAuthentication", peerAuthenticationName)
+	defer func() { span.Finish(err) }()
+	if !r.istioSecurityAvailable {
+		if resources.RequirePeerAuthentication(component, r.cfg.ForNamespace(component.Namespace)) {
+			return controller.NewPermanentError(fmt.Errorf("component: %q requires PeerAuthentications which are not served by the cluster, "+
+				"the configured istio-version must match the installed istio version", component.Name))
+		}
+		controller.SetAction(span, controller.ActionSkip)
+		return nil
+	}
+	peerAuthentication, err := r.istioPeerAuthenticationLister.PeerAuthentications(component.Namespace).Get(peerAuthenticationName)
+	if !resources.RequirePeerAuthentication(component, r.cfg.ForNamespace(component.Namespace)) {
+		if err == nil && metav1.IsControlledBy(peerAuthentication, component) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.SecurityV1beta1().PeerAuthentications(component.Namespace).Delete(peerAuthenticationName, &metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				r.logger.Errorf("Failed to delete PeerAuthentication %q: %v", peerAuthenticationName, err)
+				return err
+			}
+		}
+		component.Status.PeerAuthenticationGeneration = 0
+		return nil
+	}
+
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		peerAuthentication, err = r.meshClient.SecurityV1beta1().PeerAuthentications(component.Namespace).Create(resources.MakePeerAuthentication(component))
+		if err != nil {
+			r.logger.Errorf("Failed to create PeerAuthentication %q: %v", peerAuthenticationName, err)
+			r.recorder.Eventf(component, corev1.EventTypeWarning, "CreationFailed", "Failed to create PeerAuthentication %q: %v", peerAuthenticationName, err)
+			return err
+		}
+		r.recorder.Eventf(component, corev1.EventTypeNormal, "Created", "Created PeerAuthentication %q", peerAuthenticationName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve PeerAuthentication %q: %v", peerAuthenticationName, err)
+		return err
+	} else if !metav1.IsControlledBy(peerAuthentication, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), peerAuthentication) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the PeerAuthentication: %q", component.Name, peerAuthenticationName))
+	} else {
+		adopt := !metav1.IsControlledBy(peerAuthentication, component)
+		peerAuthentication, err = func(component *v1alpha2.Component, peerAuthentication *istiosecurityv1beta1.PeerAuthentication) (*istiosecurityv1beta1.PeerAuthentication, error) {
+			if !adopt && !resources.RequirePeerAuthenticationUpdate(component, peerAuthentication) {
+				controller.SetAction(span, controller.ActionSkip)
+				return peerAuthentication, nil
+			}
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredPeerAuthentication := resources.MakePeerAuthentication(component)
+			existingPeerAuthentication := peerAuthentication.DeepCopy()
+			resources.CopyPeerAuthentication(desiredPeerAuthentication, existingPeerAuthentication)
+			if adopt {
+				controller.Adopt(existingPeerAuthentication, desiredPeerAuthentication)
+			}
+			return r.meshClient.SecurityV1beta1().PeerAuthentications(component.Namespace).Update(existingPeerAuthentication)
+		}(component, peerAuthentication)
+		if err != nil {
+			r.logger.Errorf("Failed to update PeerAuthentication %q: %v", peerAuthenticationName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(component, "PeerAuthentication", peerAuthenticationName)
+		}
+	}
+	resources.StatusFromPeerAuthentication(component, peerAuthentication)
+	return nil
+}
+
+func (r *reconciler) reconcileDestinationRule(ctx context.Context, component *v1alpha2.Component) (err error) {
+	destinationRuleName := resources.DestinationRuleName(component)
+	_, span := controller.StartStepSpan(ctx, "DestinationRule", destinationRuleName)
+	defer func() { span.Finish(err) }()
+	destinationRule, err := r.istioDestinationRuleLister.DestinationRules(component.Namespace).Get(destinationRuleName)
+	if !resources.RequireDestinationRule(component) {
+		if err == nil && metav1.IsControlledBy(destinationRule, component) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.NetworkingV1alpha3().DestinationRules(component.Namespace).Delete(destinationRuleName, &metav1.DeleteOptions{})
+			if err != nil {
+				r.logger.Errorf("Failed to delete DestinationRule %q: %v", destinationRuleName, err)
+				return err
+			}
+		}
+		component.Status.DestinationRuleGeneration = 0
+		return nil
+	}
+
+	desiredDestinationRule, makeErr := resources.MakeDestinationRule(component, r.cfg.ForNamespace(component.Namespace))
+	if makeErr != nil {
+		return controller.NewPermanentError(makeErr)
+	}
+	conflicting, listErr := controller.ConflictingDestinationRule(r.istioDestinationRuleLister, component, destinationRuleName, desiredDestinationRule.Spec.Host)
+	if listErr != nil {
+		r.logger.Errorf("Failed to list the DestinationRules of host %q: %v", desiredDestinationRule.Spec.Host, listErr)
+		return listErr
+	} else if conflicting != nil {
+		// Remove the DestinationRule of the component if it lost to an older one of the host
+		if err == nil && metav1.IsControlledBy(destinationRule, component) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.NetworkingV1alpha3().DestinationRules(component.Namespace).Delete(destinationRuleName, &metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				r.logger.Errorf("Failed to delete DestinationRule %q: %v", destinationRuleName, err)
+				return err
+			}
+		}
+		component.Status.DestinationRuleGeneration = 0
+		return controller.NewPermanentError(fmt.Errorf("component: %q cannot apply the traffic policy of the host %q which is applied by the DestinationRule: %q",
+			component.Name, desiredDestinationRule.Spec.Host, conflicting.Name))
+	}
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		destinationRule, err = r.meshClient.NetworkingV1alpha3().DestinationRules(component.Namespace).Create(desiredDestinationRule)
+		if err != nil {
+			r.logger.Errorf("Failed to create DestinationRule %q: %v", destinationRuleName, err)
+			r.recorder.Eventf(component, corev1.EventTypeWarning, "CreationFailed", "Failed to create DestinationRule %q: %v", destinationRuleName, err)
+			return err
+		}
+		r.recorder.Eventf(component, corev1.EventTypeNormal, "Created", "Created DestinationRule %q", destinationRuleName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve DestinationRule %q: %v", destinationRuleName, err)
+		return err
+	} else if !metav1.IsControlledBy(destinationRule, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), destinationRule) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the DestinationRule: %q", component.Name, destinationRuleName))
+	} else {
+		adopt := !metav1.IsControlledBy(destinationRule, component)
+		destinationRule, err = func(component *v1alpha2.Component, destinationRule *istionetworkingv1alpha3.DestinationRule) (*istionetworkingv1alpha3.DestinationRule, error) {
+			if !adopt && !resources.RequireDestinationRuleUpdate(component, desiredDestinationRule, destinationRule) {
+				controller.SetAction(span, controller.ActionSkip)
+				return destinationRule, nil
+			}
+			controller.SetAction(span, controller.ActionUpdate)
+			existingDestinationRule := destinationRule.DeepCopy()
+			resources.CopyDestinationRule(desiredDestinationRule, existingDestinationRule)
+			if adopt {
+				controller.Adopt(existingDestinationRule, desiredDestinationRule)
+			}
+			return r.meshClient.NetworkingV1alpha3().DestinationRules(component.Namespace).Update(existingDestinationRule)
+		}(component, destinationRule)
+		if err != nil {
+			r.logger.Errorf("Failed to update DestinationRule %q: %v", destinationRuleName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(component, "DestinationRule", destinationRuleName)
+		}
+	}
+	resources.StatusFromDestinationRule(component, destinationRule)
+	return nil
+}
+
+func (r *reconciler) reconcilePersistentVolumeClaim(ctx context.Context, component *v1alpha2.Component, volumeClaim *v1alpha2.VolumeClaim) (err error) {
 	persistentVolumeClaimName := resources.PersistentVolumeClaimName(component, volumeClaim)
+	_, span := controller.StartStepSpan(ctx, "PersistentVolumeClaim", persistentVolumeClaimName)
+	defer func() { span.Finish(err) }()
 	persistentVolumeClaim, err := r.persistentVolumeClaimLister.PersistentVolumeClaims(component.Namespace).Get(persistentVolumeClaimName)
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		persistentVolumeClaim, err = r.kubeClient.CoreV1().PersistentVolumeClaims(component.Namespace).Create(resources.MakePersistentVolumeClaim(component, volumeClaim))
 		if err != nil {
 			r.logger.Errorf("Failed to create PersistentVolumeClaim %q: %v", persistentVolumeClaimName, err)
@@ -611,15 +1256,20 @@ func (r *reconciler) reconcilePersistentVolumeClaim(component *v1alpha2.Componen
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve PersistentVolumeClaim %q: %v", persistentVolumeClaimName, err)
 		return err
+	} else {
+		controller.SetAction(span, controller.ActionSkip)
 	}
 	resources.StatusFromPersistentVolumeClaim(component, persistentVolumeClaim)
 	return nil
 }
 
-func (r *reconciler) reconcileConfiguration(component *v1alpha2.Component, configMapTemplate *corev1.ConfigMap) error {
+func (r *reconciler) reconcileConfiguration(ctx context.Context, component *v1alpha2.Component, configMapTemplate *corev1.ConfigMap) (err error) {
 	configMapName := resources.ConfigMapName(component, configMapTemplate)
+	_, span := controller.StartStepSpan(ctx, "Configuration", configMapName)
+	defer func() { span.Finish(err) }()
 	configMap, err := r.configMapLister.ConfigMaps(component.Namespace).Get(configMapName)
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		configMap, err = r.kubeClient.CoreV1().ConfigMaps(component.Namespace).Create(resources.MakeConfigMap(component, configMapTemplate))
 		if err != nil {
 			r.logger.Errorf("Failed to create ConfigMap %q: %v", configMapName, err)
@@ -630,33 +1280,45 @@ func (r *reconciler) reconcileConfiguration(component *v1alpha2.Component, confi
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve ConfigMap %q: %v", configMapName, err)
 		return err
-	} else if !metav1.IsControlledBy(configMap, component) {
-		return fmt.Errorf("component: %q does not own the ConfigMap: %q", component.Name, configMapName)
+	} else if !metav1.IsControlledBy(configMap, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), configMap) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the ConfigMap: %q", component.Name, configMapName))
 	} else {
+		adopt := !metav1.IsControlledBy(configMap, component)
 		configMap, err = func(component *v1alpha2.Component, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
-			if !resources.RequireConfigMapUpdate(component, configMap) {
+			if !adopt && !resources.RequireConfigMapUpdate(component, configMap) {
+				controller.SetAction(span, controller.ActionSkip)
 				return configMap, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredConfigMap := resources.MakeConfigMap(component, configMapTemplate)
 			existingConfigMap := configMap.DeepCopy()
 			resources.CopyConfigMap(desiredConfigMap, existingConfigMap)
+			if adopt {
+				controller.Adopt(existingConfigMap, desiredConfigMap)
+			}
 			return r.kubeClient.CoreV1().ConfigMaps(component.Namespace).Update(existingConfigMap)
 		}(component, configMap)
 		if err != nil {
 			r.logger.Errorf("Failed to update ConfigMap %q: %v", configMapName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(component, "ConfigMap", configMapName)
+		}
 	}
 	resources.StatusFromConfigMap(component, configMap)
 	return nil
 }
 
-func (r *reconciler) reconcileSecret(component *v1alpha2.Component, secretTemplate *corev1.Secret) error {
+func (r *reconciler) reconcileSecret(ctx context.Context, component *v1alpha2.Component, secretTemplate *corev1.Secret) (err error) {
 	secretName := resources.SecretName(component, secretTemplate)
+	_, span := controller.StartStepSpan(ctx, "Secret", secretName)
+	defer func() { span.Finish(err) }()
 	secret, err := r.secretLister.Secrets(component.Namespace).Get(secretName)
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		secret, err = func(component *v1alpha2.Component, secretTemplate *corev1.Secret) (*corev1.Secret, error) {
-			desiredSecret, err := resources.MakeSecret(component, secretTemplate, r.cfg)
+			desiredSecret, err := resources.MakeSecret(component, secretTemplate, r.cfg.ForNamespace(component.Namespace))
 			if err != nil {
 				return nil, err
 			}
@@ -671,30 +1333,46 @@ func (r *reconciler) reconcileSecret(component *v1alpha2.Component, secretTempla
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Secret %q: %v", secretName, err)
 		return err
-	} else if !metav1.IsControlledBy(secret, component) {
-		return fmt.Errorf("component: %q does not own the Secret: %q", component.Name, secretName)
+	} else if !metav1.IsControlledBy(secret, component) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(component, r.cellLister, r.compositeLister), secret) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the Secret: %q", component.Name, secretName))
 	} else {
+		adopt := !metav1.IsControlledBy(secret, component)
 		secret, err = func(component *v1alpha2.Component, secret *corev1.Secret) (*corev1.Secret, error) {
-			if !resources.RequireSecretUpdate(component, secret) {
+			if !adopt && !resources.RequireSecretUpdate(component, secret) &&
+				controller.ConfigHashEqual(secret, controller.CertificateHash(r.cfg.ForNamespace(component.Namespace))) {
+				controller.SetAction(span, controller.ActionSkip)
 				return secret, nil
 			}
-			desiredSecret, err := resources.MakeSecret(component, secretTemplate, r.cfg)
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredSecret, err := resources.MakeSecret(component, secretTemplate, r.cfg.ForNamespace(component.Namespace))
 			if err != nil {
 				return nil, err
 			}
 			existingSecret := secret.DeepCopy()
 			resources.CopySecret(desiredSecret, existingSecret)
+			if adopt {
+				controller.Adopt(existingSecret, desiredSecret)
+			}
 			return r.kubeClient.CoreV1().Secrets(component.Namespace).Update(existingSecret)
 		}(component, secret)
 		if err != nil {
 			r.logger.Errorf("Failed to update Secret %q: %v", secretName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(component, "Secret", secretName)
+		}
 	}
 	resources.StatusFromSecret(component, secret)
 	return nil
 }
 
+// recordAdoption records the adoption of an existing resource in the events and the status of the component.
+func (r *reconciler) recordAdoption(component *v1alpha2.Component, kind string, name string) {
+	r.recorder.Eventf(component, corev1.EventTypeNormal, "Adopted", "Adopted %s %q", kind, name)
+	component.Status.AdoptedResources = controller.AddAdoptedResource(component.Status.AdoptedResources, kind, name)
+}
+
 func (r *reconciler) updateStatus(desired *v1alpha2.Component) (*v1alpha2.Component, error) {
 	component, err := r.componentLister.Components(desired.Namespace).Get(desired.Name)
 	if err != nil {
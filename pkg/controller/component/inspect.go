@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package component
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/controller/component/resources"
+)
+
+// Inspect returns the desired child resources of the component along with the ones held by the listers.
+// The desired state of a resource which is not required by the component is empty.
+func (r *reconciler) Inspect(key string) ([]controller.ResourceState, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, err
+	}
+	original, err := r.componentLister.Components(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	component := original.DeepCopy()
+	if _, err := r.prepare(component, controller.DiscardEvents); err != nil {
+		return nil, err
+	}
+	cfg := r.cfg.ForNamespace(component.Namespace)
+
+	desiredIf := func(required bool, build func() interface{}) interface{} {
+		if !required {
+			return nil
+		}
+		return build()
+	}
+
+	var states []controller.ResourceState
+
+	serviceName := resources.ServiceName(component)
+	service, err := r.serviceLister.Services(component.Namespace).Get(serviceName)
+	states = append(states, controller.NewResourceState("Service", serviceName,
+		desiredIf(resources.RequireService(component), func() interface{} { return resources.MakeService(component) }),
+		nil, service, err))
+
+	deploymentName := resources.DeploymentName(component)
+	deployment, err := r.deploymentLister.Deployments(component.Namespace).Get(deploymentName)
+	states = append(states, controller.NewResourceState("Deployment", deploymentName,
+		desiredIf(resources.RequireDeployment(component), func() interface{} { return resources.MakeDeployment(component) }),
+		nil, deployment, err))
+
+	statefulSetName := resources.StatefulSetName(component)
+	statefulSet, err := r.statefulSetLister.StatefulSets(component.Namespace).Get(statefulSetName)
+	states = append(states, controller.NewResourceState("StatefulSet", statefulSetName,
+		desiredIf(resources.RequireStatefulSet(component), func() interface{} { return resources.MakeStatefulSet(component) }),
+		nil, statefulSet, err))
+
+	jobName := resources.JobName(component)
+	job, err := r.jobLister.Jobs(component.Namespace).Get(jobName)
+	states = append(states, controller.NewResourceState("Job", jobName,
+		desiredIf(resources.RequireJob(component), func() interface{} { return resources.MakeJob(component) }),
+		nil, job, err))
+
+	hpaName := resources.HpaName(component)
+	hpa, err := r.hpaLister.HorizontalPodAutoscalers(component.Namespace).Get(hpaName)
+	states = append(states, controller.NewResourceState("HorizontalPodAutoscaler", hpaName,
+		desiredIf(resources.RequireHpa(component) && !r.isSuspended(component), func() interface{} { return resources.MakeHpa(component) }),
+		nil, hpa, err))
+
+	servingServiceName := resources.ServingServiceName(component)
+	servingService, err := r.servingServiceLister.Services(component.Namespace).Get(servingServiceName)
+	states = append(states, controller.NewResourceState("ServingService", servingServiceName,
+		desiredIf(resources.RequireKnativeServing(component), func() interface{} { return resources.MakeServingService(component) }),
+		nil, servingService, err))
+
+	if r.istioAuthenticationAvailable {
+		policyName := resources.TlsPolicyName(component)
+		policy, err := r.istioPolicyLister.Policies(component.Namespace).Get(policyName)
+		states = append(states, controller.NewResourceState("Policy", policyName,
+			desiredIf(resources.RequireTlsPolicy(component, cfg), func() interface{} { return resources.MakeTlsPolicy(component) }),
+			nil, policy, err))
+	}
+
+	if r.istioSecurityAvailable {
+		peerAuthenticationName := resources.PeerAuthenticationName(component)
+		peerAuthentication, err := r.istioPeerAuthenticationLister.PeerAuthentications(component.Namespace).Get(peerAuthenticationName)
+		states = append(states, controller.NewResourceState("PeerAuthentication", peerAuthenticationName,
+			desiredIf(resources.RequirePeerAuthentication(component, cfg), func() interface{} { return resources.MakePeerAuthentication(component) }),
+			nil, peerAuthentication, err))
+	}
+
+	destinationRuleName := resources.DestinationRuleName(component)
+	destinationRule, err := r.istioDestinationRuleLister.DestinationRules(component.Namespace).Get(destinationRuleName)
+	if resources.RequireDestinationRule(component) {
+		desiredDestinationRule, desiredErr := resources.MakeDestinationRule(component, cfg)
+		states = append(states, controller.NewResourceState("DestinationRule", destinationRuleName,
+			desiredDestinationRule, desiredErr, destinationRule, err))
+	} else {
+		states = append(states, controller.NewResourceState("DestinationRule", destinationRuleName, nil, nil, destinationRule, err))
+	}
+
+	for i := range component.Spec.VolumeClaims {
+		volumeClaim := &component.Spec.VolumeClaims[i]
+		persistentVolumeClaimName := resources.PersistentVolumeClaimName(component, volumeClaim)
+		persistentVolumeClaim, err := r.persistentVolumeClaimLister.PersistentVolumeClaims(component.Namespace).Get(persistentVolumeClaimName)
+		states = append(states, controller.NewResourceState("PersistentVolumeClaim", persistentVolumeClaimName,
+			resources.MakePersistentVolumeClaim(component, volumeClaim), nil, persistentVolumeClaim, err))
+	}
+
+	for i := range component.Spec.Configurations {
+		configMapTemplate := &component.Spec.Configurations[i]
+		configMapName := resources.ConfigMapName(component, configMapTemplate)
+		configMap, err := r.configMapLister.ConfigMaps(component.Namespace).Get(configMapName)
+		states = append(states, controller.NewResourceState("ConfigMap", configMapName,
+			resources.MakeConfigMap(component, configMapTemplate), nil, configMap, err))
+	}
+
+	for i := range component.Spec.Secrets {
+		secretTemplate := &component.Spec.Secrets[i]
+		secretName := resources.SecretName(component, secretTemplate)
+		desiredSecret, desiredErr := resources.MakeSecret(component, secretTemplate, cfg)
+		secret, err := r.secretLister.Secrets(component.Namespace).Get(secretName)
+		states = append(states, controller.NewResourceState("Secret", secretName,
+			controller.RedactSecret(desiredSecret), desiredErr, controller.RedactSecret(secret), err))
+	}
+
+	return states, nil
+}
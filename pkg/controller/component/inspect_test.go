@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package component
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
+	fakeclients "cellery.io/cellery-controller/pkg/clients/fake"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	fakeinformers "cellery.io/cellery-controller/pkg/informers/fake"
+	"cellery.io/cellery-controller/pkg/meta"
+	. "cellery.io/cellery-controller/pkg/testing/apis/mesh/v1alpha2"
+)
+
+func TestInspectHpa(t *testing.T) {
+	override := AutoscaleOverride("salary-peak", "foo", "employee", WithAutoscaleOverrideComponent("salary"), WithAutoscaleOverrideReplicas(4, 20))
+	tests := []struct {
+		name            string
+		objects         []runtime.Object
+		wantMaxReplicas int32
+	}{
+		{
+			name:            "autoscale override applied",
+			objects:         []runtime.Object{overriddenComponent(), override},
+			wantMaxReplicas: 20,
+		},
+		{
+			name:    "suspended",
+			objects: []runtime.Object{overriddenComponent(WithComponentAnnotation(meta.SuspendedAnnotationKey, meta.SuspendedValue))},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clients := fakeclients.New(test.objects...)
+			informers := fakeinformers.New(clients, 0, test.objects...)
+			recorder := record.NewFakeRecorder(10)
+			r := newTestReconciler(clients, informers, fakeconfig.New(nil), recorder).(*reconciler)
+
+			states, err := r.Inspect("foo/employee--salary")
+			if err != nil {
+				t.Fatalf("Inspect() returned an error: %v", err)
+			}
+			var maxReplicas int32
+			for _, state := range states {
+				if hpa, ok := state.Desired.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+					maxReplicas = hpa.Spec.MaxReplicas
+				}
+			}
+			if maxReplicas != test.wantMaxReplicas {
+				t.Errorf("Inspect() desired HPA max replicas = %d, want %d", maxReplicas, test.wantMaxReplicas)
+			}
+			if len(recorder.Events) > 0 {
+				t.Errorf("Inspect() recorded event %q", <-recorder.Events)
+			}
+		})
+	}
+}
@@ -38,9 +38,10 @@ const readinessSuccessThreshold = 1
 func MakeDeployment(component *v1alpha2.Component) *appsv1.Deployment {
 	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      DeploymentName(component),
-			Namespace: component.Namespace,
-			Labels:    makeLabels(component),
+			Name:        DeploymentName(component),
+			Namespace:   component.Namespace,
+			Labels:      makeLabels(component),
+			Annotations: makeScheduleAnnotations(component),
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateComponentOwnerRef(component),
 			},
@@ -72,14 +73,15 @@ func RequireDeployment(component *v1alpha2.Component) bool {
 
 func RequireDeploymentUpdate(component *v1alpha2.Component, deployment *appsv1.Deployment) bool {
 	return component.Generation != component.Status.ObservedGeneration ||
-		deployment.Generation != component.Status.DeploymentGeneration
+		deployment.Generation != component.Status.DeploymentGeneration ||
+		deployment.Annotations[meta.ActiveScheduleAnnotationKey] != component.Status.ActiveSchedule
 }
 
 func CopyDeployment(source, destination *appsv1.Deployment, component *v1alpha2.Component) {
 	destination.Spec.Template = source.Spec.Template
 	destination.Spec.Selector = source.Spec.Selector
 	// If the scaling policy is using HPA, should not update the replicas forcefully since the replica count will be updated by the HPA.
-	if !component.Spec.ScalingPolicy.IsHpa() {
+	if !component.Spec.ScalingPolicy.IsAutoscaled() {
 		destination.Spec.Replicas = source.Spec.Replicas
 	}
 	destination.Labels = source.Labels
@@ -20,36 +20,43 @@ package resources
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
-	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
-func MakeHpa(component *v1alpha2.Component) *autoscalingv2beta1.HorizontalPodAutoscaler {
-	return &autoscalingv2beta1.HorizontalPodAutoscaler{
+func MakeHpa(component *v1alpha2.Component) *autoscalingv2.HorizontalPodAutoscaler {
+	return &autoscalingv2.HorizontalPodAutoscaler{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      HpaName(component),
-			Namespace: component.Namespace,
-			Labels:    makeLabels(component),
+			Name:        HpaName(component),
+			Namespace:   component.Namespace,
+			Labels:      makeLabels(component),
+			Annotations: makeHpaAnnotations(component),
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateComponentOwnerRef(component),
 			},
 		},
-		Spec: autoscalingv2beta1.HorizontalPodAutoscalerSpec{
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
 			MinReplicas:    component.Spec.ScalingPolicy.Hpa.MinReplicas,
 			MaxReplicas:    component.Spec.ScalingPolicy.Hpa.MaxReplicas,
 			ScaleTargetRef: makeTargetRef(component),
-			Metrics:        component.Spec.ScalingPolicy.Hpa.Metrics,
+			Metrics:        controller.ConvertMetrics(component.Spec.ScalingPolicy.Hpa.Metrics),
+			Behavior:       component.Spec.ScalingPolicy.Hpa.Behavior,
 		},
 	}
 }
 
-func makeTargetRef(component *v1alpha2.Component) autoscalingv2beta1.CrossVersionObjectReference {
+func makeTargetRef(component *v1alpha2.Component) autoscalingv2.CrossVersionObjectReference {
 	kind := "Deployment"
 	name := DeploymentName(component)
-	return autoscalingv2beta1.CrossVersionObjectReference{
+	if component.Spec.Type == v1alpha2.ComponentTypeStatefulSet {
+		kind = "StatefulSet"
+		name = StatefulSetName(component)
+	}
+	return autoscalingv2.CrossVersionObjectReference{
 		Kind:       kind,
 		Name:       name,
 		APIVersion: appsv1.SchemeGroupVersion.String(),
@@ -60,20 +67,23 @@ func RequireHpa(component *v1alpha2.Component) bool {
 	return (component.Spec.Type == v1alpha2.ComponentTypeDeployment || component.Spec.Type == v1alpha2.ComponentTypeStatefulSet) && component.Spec.ScalingPolicy.IsHpa()
 }
 
-func RequireHpaUpdate(component *v1alpha2.Component, hpa *autoscalingv2beta1.HorizontalPodAutoscaler) bool {
+func RequireHpaUpdate(component *v1alpha2.Component, hpa *autoscalingv2.HorizontalPodAutoscaler) bool {
 	return component.Generation != component.Status.ObservedGeneration ||
-		hpa.Generation != component.Status.HpaGeneration
+		hpa.Generation != component.Status.HpaGeneration ||
+		hpa.Annotations[meta.ActiveScheduleAnnotationKey] != component.Status.ActiveSchedule ||
+		hpa.Annotations[meta.AutoscaleOverrideAnnotationKey] != makeHpaAnnotations(component)[meta.AutoscaleOverrideAnnotationKey]
 }
 
-func CopyHpa(source, destination *autoscalingv2beta1.HorizontalPodAutoscaler) {
+func CopyHpa(source, destination *autoscalingv2.HorizontalPodAutoscaler) {
 	destination.Spec.ScaleTargetRef = source.Spec.ScaleTargetRef
 	destination.Spec.MinReplicas = source.Spec.MinReplicas
 	destination.Spec.MaxReplicas = source.Spec.MaxReplicas
 	destination.Spec.Metrics = source.Spec.Metrics
+	destination.Spec.Behavior = source.Spec.Behavior
 	destination.Labels = source.Labels
 	destination.Annotations = source.Annotations
 }
 
-func StatusFromHpa(component *v1alpha2.Component, hpa *autoscalingv2beta1.HorizontalPodAutoscaler) {
+func StatusFromHpa(component *v1alpha2.Component, hpa *autoscalingv2.HorizontalPodAutoscaler) {
 	component.Status.HpaGeneration = hpa.Generation
 }
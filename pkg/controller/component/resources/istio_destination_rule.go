@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	istionetworkingv1alpha3 "cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+// MakeDestinationRule applies the traffic policy of the component to the connections to its Service. The
+// Service of a zero scaling component has the same name, so the policy applies to both.
+func MakeDestinationRule(component *v1alpha2.Component, cfg config.Interface) (*istionetworkingv1alpha3.DestinationRule, error) {
+	trafficPolicy, err := controller.MakeIstioTrafficPolicy(component.Spec.TrafficPolicy, cfg)
+	if err != nil {
+		return nil, err
+	}
+	destinationRule := &istionetworkingv1alpha3.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DestinationRuleName(component),
+			Namespace: component.Namespace,
+			Labels:    makeLabels(component),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateComponentOwnerRef(component),
+			},
+		},
+		Spec: istionetworkingv1alpha3.DestinationRuleSpec{
+			Host:          ServiceName(component) + "." + component.Namespace + ".svc.cluster.local",
+			TrafficPolicy: trafficPolicy,
+		},
+	}
+	meta.AddObjectHash(destinationRule)
+	return destinationRule, nil
+}
+
+func RequireDestinationRule(component *v1alpha2.Component) bool {
+	return component.Spec.TrafficPolicy != nil
+}
+
+// RequireDestinationRuleUpdate returns true if the component or the DestinationRule is changed, or if the
+// desired DestinationRule is changed by the default traffic policy of the config.
+func RequireDestinationRuleUpdate(component *v1alpha2.Component, desired, destinationRule *istionetworkingv1alpha3.DestinationRule) bool {
+	return component.Generation != component.Status.ObservedGeneration ||
+		destinationRule.Generation != component.Status.DestinationRuleGeneration ||
+		!meta.HashEqual(desired, destinationRule)
+}
+
+func CopyDestinationRule(source, destination *istionetworkingv1alpha3.DestinationRule) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
+func StatusFromDestinationRule(component *v1alpha2.Component, destinationRule *istionetworkingv1alpha3.DestinationRule) {
+	component.Status.DestinationRuleGeneration = destinationRule.Generation
+}
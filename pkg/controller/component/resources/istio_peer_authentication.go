@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	istiosecurityv1beta1 "cellery.io/cellery-controller/pkg/apis/istio/security/v1beta1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
+)
+
+// MakePeerAuthentication disables mTLS for the TCP ports of the component. The port level settings
+// use the container ports since the PeerAuthentication is applied to the workload.
+// See https://istio.io/faq/security/#mysql-with-mtls
+func MakePeerAuthentication(component *v1alpha2.Component) *istiosecurityv1beta1.PeerAuthentication {
+	portLevelMtls := make(map[uint32]istiosecurityv1beta1.PeerAuthenticationMutualTLS)
+	for _, p := range component.Spec.Ports {
+		if p.Protocol == v1alpha2.ProtocolTCP {
+			portLevelMtls[uint32(p.TargetPort)] = istiosecurityv1beta1.PeerAuthenticationMutualTLS{
+				Mode: istiosecurityv1beta1.PeerAuthenticationModeDisable,
+			}
+		}
+	}
+	return &istiosecurityv1beta1.PeerAuthentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PeerAuthenticationName(component),
+			Namespace: component.Namespace,
+			Labels:    makeLabels(component),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateComponentOwnerRef(component),
+			},
+		},
+		Spec: istiosecurityv1beta1.PeerAuthenticationSpec{
+			Selector: &istiosecurityv1beta1.WorkloadSelector{
+				MatchLabels: makeLabels(component),
+			},
+			PortLevelMtls: portLevelMtls,
+		},
+	}
+}
+
+func RequirePeerAuthentication(component *v1alpha2.Component, cfg config.Interface) bool {
+	return hasTcpPorts(component) && controller.UseIstioSecurityPolicies(cfg)
+}
+
+func RequirePeerAuthenticationUpdate(component *v1alpha2.Component, peerAuthentication *istiosecurityv1beta1.PeerAuthentication) bool {
+	return component.Generation != component.Status.ObservedGeneration ||
+		peerAuthentication.Generation != component.Status.PeerAuthenticationGeneration
+}
+
+func CopyPeerAuthentication(source, destination *istiosecurityv1beta1.PeerAuthentication) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
+func StatusFromPeerAuthentication(component *v1alpha2.Component, peerAuthentication *istiosecurityv1beta1.PeerAuthentication) {
+	component.Status.PeerAuthenticationGeneration = peerAuthentication.Generation
+}
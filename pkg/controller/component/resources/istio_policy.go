@@ -23,6 +23,7 @@ import (
 
 	istioauthenticationv1alpha1 "cellery.io/cellery-controller/pkg/apis/istio/authentication/v1alpha1"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
 )
 
@@ -47,15 +48,19 @@ func MakeTlsPolicy(component *v1alpha2.Component) *istioauthenticationv1alpha1.P
 	}
 }
 
-func RequireTlsPolicy(component *v1alpha2.Component) bool {
-	b := false
+// RequireTlsPolicy reports whether mTLS should be disabled for the component using an
+// authentication.istio.io/v1alpha1 Policy. The Policy is only used with istio versions prior to 1.5.
+func RequireTlsPolicy(component *v1alpha2.Component, cfg config.Interface) bool {
+	return hasTcpPorts(component) && !controller.UseIstioSecurityPolicies(cfg)
+}
+
+func hasTcpPorts(component *v1alpha2.Component) bool {
 	for _, v := range component.Spec.Ports {
 		if v.Protocol == v1alpha2.ProtocolTCP {
-			b = true
-			break
+			return true
 		}
 	}
-	return b
+	return false
 }
 
 func RequireTlsPolicyUpdate(component *v1alpha2.Component, policy *istioauthenticationv1alpha1.Policy) bool {
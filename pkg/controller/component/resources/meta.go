@@ -19,6 +19,8 @@
 package resources
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -56,10 +58,32 @@ func makePodAnnotations(component *v1alpha2.Component) map[string]string {
 	)
 }
 
-func makeServingDeploymentSelector(component *v1alpha2.Component) labels.Selector {
+// makeScheduleAnnotations records the active scaling schedule in the workload and the HPA so that
+// they are updated when the schedule changes.
+func makeScheduleAnnotations(component *v1alpha2.Component) map[string]string {
+	if len(component.Status.ActiveSchedule) == 0 {
+		return nil
+	}
+	return map[string]string{
+		ActiveScheduleAnnotationKey: component.Status.ActiveSchedule,
+	}
+}
+
+// makeHpaAnnotations records the active scaling schedule and the AutoscaleOverride merged into the HPA
+// so that it is updated when either of them changes.
+func makeHpaAnnotations(component *v1alpha2.Component) map[string]string {
+	annotations := makeScheduleAnnotations(component)
+	if len(component.Status.AutoscaleOverride) == 0 {
+		return annotations
+	}
+	return UnionMaps(annotations, map[string]string{
+		AutoscaleOverrideAnnotationKey: fmt.Sprintf("%s/%d", component.Status.AutoscaleOverride, component.Status.AutoscaleOverrideGeneration),
+	})
+}
+
+func makeServingDeploymentSelector(revisionName string) labels.Selector {
 	return labels.SelectorFromSet(map[string]string{
-		"serving.knative.dev/configuration": ServingConfigurationName(component),
-		"serving.knative.dev/revision":      ServingRevisionName(component),
+		KnativeRevisionLabelKey: revisionName,
 	})
 }
 
@@ -95,10 +119,28 @@ func HpaName(component *v1alpha2.Component) string {
 	return component.Name + "-hpa"
 }
 
+func ScaledObjectName(component *v1alpha2.Component) string {
+	return component.Name + "-scaledobject"
+}
+
+func TriggerAuthenticationName(component *v1alpha2.Component, trigger *v1alpha2.EventDrivenTrigger) string {
+	return component.Name + "-" + trigger.Name + "-auth"
+}
+
+// ServingServiceName is the name of the Knative Service of a zero scaling component. Knative creates a
+// Kubernetes Service with the same name so the component is addressed as any other component.
+func ServingServiceName(component *v1alpha2.Component) string {
+	return ServiceName(component)
+}
+
+// ServingConfigurationName is the name of the serving.knative.dev/v1alpha1 Configuration which served
+// the component before the serving.knative.dev/v1 Service.
 func ServingConfigurationName(component *v1alpha2.Component) string {
 	return component.Name
 }
 
+// ServingVirtualServiceName is the name of the Istio VirtualService which routed the requests to the
+// serving.knative.dev/v1alpha1 revision of the component.
 func ServingVirtualServiceName(component *v1alpha2.Component) string {
 	return component.Name + "-mesh"
 }
@@ -107,6 +149,10 @@ func TlsPolicyName(component *v1alpha2.Component) string {
 	return component.Name + "-tls"
 }
 
-func ServingRevisionName(component *v1alpha2.Component) string {
-	return ServingConfigurationName(component) + "-service"
+func PeerAuthenticationName(component *v1alpha2.Component) string {
+	return component.Name + "-tls"
+}
+
+func DestinationRuleName(component *v1alpha2.Component) string {
+	return component.Name + "-destination-rule"
 }
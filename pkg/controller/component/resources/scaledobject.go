@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kedav1alpha1 "cellery.io/cellery-controller/pkg/apis/keda/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+func MakeScaledObject(component *v1alpha2.Component) *kedav1alpha1.ScaledObject {
+	eventDriven := component.Spec.ScalingPolicy.EventDriven
+	targetRef := makeTargetRef(component)
+	var maxReplicas *int32
+	if eventDriven.MaxReplicas > 0 {
+		max := eventDriven.MaxReplicas
+		maxReplicas = &max
+	}
+	return &kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ScaledObjectName(component),
+			Namespace:   component.Namespace,
+			Labels:      makeLabels(component),
+			Annotations: makeScheduleAnnotations(component),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateComponentOwnerRef(component),
+			},
+		},
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+				Name:       targetRef.Name,
+				APIVersion: targetRef.APIVersion,
+				Kind:       targetRef.Kind,
+			},
+			PollingInterval: eventDriven.PollingInterval,
+			CooldownPeriod:  eventDriven.CooldownPeriod,
+			MinReplicaCount: eventDriven.MinReplicas,
+			MaxReplicaCount: maxReplicas,
+			Triggers:        makeScaleTriggers(component),
+		},
+	}
+}
+
+func makeScaleTriggers(component *v1alpha2.Component) []kedav1alpha1.ScaleTriggers {
+	var triggers []kedav1alpha1.ScaleTriggers
+	for i := range component.Spec.ScalingPolicy.EventDriven.Triggers {
+		trigger := &component.Spec.ScalingPolicy.EventDriven.Triggers[i]
+		scaleTrigger := kedav1alpha1.ScaleTriggers{
+			Type:     trigger.Type,
+			Name:     trigger.Name,
+			Metadata: trigger.Metadata,
+		}
+		if RequireTriggerAuthentication(component, trigger) {
+			scaleTrigger.AuthenticationRef = &kedav1alpha1.ScaledObjectAuthRef{
+				Name: TriggerAuthenticationName(component, trigger),
+			}
+		}
+		triggers = append(triggers, scaleTrigger)
+	}
+	return triggers
+}
+
+func RequireScaledObject(component *v1alpha2.Component) bool {
+	return (component.Spec.Type == v1alpha2.ComponentTypeDeployment || component.Spec.Type == v1alpha2.ComponentTypeStatefulSet) && component.Spec.ScalingPolicy.IsEventDriven()
+}
+
+func RequireScaledObjectUpdate(component *v1alpha2.Component, scaledObject *kedav1alpha1.ScaledObject) bool {
+	return component.Generation != component.Status.ObservedGeneration ||
+		scaledObject.Generation != component.Status.ScaledObjectGeneration ||
+		scaledObject.Annotations[meta.ActiveScheduleAnnotationKey] != component.Status.ActiveSchedule
+}
+
+func CopyScaledObject(source, destination *kedav1alpha1.ScaledObject) {
+	destination.Spec.ScaleTargetRef = source.Spec.ScaleTargetRef
+	destination.Spec.PollingInterval = source.Spec.PollingInterval
+	destination.Spec.CooldownPeriod = source.Spec.CooldownPeriod
+	destination.Spec.MinReplicaCount = source.Spec.MinReplicaCount
+	destination.Spec.MaxReplicaCount = source.Spec.MaxReplicaCount
+	destination.Spec.Triggers = source.Spec.Triggers
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
+func StatusFromScaledObject(component *v1alpha2.Component, scaledObject *kedav1alpha1.ScaledObject) {
+	component.Status.ScaledObjectGeneration = scaledObject.Generation
+}
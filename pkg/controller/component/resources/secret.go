@@ -28,6 +28,7 @@ import (
 	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
 	"cellery.io/cellery-controller/pkg/crypto"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
 func MakeSecret(component *v1alpha2.Component, secret *corev1.Secret, cfg config.Interface) (*corev1.Secret, error) {
@@ -51,6 +52,9 @@ func MakeSecret(component *v1alpha2.Component, secret *corev1.Secret, cfg config
 			Name:      SecretName(component, secret),
 			Namespace: component.Namespace,
 			Labels:    makeLabels(component),
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: controller.CertificateHash(cfg),
+			},
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateComponentOwnerRef(component),
 			},
@@ -19,7 +19,6 @@
 package resources
 
 import (
-	"fmt"
 	"strconv"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -27,73 +26,54 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
-	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
-	servingv1alpha1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1alpha1"
-	servingv1beta1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1beta1"
+	servingv1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
+	"cellery.io/cellery-controller/pkg/ptr"
 )
 
-func MakeServingConfiguration(component *v1alpha2.Component) *servingv1alpha1.Configuration {
-
-	var container corev1.Container
-
-	if len(component.Spec.Template.Containers) > 0 {
-		container = component.Spec.Template.Containers[0]
-	}
-	// Reset the user specified container ports
-	// https://github.com/knative/serving/blob/master/docs/runtime-contract.md
-	container.Ports = []corev1.ContainerPort{}
-	if pm := findServingContainerPortMapping(component, container.Name); pm != nil {
-		container.Ports = append(container.Ports, corev1.ContainerPort{
-			ContainerPort: pm.TargetPort,
-			Name: func() string {
-				if pm.Protocol == v1alpha2.ProtocolGRPC {
-					return "h2c"
-				}
-				return "http1"
-			}(),
-		})
+func MakeServingService(component *v1alpha2.Component) *servingv1.Service {
+	kpa := component.Spec.ScalingPolicy.Kpa
+	var containerConcurrency *int64
+	if kpa.Concurrency > 0 {
+		containerConcurrency = ptr.Int64(kpa.Concurrency)
 	}
 
-	// Container name is set by the knative controller
-	container.Name = ""
-
-	kpaOpts := component.Spec.ScalingPolicy.Kpa
-
-	return &servingv1alpha1.Configuration{
+	return &servingv1.Service{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      ServingConfigurationName(component),
+			Name:      ServingServiceName(component),
 			Namespace: component.Namespace,
-			Labels:    makeLabels(component),
+			// The component is only reachable within the cluster through the cell gateways
+			Labels: meta.UnionMaps(makeLabels(component), map[string]string{
+				meta.KnativeVisibilityLabelKey: meta.KnativeVisibilityClusterLocal,
+			}),
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateComponentOwnerRef(component),
 			},
 		},
-		Spec: servingv1alpha1.ConfigurationSpec{
-			Template: &servingv1alpha1.RevisionTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: ServingRevisionName(component),
-					Annotations: map[string]string{
-						"autoscaling.knative.dev/maxScale": strconv.Itoa(int(kpaOpts.MaxReplicas)),
+		Spec: servingv1.ServiceSpec{
+			ConfigurationSpec: servingv1.ConfigurationSpec{
+				Template: servingv1.RevisionTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      makeLabels(component),
+						Annotations: makeServingAnnotations(component),
+					},
+					Spec: servingv1.RevisionSpec{
+						PodSpec: makePodSpec(component,
+							addServingPort(component),
+							addConfigMapVolumes(component),
+							addSecretVolumes(component),
+						),
+						ContainerConcurrency: containerConcurrency,
 					},
-					// Labels: createLabelsWithComponentFlag(createLabels(component)),
-					Labels: makeLabels(component),
 				},
-				Spec: servingv1alpha1.RevisionSpec{
-					RevisionSpec: servingv1beta1.RevisionSpec{
-						PodSpec: servingv1beta1.PodSpec{
-							Containers: []corev1.Container{
-								container,
-							},
-						},
-						ContainerConcurrency: func() servingv1beta1.RevisionContainerConcurrencyType {
-							c := kpaOpts.Concurrency
-							if c < 1 {
-								return 0
-							}
-							return servingv1beta1.RevisionContainerConcurrencyType(c)
-						}(),
+			},
+			RouteSpec: servingv1.RouteSpec{
+				Traffic: []servingv1.TrafficTarget{
+					{
+						LatestRevision: ptr.Bool(true),
+						Percent:        ptr.Int64(100),
 					},
 				},
 			},
@@ -101,74 +81,54 @@ func MakeServingConfiguration(component *v1alpha2.Component) *servingv1alpha1.Co
 	}
 }
 
-func MakeServingVirtualService(component *v1alpha2.Component) *v1alpha3.VirtualService {
-
-	var container corev1.Container
-
-	if len(component.Spec.Template.Containers) > 0 {
-		container = component.Spec.Template.Containers[0]
+// makeServingAnnotations configures the Knative autoscaler of the revisions with the kpa options of the component.
+func makeServingAnnotations(component *v1alpha2.Component) map[string]string {
+	kpa := component.Spec.ScalingPolicy.Kpa
+	annotations := makePodAnnotations(component)
+	if kpa.MinReplicas != nil {
+		annotations[meta.KnativeMinScaleAnnotationKey] = strconv.Itoa(int(*kpa.MinReplicas))
 	}
+	if kpa.MaxReplicas > 0 {
+		annotations[meta.KnativeMaxScaleAnnotationKey] = strconv.Itoa(int(kpa.MaxReplicas))
+	}
+	if kpa.TargetUtilization != nil {
+		annotations[meta.KnativeTargetUtilizationAnnotationKey] = strconv.Itoa(int(*kpa.TargetUtilization))
+	}
+	if kpa.ScaleDownDelay != nil {
+		annotations[meta.KnativeScaleDownDelayAnnotationKey] = kpa.ScaleDownDelay.Duration.String()
+	}
+	return annotations
+}
 
-	selector := component.Spec.ScalingPolicy.Kpa.Selector
-
-	return &v1alpha3.VirtualService{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      ServingVirtualServiceName(component),
-			Namespace: component.Namespace,
-			Labels:    makeLabels(component),
-			OwnerReferences: []metav1.OwnerReference{
-				*controller.CreateComponentOwnerRef(component),
-			},
-		},
-		Spec: v1alpha3.VirtualServiceSpec{
-			Gateways: []string{"mesh"},
-			Hosts:    []string{ServingRevisionName(component)},
-			Http: []*v1alpha3.HTTPRoute{
-				{
-					AppendHeaders: map[string]string{
-						controller.KnativeServingNamespaceHeader: component.Namespace,
-						controller.KnativeServingRevisionHeader:  ServingRevisionName(component),
-					},
-					Match: []*v1alpha3.HTTPMatchRequest{
-						{
-							Authority: &v1alpha3.StringMatch{
-								Regex: fmt.Sprintf("^%s(?::\\d{1,5})?$", ServingRevisionName(component)),
-							},
-							SourceLabels: selector,
-						},
-						{
-							Authority: &v1alpha3.StringMatch{
-								Regex: fmt.Sprintf("^%s\\.%s(?::\\d{1,5})?$", ServingRevisionName(component), component.Namespace),
-							},
-							SourceLabels: selector,
-						},
-						{
-							Authority: &v1alpha3.StringMatch{
-								Regex: fmt.Sprintf("^%s\\.%s\\.svc\\.cluster\\.local(?::\\d{1,5})?$", ServingRevisionName(component), component.Namespace),
-							},
-							SourceLabels: selector,
-						},
-					},
-					Route: []*v1alpha3.DestinationWeight{
-						{
-							Destination: &v1alpha3.Destination{
-								Host: ServingRevisionName(component),
-								Port: &v1alpha3.PortSelector{
-									Number: func() uint32 {
-										if pm := findServingContainerPortMapping(component, container.Name); pm != nil {
-											if pm.Protocol == v1alpha2.ProtocolGRPC {
-												return 81
-											}
-										}
-										return 80
-									}(),
-								},
-							},
-						},
+// addServingPort exposes the port of the component on a single container since a Knative revision
+// routes the requests to only one port. The other containers run as sidecars without any ports.
+// https://github.com/knative/serving/blob/main/docs/runtime-contract.md
+func addServingPort(component *v1alpha2.Component) podSpecOption {
+	return func(podSpec *corev1.PodSpec) {
+		containers := make([]corev1.Container, len(podSpec.Containers))
+		copy(containers, podSpec.Containers)
+		served := false
+		for i := range containers {
+			containers[i].Ports = nil
+			if served {
+				continue
+			}
+			if pm := findServingContainerPortMapping(component, containers[i].Name); pm != nil {
+				containers[i].Ports = []corev1.ContainerPort{
+					{
+						ContainerPort: pm.TargetPort,
+						Name: func() string {
+							if pm.Protocol == v1alpha2.ProtocolGRPC {
+								return "h2c"
+							}
+							return "http1"
+						}(),
 					},
-				},
-			},
-		},
+				}
+				served = true
+			}
+		}
+		podSpec.Containers = containers
 	}
 }
 
@@ -176,17 +136,34 @@ func RequireKnativeServing(component *v1alpha2.Component) bool {
 	return component.Spec.Type == v1alpha2.ComponentTypeDeployment && component.Spec.ScalingPolicy.IsKpa()
 }
 
-func RequireServingConfigurationUpdate(component *v1alpha2.Component, configuration *servingv1alpha1.Configuration) bool {
+func RequireServingServiceUpdate(component *v1alpha2.Component, service *servingv1.Service) bool {
 	return component.Generation != component.Status.ObservedGeneration ||
-		configuration.Generation != component.Status.ServingConfigurationGeneration
+		service.Generation != component.Status.ServingServiceGeneration
+}
+
+func CopyServingService(source, destination *servingv1.Service) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
 }
 
-func StatusFromServingConfiguration(component *v1alpha2.Component, configuration *servingv1alpha1.Configuration,
+func StatusFromServingService(component *v1alpha2.Component, service *servingv1.Service,
 	listerFn func(selector labels.Selector) ([]*appsv1.Deployment, error)) {
 	component.Status.Type = v1alpha2.ComponentTypeDeployment
-	component.Status.ServingConfigurationGeneration = configuration.Generation
-	// manually check the status of the deployment created by this configuration revision
-	deployments, err := listerFn(makeServingDeploymentSelector(component))
+	component.Status.ServingServiceGeneration = service.Generation
+	component.Status.ServiceName = service.Name
+	setServingCondition(component, service, v1alpha2.ComponentServingReady, servingv1.ConditionReady)
+	setServingCondition(component, service, v1alpha2.ComponentServingConfigurationsReady, servingv1.ServiceConditionConfigurationsReady)
+	setServingCondition(component, service, v1alpha2.ComponentServingRoutesReady, servingv1.ServiceConditionRoutesReady)
+
+	ready := service.Status.GetCondition(servingv1.ConditionReady)
+	if ready == nil || ready.Status != corev1.ConditionTrue || len(service.Status.LatestReadyRevisionName) == 0 {
+		component.Status.AvailableReplicas = 0
+		component.Status.Status = v1alpha2.ComponentCurrentStatusNotReady
+		return
+	}
+	// The replicas of the latest ready revision are only available in the deployment created for it
+	deployments, err := listerFn(makeServingDeploymentSelector(service.Status.LatestReadyRevisionName))
 	if err != nil || len(deployments) == 0 {
 		component.Status.AvailableReplicas = 0
 		component.Status.Status = v1alpha2.ComponentCurrentStatusNotReady
@@ -201,19 +178,24 @@ func StatusFromServingConfiguration(component *v1alpha2.Component, configuration
 	}
 }
 
-func RequireServingVirtualServiceUpdate(component *v1alpha2.Component, virtualService *v1alpha3.VirtualService) bool {
-	return component.Generation != component.Status.ObservedGeneration ||
-		virtualService.Generation != component.Status.ServingVirtualServiceGeneration
-}
-
-func CopyServingVirtualService(source, destination *v1alpha3.VirtualService) {
-	destination.Spec = source.Spec
-	destination.Labels = source.Labels
-	destination.Annotations = source.Annotations
+// setServingCondition reports a condition of the Knative Service in the status of the component.
+// The condition is unknown until the Knative controller reports it.
+func setServingCondition(component *v1alpha2.Component, service *servingv1.Service,
+	conditionType v1alpha2.ComponentConditionType, servingConditionType servingv1.ConditionType) {
+	condition := service.Status.GetCondition(servingConditionType)
+	if condition == nil {
+		component.Status.SetConditionWithReason(conditionType, corev1.ConditionUnknown, "", "")
+		return
+	}
+	component.Status.SetConditionWithReason(conditionType, condition.Status, condition.Reason, condition.Message)
 }
 
-func StatusFromServingVirtualService(component *v1alpha2.Component, virtualService *v1alpha3.VirtualService) {
-	component.Status.ServingVirtualServiceGeneration = virtualService.Generation
+// ResetServingConditions removes the conditions of the Knative Service from the status of a component
+// which is no longer served by Knative.
+func ResetServingConditions(component *v1alpha2.Component) {
+	component.Status.RemoveCondition(v1alpha2.ComponentServingReady)
+	component.Status.RemoveCondition(v1alpha2.ComponentServingConfigurationsReady)
+	component.Status.RemoveCondition(v1alpha2.ComponentServingRoutesReady)
 }
 
 func findServingContainerPortMapping(component *v1alpha2.Component, targetContainer string) *v1alpha2.PortMapping {
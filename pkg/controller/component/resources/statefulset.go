@@ -25,15 +25,17 @@ import (
 
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 	"cellery.io/cellery-controller/pkg/ptr"
 )
 
 func MakeStatefulSet(component *v1alpha2.Component) *appsv1.StatefulSet {
 	return &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      StatefulSetName(component),
-			Namespace: component.Namespace,
-			Labels:    makeLabels(component),
+			Name:        StatefulSetName(component),
+			Namespace:   component.Namespace,
+			Labels:      makeLabels(component),
+			Annotations: makeScheduleAnnotations(component),
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateComponentOwnerRef(component),
 			},
@@ -72,13 +74,14 @@ func RequireStatefulSet(component *v1alpha2.Component) bool {
 
 func RequireStatefulSetUpdate(component *v1alpha2.Component, statefulSet *appsv1.StatefulSet) bool {
 	return component.Generation != component.Status.ObservedGeneration ||
-		statefulSet.Generation != component.Status.StatefulSetGeneration
+		statefulSet.Generation != component.Status.StatefulSetGeneration ||
+		statefulSet.Annotations[meta.ActiveScheduleAnnotationKey] != component.Status.ActiveSchedule
 }
 
 func CopyStatefulSet(source, destination *appsv1.StatefulSet, component *v1alpha2.Component) {
 	destination.Spec.Template = source.Spec.Template
 	// If the scaling policy is using HPA, should not update the replicas forcefully since the replica count will be updated by the HPA.
-	if !component.Spec.ScalingPolicy.IsHpa() {
+	if !component.Spec.ScalingPolicy.IsAutoscaled() {
 		destination.Spec.Replicas = source.Spec.Replicas
 	}
 	destination.Spec.Selector = source.Spec.Selector
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kedav1alpha1 "cellery.io/cellery-controller/pkg/apis/keda/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/controller"
+)
+
+func MakeTriggerAuthentication(component *v1alpha2.Component, trigger *v1alpha2.EventDrivenTrigger) *kedav1alpha1.TriggerAuthentication {
+	var secretTargetRefs []kedav1alpha1.AuthSecretTargetRef
+	for _, ref := range trigger.SecretRefs {
+		secretTargetRefs = append(secretTargetRefs, kedav1alpha1.AuthSecretTargetRef{
+			Parameter: ref.Parameter,
+			Name:      SecretName(component, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ref.Secret}}),
+			Key:       ref.Key,
+		})
+	}
+	return &kedav1alpha1.TriggerAuthentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TriggerAuthenticationName(component, trigger),
+			Namespace: component.Namespace,
+			Labels:    makeLabels(component),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateComponentOwnerRef(component),
+			},
+		},
+		Spec: kedav1alpha1.TriggerAuthenticationSpec{
+			SecretTargetRef: secretTargetRefs,
+		},
+	}
+}
+
+func RequireTriggerAuthentication(component *v1alpha2.Component, trigger *v1alpha2.EventDrivenTrigger) bool {
+	return RequireScaledObject(component) && len(trigger.SecretRefs) > 0
+}
+
+func RequireTriggerAuthenticationUpdate(component *v1alpha2.Component, triggerAuthentication *kedav1alpha1.TriggerAuthentication) bool {
+	return component.Generation != component.Status.ObservedGeneration ||
+		triggerAuthentication.Generation != component.Status.TriggerAuthenticationGenerations[triggerAuthentication.Name]
+}
+
+func CopyTriggerAuthentication(source, destination *kedav1alpha1.TriggerAuthentication) {
+	destination.Spec.SecretTargetRef = source.Spec.SecretTargetRef
+	destination.Labels = source.Labels
+}
+
+func StatusFromTriggerAuthentication(component *v1alpha2.Component, triggerAuthentication *kedav1alpha1.TriggerAuthentication) {
+	component.Status.TriggerAuthenticationGenerations[triggerAuthentication.Name] = triggerAuthentication.Generation
+}
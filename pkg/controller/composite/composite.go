@@ -19,6 +19,7 @@
 package composite
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -28,15 +29,18 @@ import (
 	"cellery.io/cellery-controller/pkg/apis/mesh"
 	"cellery.io/cellery-controller/pkg/clients"
 	"cellery.io/cellery-controller/pkg/informers"
+	"cellery.io/cellery-controller/pkg/logging"
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -59,6 +63,7 @@ type reconciler struct {
 	compositeLister           v1alpha2listers.CompositeLister
 	componentLister           v1alpha2listers.ComponentLister
 	serviceLister             corev1listers.ServiceLister
+	controllerRevisionLister  appsv1listers.ControllerRevisionLister
 	secretLister              corev1listers.SecretLister
 	tokenServiceLister        v1alpha2listers.TokenServiceLister
 	istioVirtualServiceLister istionetwork1alpha3listers.VirtualServiceLister
@@ -74,19 +79,7 @@ func NewController(
 	cfg config.Interface,
 	logger *zap.SugaredLogger,
 ) *controller.Controller {
-	r := &reconciler{
-		kubeClient:                clientset.Kubernetes(),
-		meshClient:                clientset.Mesh(),
-		compositeLister:           informerset.Composites().Lister(),
-		componentLister:           informerset.Components().Lister(),
-		serviceLister:             informerset.Services().Lister(),
-		tokenServiceLister:        informerset.TokenServices().Lister(),
-		secretLister:              informerset.Secrets().Lister(),
-		istioVirtualServiceLister: informerset.IstioVirtualServices().Lister(),
-		cellLister:                informerset.Cells().Lister(),
-		cfg:                       cfg,
-		logger:                    logger.Named("composite-controller"),
-	}
+	r := newReconciler(clientset, informerset, cfg, logger)
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(r.logger.Named("events").Infof)
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: r.kubeClient.CoreV1().Events("")})
@@ -102,58 +95,143 @@ func NewController(
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
+	informerset.ControllerRevisions().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Composite")),
+		Handler:    informers.HandleAll(c.EnqueueControllerOf),
+	})
+
+	// The configuration of a composite depends on the config overrides of its namespace
+	enqueueNamespace := func(namespace string) {
+		composites, err := r.compositeLister.Composites(namespace).List(labels.Everything())
+		if err != nil {
+			r.logger.Errorf("Failed to list the composites of namespace %q: %v", namespace, err)
+			return
+		}
+		for _, composite := range composites {
+			c.Enqueue(composite)
+		}
+	}
+	informerset.ConfigMaps().Informer().AddEventHandler(controller.HandleConfigOverride(enqueueNamespace))
+	informerset.Secrets().Informer().AddEventHandler(controller.HandleConfigOverride(enqueueNamespace))
+
 	return c
 }
 
-func (r *reconciler) Reconcile(key string) error {
-	r.logger.Infof("Reconcile called with %s", key)
+func newReconciler(
+	clientset clients.Interface,
+	informerset informers.Interface,
+	cfg config.Interface,
+	logger *zap.SugaredLogger,
+) *reconciler {
+	return &reconciler{
+		kubeClient:                clientset.Kubernetes(),
+		meshClient:                clientset.Mesh(),
+		compositeLister:           informerset.Composites().Lister(),
+		componentLister:           informerset.Components().Lister(),
+		serviceLister:             informerset.Services().Lister(),
+		tokenServiceLister:        informerset.TokenServices().Lister(),
+		controllerRevisionLister:  informerset.ControllerRevisions().Lister(),
+		secretLister:              informerset.Secrets().Lister(),
+		istioVirtualServiceLister: informerset.IstioVirtualServices().Lister(),
+		cellLister:                informerset.Cells().Lister(),
+		cfg:                       cfg,
+		logger:                    logger.Named("composite-controller"),
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, key string) (_ controller.Result, err error) {
+	logger := logging.FromContext(ctx)
+	logger.Infof("Reconcile called with %s", key)
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
-		r.logger.Errorf("invalid resource key: %s", key)
-		return nil
+		logger.Errorf("invalid resource key: %s", key)
+		return controller.Result{}, nil
 	}
 	original, err := r.compositeLister.Composites(namespace).Get(name)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			r.logger.Errorf("composite '%s' in work queue no longer exists", key)
-			return nil
+			logger.Errorf("composite '%s' in work queue no longer exists", key)
+			return controller.Result{}, nil
 		}
-		return err
+		return controller.Result{}, err
 	}
 
 	composite := original.DeepCopy()
 
-	if err = r.reconcile(composite); err != nil {
-		r.recorder.Eventf(composite, corev1.EventTypeWarning, "InternalError", "Failed to update cluster: %v", err)
-		return err
+	ctx, span := controller.StartReconcileSpan(ctx, "Composite", composite, composite.Generation != composite.Status.ObservedGeneration)
+	defer func() { span.Finish(err) }()
+
+	if controller.IsPaused(composite) {
+		if composite.Status.SetCondition(v1alpha2.CompositeReconcilePaused, corev1.ConditionTrue) {
+			r.recorder.Eventf(composite, corev1.EventTypeNormal, "ReconcilePaused", "Reconciliation of Composite %q is paused", composite.Name)
+		}
+	} else {
+		if composite.Status.RemoveCondition(v1alpha2.CompositeReconcilePaused) {
+			r.recorder.Eventf(composite, corev1.EventTypeNormal, "ReconcileResumed", "Reconciliation of Composite %q is resumed", composite.Name)
+		}
+		if _, ok := composite.Annotations[meta.RollbackToAnnotationKey]; ok {
+			return controller.Result{}, r.rollback(ctx, composite)
+		}
+		if err = r.reconcile(ctx, composite); err != nil {
+			r.recorder.Eventf(composite, corev1.EventTypeWarning, "InternalError", "Failed to update cluster: %v", err)
+			return controller.Result{}, err
+		}
+		composite.Status.RemoveCondition(v1alpha2.CompositeReconcileFailed)
+	}
+
+	// Do not update the status from a cancelled or timed out reconcile
+	if err = ctx.Err(); err != nil {
+		return controller.Result{}, err
 	}
 
 	if equality.Semantic.DeepEqual(original.Status, composite.Status) {
-		return nil
+		return controller.Result{}, nil
 	}
 
 	if _, err = r.updateStatus(composite); err != nil {
 		r.recorder.Eventf(composite, corev1.EventTypeWarning, "UpdateFailed", "Failed to update status: %v", err)
-		return err
+		return controller.Result{}, err
 	}
 	r.recorder.Eventf(composite, corev1.EventTypeNormal, "Updated", "Updated Composite status %q", composite.GetName())
-	return nil
+	return controller.Result{}, nil
 }
 
-func (r *reconciler) reconcile(composite *v1alpha2.Composite) error {
-	composite.Default()
+// RecordFailure marks the Composite as failed once its key has exhausted the retries.
+func (r *reconciler) RecordFailure(ctx context.Context, key string, reconcileErr error) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	original, err := r.compositeLister.Composites(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+
+	composite := original.DeepCopy()
+	if !composite.Status.SetCondition(v1alpha2.CompositeReconcileFailed, corev1.ConditionTrue) {
+		return nil
+	}
+	r.recorder.Eventf(composite, corev1.EventTypeWarning, "ReconcileFailed", "Reconciliation of Composite %q failed after retries: %v", name, reconcileErr)
+	_, err = r.updateStatus(composite)
+	return err
+}
+
+func (r *reconciler) reconcile(ctx context.Context, composite *v1alpha2.Composite) error {
 	rErrs := &controller.ReconcileErrors{}
+	// The revision holds the spec as applied by the user rather than the defaulted spec
+	rErrs.Add(r.reconcileRevision(ctx, composite))
+	composite.Default()
 
-	rErrs.Add(r.reconcileSecret(composite))
-	rErrs.Add(r.reconcileTokenService(composite))
+	rErrs.Add(r.reconcileSecret(ctx, composite))
+	rErrs.Add(r.reconcileTokenService(ctx, composite))
 
 	for i, _ := range composite.Spec.Components {
-		rErrs.Add(r.reconcileComponent(composite, &composite.Spec.Components[i]))
+		rErrs.Add(r.reconcileComponent(ctx, composite, &composite.Spec.Components[i]))
 	}
 
-	rErrs.Add(r.reconcileVirtualService(composite))
+	rErrs.Add(r.reconcileVirtualService(ctx, composite))
 
-	rErrs.Add(r.reconcileRoutingK8sService(composite))
+	rErrs.Add(r.reconcileRoutingK8sService(ctx, composite))
 
 	if !rErrs.Empty() {
 		return rErrs
@@ -168,38 +246,95 @@ func (r *reconciler) reconcile(composite *v1alpha2.Composite) error {
 
 	composite.Status.ActiveComponentCount = activeCount
 	composite.Status.ComponentCount = len(composite.Spec.Components)
-	if composite.Status.TokenServiceStatus == v1alpha2.TokenServiceCurrentStatusReady &&
-		composite.Status.ActiveComponentCount == composite.Status.ComponentCount {
-		composite.Status.Status = v1alpha2.CompositeCurrentStatusReady
-		c := []v1alpha2.CompositeCondition{
-			{
-				Type:   v1alpha2.CompositeReady,
-				Status: corev1.ConditionTrue,
-			},
+	if controller.IsSuspended(composite) {
+		composite.Status.Status = v1alpha2.CompositeCurrentStatusSuspended
+		composite.Status.SetCondition(v1alpha2.CompositeReady, corev1.ConditionFalse)
+		if composite.Status.SetCondition(v1alpha2.CompositeSuspended, corev1.ConditionTrue) {
+			r.recorder.Eventf(composite, corev1.EventTypeNormal, "Suspended", "Composite %q is suspended", composite.Name)
 		}
-		composite.Status.Conditions = c
 	} else {
-		composite.Status.Status = v1alpha2.CompositeCurrentStatusNotReady
-		c := []v1alpha2.CompositeCondition{
-			{
-				Type:   v1alpha2.CompositeReady,
-				Status: corev1.ConditionFalse,
-			},
+		if composite.Status.RemoveCondition(v1alpha2.CompositeSuspended) {
+			r.recorder.Eventf(composite, corev1.EventTypeNormal, "Resumed", "Composite %q is resumed", composite.Name)
+		}
+		if composite.Status.TokenServiceStatus == v1alpha2.TokenServiceCurrentStatusReady &&
+			composite.Status.ActiveComponentCount == composite.Status.ComponentCount {
+			composite.Status.Status = v1alpha2.CompositeCurrentStatusReady
+			composite.Status.SetCondition(v1alpha2.CompositeReady, corev1.ConditionTrue)
+		} else {
+			composite.Status.Status = v1alpha2.CompositeCurrentStatusNotReady
+			composite.Status.SetCondition(v1alpha2.CompositeReady, corev1.ConditionFalse)
 		}
-		composite.Status.Conditions = c
 	}
 	composite.Status.ObservedGeneration = composite.Generation
 
 	return nil
 }
 
-func (r *reconciler) reconcileSecret(composite *v1alpha2.Composite) error {
+func (r *reconciler) reconcileRevision(ctx context.Context, composite *v1alpha2.Composite) (err error) {
+	revisionName := controller.RevisionName(composite, composite.Spec)
+	_, span := controller.StartStepSpan(ctx, "ControllerRevision", revisionName)
+	defer func() { span.Finish(err) }()
+	revisions, err := controller.ListRevisions(r.controllerRevisionLister, composite, meta.CompositeLabelKey)
+	if err != nil {
+		return err
+	}
+	revision, created, err := controller.SnapshotRevision(r.kubeClient, revisions, composite, controller.CreateCompositeOwnerRef(composite),
+		meta.CompositeLabelKey, composite.Spec, controller.RevisionHistoryLimit(r.cfg.ForNamespace(composite.Namespace)))
+	if err != nil {
+		r.recorder.Eventf(composite, corev1.EventTypeWarning, "CreationFailed", "Failed to create ControllerRevision %q: %v", revisionName, err)
+		return err
+	}
+	if created {
+		controller.SetAction(span, controller.ActionCreate)
+		r.recorder.Eventf(composite, corev1.EventTypeNormal, "Created", "Created ControllerRevision %q", revisionName)
+	} else {
+		controller.SetAction(span, controller.ActionSkip)
+	}
+	composite.Status.CurrentRevision = revision.Name
+	return nil
+}
+
+// rollback restores the spec of the Composite from the revision requested with the rollback annotation.
+// The annotation is removed with the same update so that the restored spec is reconciled next.
+func (r *reconciler) rollback(ctx context.Context, composite *v1alpha2.Composite) (err error) {
+	_, span := controller.StartStepSpan(ctx, "Rollback", composite.Name)
+	defer func() { span.Finish(err) }()
+	revisions, err := controller.ListRevisions(r.controllerRevisionLister, composite, meta.CompositeLabelKey)
+	if err != nil {
+		return err
+	}
+	desired := composite.DeepCopy()
+	delete(desired.Annotations, meta.RollbackToAnnotationKey)
+	revision, rollbackErr := controller.RollbackRevision(revisions, composite, composite.Status.CurrentRevision)
+	if rollbackErr == nil {
+		desired.Spec = v1alpha2.CompositeSpec{}
+		rollbackErr = controller.DecodeRevision(revision, &desired.Spec)
+	}
+	if rollbackErr != nil {
+		// Only the annotation is removed so that the current spec is kept
+		desired.Spec = composite.Spec
+		r.recorder.Eventf(composite, corev1.EventTypeWarning, "RollbackFailed", "Failed to roll back Composite %q: %v", composite.Name, rollbackErr)
+	}
+	if _, err = r.meshClient.MeshV1alpha2().Composites(composite.Namespace).Update(desired); err != nil {
+		return err
+	}
+	controller.SetAction(span, controller.ActionUpdate)
+	if rollbackErr == nil {
+		r.recorder.Eventf(composite, corev1.EventTypeNormal, "RolledBack", "Rolled back Composite %q to revision %d", composite.Name, revision.Revision)
+	}
+	return nil
+}
+
+func (r *reconciler) reconcileSecret(ctx context.Context, composite *v1alpha2.Composite) (err error) {
 	secretName := resources.SecretName(composite)
+	_, span := controller.StartStepSpan(ctx, "Secret", secretName)
+	defer func() { span.Finish(err) }()
 	secret, err := r.secretLister.Secrets(mesh.SystemNamespace).Get(resources.SecretName(composite))
 
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		secret, err = func(composite *v1alpha2.Composite) (*corev1.Secret, error) {
-			desiredSecret, err := resources.MakeSecret(composite, r.cfg)
+			desiredSecret, err := resources.MakeSecret(composite, r.cfg.ForNamespace(composite.Namespace))
 			if err != nil {
 				return nil, err
 			}
@@ -214,16 +349,39 @@ func (r *reconciler) reconcileSecret(composite *v1alpha2.Composite) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Secret %q: %v", secretName, err)
 		return err
+	} else if cfg := r.cfg.ForNamespace(composite.Namespace); !controller.ConfigHashEqual(secret, controller.CertificateHash(cfg)) {
+		controller.SetAction(span, controller.ActionUpdate)
+		secret, err = func(composite *v1alpha2.Composite, secret *corev1.Secret) (*corev1.Secret, error) {
+			desiredSecret, err := resources.MakeSecret(composite, cfg)
+			if err != nil {
+				return nil, err
+			}
+			existingSecret := secret.DeepCopy()
+			resources.CopySecret(desiredSecret, existingSecret)
+			return r.kubeClient.CoreV1().Secrets(mesh.SystemNamespace).Update(existingSecret)
+		}(composite, secret)
+		if err != nil {
+			r.logger.Errorf("Failed to update Secret %q: %v", secretName, err)
+			return err
+		}
+		r.recorder.Eventf(composite, corev1.EventTypeNormal, "Updated", "Updated Secret %q with the keys signed by the current certificate", secretName)
+	} else {
+		controller.SetAction(span, controller.ActionSkip)
 	}
 	resources.StatusFromSecret(composite, secret)
 	return nil
 }
 
-func (r *reconciler) reconcileTokenService(composite *v1alpha2.Composite) error {
+func (r *reconciler) reconcileTokenService(ctx context.Context, composite *v1alpha2.Composite) (err error) {
 	tokenServiceName := resources.TokenServiceName(composite)
+	ctx, span := controller.StartStepSpan(ctx, "TokenService", tokenServiceName)
+	defer func() { span.Finish(err) }()
 	tokenService, err := r.tokenServiceLister.TokenServices(mesh.SystemNamespace).Get(tokenServiceName)
 	if errors.IsNotFound(err) {
-		tokenService, err = r.meshClient.MeshV1alpha2().TokenServices(mesh.SystemNamespace).Create(resources.MakeTokenService(composite))
+		controller.SetAction(span, controller.ActionCreate)
+		desiredTokenService := resources.MakeTokenService(composite)
+		controller.InjectTraceParent(ctx, desiredTokenService)
+		tokenService, err = r.meshClient.MeshV1alpha2().TokenServices(mesh.SystemNamespace).Create(desiredTokenService)
 		if err != nil {
 			r.logger.Errorf("Failed to create TokenService %q: %v", tokenServiceName, err)
 			r.recorder.Eventf(composite, corev1.EventTypeWarning, "CreationFailed", "Failed to create TokenService %q: %v", tokenServiceName, err)
@@ -233,16 +391,23 @@ func (r *reconciler) reconcileTokenService(composite *v1alpha2.Composite) error
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve TokenService %q: %v", tokenServiceName, err)
 		return err
+	} else {
+		controller.SetAction(span, controller.ActionSkip)
 	}
 	resources.StatusFromTokenService(composite, tokenService)
 	return nil
 }
 
-func (r *reconciler) reconcileComponent(composite *v1alpha2.Composite, componentTemplate *v1alpha2.Component) error {
+func (r *reconciler) reconcileComponent(ctx context.Context, composite *v1alpha2.Composite, componentTemplate *v1alpha2.Component) (err error) {
 	componentName := resources.ComponentName(composite, componentTemplate)
+	ctx, span := controller.StartStepSpan(ctx, "Component", componentName)
+	defer func() { span.Finish(err) }()
 	component, err := r.componentLister.Components(composite.Namespace).Get(componentName)
 	if errors.IsNotFound(err) {
-		component, err = r.meshClient.MeshV1alpha2().Components(composite.Namespace).Create(resources.MakeComponent(composite, componentTemplate))
+		controller.SetAction(span, controller.ActionCreate)
+		desiredComponent := resources.MakeComponent(composite, componentTemplate)
+		controller.InjectTraceParent(ctx, desiredComponent)
+		component, err = r.meshClient.MeshV1alpha2().Components(composite.Namespace).Create(desiredComponent)
 		if err != nil {
 			r.logger.Errorf("Failed to create Component %q: %v", componentName, err)
 			r.recorder.Eventf(composite, corev1.EventTypeWarning, "CreationFailed", "Failed to create Component %q: %v", componentName, err)
@@ -252,31 +417,44 @@ func (r *reconciler) reconcileComponent(composite *v1alpha2.Composite, component
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Component %q: %v", componentName, err)
 		return err
-	} else if !metav1.IsControlledBy(component, composite) {
-		return fmt.Errorf("composite: %q does not own the Component: %q", composite.Name, componentName)
+	} else if !metav1.IsControlledBy(component, composite) && !controller.CanAdopt(controller.GetAdoptionPolicy(composite), component) {
+		return controller.NewPermanentError(fmt.Errorf("composite: %q does not own the Component: %q", composite.Name, componentName))
 	} else {
+		adopt := !metav1.IsControlledBy(component, composite)
 		component, err = func(composite *v1alpha2.Composite, component *v1alpha2.Component) (*v1alpha2.Component, error) {
-			if !resources.RequireComponentUpdate(composite, component) {
+			if !adopt && !resources.RequireComponentUpdate(composite, component) {
+				controller.SetAction(span, controller.ActionSkip)
 				return component, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredComponent := resources.MakeComponent(composite, componentTemplate)
+			controller.InjectTraceParent(ctx, desiredComponent)
 			existingComponent := component.DeepCopy()
 			resources.CopyComponent(desiredComponent, existingComponent)
+			if adopt {
+				controller.Adopt(existingComponent, desiredComponent)
+			}
 			return r.meshClient.MeshV1alpha2().Components(composite.Namespace).Update(existingComponent)
 		}(composite, component)
 		if err != nil {
 			r.logger.Errorf("Failed to update Component %q: %v", componentName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(composite, "Component", componentName)
+		}
 	}
 	resources.StatusFromComponent(composite, component)
 	return nil
 }
 
-func (r *reconciler) reconcileVirtualService(composite *v1alpha2.Composite) error {
+func (r *reconciler) reconcileVirtualService(ctx context.Context, composite *v1alpha2.Composite) (err error) {
 	name := routing.RoutingVirtualServiceName(composite.Name)
+	_, span := controller.StartStepSpan(ctx, "VirtualService", name)
+	defer func() { span.Finish(err) }()
 	routingVs, err := r.istioVirtualServiceLister.VirtualServices(composite.Namespace).Get(name)
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		routingVs, err = resources.MakeRoutingVirtualService(composite, r.compositeLister, r.cellLister)
 		if err != nil {
 			r.logger.Errorf("Failed to create Composite VS object %v for instance %s", err, composite.Name)
@@ -304,9 +482,20 @@ func (r *reconciler) reconcileVirtualService(composite *v1alpha2.Composite) erro
 		r.recorder.Eventf(composite, corev1.EventTypeNormal, "Created", "Created Virtual Service %q", name)
 	} else if err != nil {
 		return err
+	} else if !metav1.IsControlledBy(routingVs, composite) && !controller.CanAdopt(controller.GetAdoptionPolicy(composite), routingVs) {
+		return controller.NewPermanentError(fmt.Errorf("Composite: %q does not own the VS: %q", composite.Name, routingVs.Name))
 	} else if !metav1.IsControlledBy(routingVs, composite) {
-		return fmt.Errorf("Composite: %q does not own the VS: %q", composite.Name, routingVs)
+		controller.SetAction(span, controller.ActionUpdate)
+		existingRoutingVs := routingVs.DeepCopy()
+		controller.AdoptWithOwnerRef(existingRoutingVs, controller.CreateCompositeOwnerRef(composite))
+		routingVs, err = r.meshClient.NetworkingV1alpha3().VirtualServices(composite.Namespace).Update(existingRoutingVs)
+		if err != nil {
+			r.logger.Errorf("Failed to adopt VirtualService %q: %v", existingRoutingVs.Name, err)
+			return err
+		}
+		r.recordAdoption(composite, "VirtualService", routingVs.Name)
 	} else {
+		controller.SetAction(span, controller.ActionSkip)
 		// TODO: find a better solution
 		//routingVs, err = func(composite *v1alpha2.Composite, routingVs *v1alpha3.VirtualService) (*v1alpha3.VirtualService, error) {
 		//	if !resources.RequireRoutingVsUpdate(composite, routingVs) {
@@ -336,6 +525,12 @@ func isEqual(oldService *v1alpha2.Component, newService *v1alpha2.Component) boo
 	return reflect.DeepEqual(oldService.Spec, newService.Spec)
 }
 
+// recordAdoption records the adoption of an existing resource in the events and the status of the composite.
+func (r *reconciler) recordAdoption(composite *v1alpha2.Composite, kind string, name string) {
+	r.recorder.Eventf(composite, corev1.EventTypeNormal, "Adopted", "Adopted %s %q", kind, name)
+	composite.Status.AdoptedResources = controller.AddAdoptedResource(composite.Status.AdoptedResources, kind, name)
+}
+
 func (r *reconciler) updateStatus(desired *v1alpha2.Composite) (*v1alpha2.Composite, error) {
 	composite, err := r.compositeLister.Composites(desired.Namespace).Get(desired.Name)
 	if err != nil {
@@ -354,7 +549,10 @@ type origComponentData struct {
 	ContainerPorts []int  `json:"containerPorts"`
 }
 
-func (r *reconciler) reconcileRoutingK8sService(composite *v1alpha2.Composite) error {
+func (r *reconciler) reconcileRoutingK8sService(ctx context.Context, composite *v1alpha2.Composite) (err error) {
+	_, span := controller.StartStepSpan(ctx, "Service", composite.Name)
+	defer func() { span.Finish(err) }()
+
 	// This is a workaround for an issue with switching traffic 100% to a new instance, and terminating the old one.
 	// When the old composite instance is terminated, the associated k8s service will be deleted as well. Since the
 	// Istio Virtual Service uses that particular gateway k8s service name as a hostname, once its deleted the DNS
@@ -373,6 +571,7 @@ func (r *reconciler) reconcileRoutingK8sService(composite *v1alpha2.Composite) e
 		for _, data := range origCompData {
 			k8sService, err := r.serviceLister.Services(composite.Namespace).Get(resources.K8sServiceName(data.ComponentName))
 			if errors.IsNotFound(err) {
+				controller.SetAction(span, controller.ActionCreate)
 				k8sService, err = r.kubeClient.CoreV1().Services(composite.Namespace).Create(
 					resources.MakeOriginalComponentK8sService(composite, data.ComponentName, data.ContainerPorts))
 				if err != nil {
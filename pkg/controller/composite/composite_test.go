@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package composite
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	fakeclients "cellery.io/cellery-controller/pkg/clients/fake"
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
+	fakeinformers "cellery.io/cellery-controller/pkg/informers/fake"
+	"cellery.io/cellery-controller/pkg/meta"
+	. "cellery.io/cellery-controller/pkg/testing/apis/apps/v1"
+	. "cellery.io/cellery-controller/pkg/testing/apis/core/v1"
+	. "cellery.io/cellery-controller/pkg/testing/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/testing/table"
+)
+
+func newTestReconciler(clients *fakeclients.Clients, informers *fakeinformers.Informers, cfg config.Interface,
+	recorder record.EventRecorder) controller.Reconciler {
+	r := newReconciler(clients, informers, cfg, zap.NewNop().Sugar())
+	r.recorder = recorder
+	return r
+}
+
+func testComposite(opt ...CompositeOption) *v1alpha2.Composite {
+	return Composite("foo", "bar", append([]CompositeOption{
+		WithCompositeComponent(Component("hello", "",
+			WithComponentPodSpec(PodSpec(WithPodSpecContainer(Container(WithContainerImage("busybox:v1.2.3"))))),
+			WithComponentPortMaping("http", "HTTP", 80, "", 8080),
+		)),
+	}, opt...)...)
+}
+
+func testRevision(composite *v1alpha2.Composite, number int64) *appsv1.ControllerRevision {
+	return ControllerRevision(controller.RevisionName(composite, composite.Spec), composite.Namespace,
+		WithControllerRevisionLabels(map[string]string{meta.CompositeLabelKey: composite.Name}),
+		WithControllerRevisionOwnerReference(*controller.CreateCompositeOwnerRef(composite)),
+		WithControllerRevisionNumber(number),
+		WithControllerRevisionSpec(composite.Spec),
+	)
+}
+
+func TestReconcile(t *testing.T) {
+	currentRevision := controller.RevisionName(testComposite(), testComposite().Spec)
+	previous := testComposite(WithCompositeComponent(Component("legacy", "",
+		WithComponentPodSpec(PodSpec(WithPodSpecContainer(Container(WithContainerImage("busybox:v1.0.0"))))),
+	)))
+
+	table.Table{
+		{
+			Name: "invalid key",
+			Key:  "foo/bar/baz",
+		},
+		{
+			Name: "non existing key",
+			Key:  "bar/foo",
+		},
+		{
+			Name:    "create composite resources",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testComposite()},
+			WantStatusUpdates: []runtime.Object{
+				testComposite(WithCompositeStatus(v1alpha2.CompositeStatus{
+					ComponentCount:       1,
+					Status:               v1alpha2.CompositeCurrentStatusNotReady,
+					ComponentStatuses:    map[string]v1alpha2.ComponentCurrentStatus{"foo--hello": ""},
+					ComponentGenerations: map[string]int64{"foo--hello": 0},
+					CurrentRevision:      currentRevision,
+					Conditions: []v1alpha2.CompositeCondition{
+						{Type: v1alpha2.CompositeReady, Status: corev1.ConditionFalse},
+					},
+				})),
+			},
+			WantEvents: []string{
+				fmt.Sprintf(`Normal Created Created ControllerRevision %q`, currentRevision),
+				`Normal Created Created Secret "composite-sts-secret"`,
+				`Normal Created Created TokenService "composite--sts"`,
+				`Normal Created Created Component "foo--hello"`,
+				`Normal Updated Updated Composite status "foo"`,
+			},
+			Golden: "create-composite-resources",
+		},
+		{
+			Name: "rollback to a previous revision",
+			Key:  "bar/foo",
+			Objects: []runtime.Object{
+				testComposite(WithCompositeAnnotation(meta.RollbackToAnnotationKey, "1")),
+				testRevision(previous, 1),
+				testRevision(testComposite(), 2),
+			},
+			WantUpdates: []runtime.Object{
+				previous,
+			},
+			WantEvents: []string{
+				`Normal RolledBack Rolled back Composite "foo" to revision 1`,
+			},
+		},
+		{
+			Name:    "pause reconciliation",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testComposite(WithCompositeAnnotation(meta.ReconcileAnnotationKey, meta.ReconcilePausedValue))},
+			WantStatusUpdates: []runtime.Object{
+				testComposite(
+					WithCompositeAnnotation(meta.ReconcileAnnotationKey, meta.ReconcilePausedValue),
+					WithCompositeStatus(v1alpha2.CompositeStatus{
+						Conditions: []v1alpha2.CompositeCondition{
+							{Type: v1alpha2.CompositeReconcilePaused, Status: corev1.ConditionTrue},
+						},
+					}),
+				),
+			},
+			WantEvents: []string{
+				`Normal ReconcilePaused Reconciliation of Composite "foo" is paused`,
+				`Normal Updated Updated Composite status "foo"`,
+			},
+		},
+		{
+			Name:    "component creation failure",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testComposite()},
+			WithReactors: []table.Reactor{{
+				Verb:     "create",
+				Resource: "components",
+				Reaction: func(action clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("inducing failure for create components")
+				},
+			}},
+			WantErr: true,
+			WantEvents: []string{
+				fmt.Sprintf(`Normal Created Created ControllerRevision %q`, currentRevision),
+				`Normal Created Created Secret "composite-sts-secret"`,
+				`Normal Created Created TokenService "composite--sts"`,
+				`Warning CreationFailed Failed to create Component "foo--hello": inducing failure for create components`,
+				"Warning InternalError Failed to update cluster: inducing failure for create components",
+			},
+			// The failed create is recorded as well
+			Golden: "create-composite-resources",
+		},
+	}.Test(t, newTestReconciler)
+}
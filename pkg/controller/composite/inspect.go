@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package composite
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/controller/composite/resources"
+	routing "cellery.io/cellery-controller/pkg/controller/routing"
+)
+
+// Inspect returns the desired child resources of the composite along with the ones held by the listers.
+func (r *reconciler) Inspect(key string) ([]controller.ResourceState, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, err
+	}
+	original, err := r.compositeLister.Composites(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	composite := original.DeepCopy()
+	composite.Default()
+
+	var states []controller.ResourceState
+
+	secretName := resources.SecretName(composite)
+	desiredSecret, desiredErr := resources.MakeSecret(composite, r.cfg.ForNamespace(composite.Namespace))
+	secret, err := r.secretLister.Secrets(mesh.SystemNamespace).Get(secretName)
+	states = append(states, controller.NewResourceState("Secret", secretName,
+		controller.RedactSecret(desiredSecret), desiredErr, controller.RedactSecret(secret), err))
+
+	tokenServiceName := resources.TokenServiceName(composite)
+	tokenService, err := r.tokenServiceLister.TokenServices(mesh.SystemNamespace).Get(tokenServiceName)
+	states = append(states, controller.NewResourceState("TokenService", tokenServiceName,
+		resources.MakeTokenService(composite), nil, tokenService, err))
+
+	for i := range composite.Spec.Components {
+		componentTemplate := &composite.Spec.Components[i]
+		componentName := resources.ComponentName(composite, componentTemplate)
+		component, err := r.componentLister.Components(composite.Namespace).Get(componentName)
+		states = append(states, controller.NewResourceState("Component", componentName,
+			resources.MakeComponent(composite, componentTemplate), nil, component, err))
+	}
+
+	virtualServiceName := routing.RoutingVirtualServiceName(composite.Name)
+	desiredVirtualService, desiredErr := resources.MakeRoutingVirtualService(composite, r.compositeLister, r.cellLister)
+	virtualService, err := r.istioVirtualServiceLister.VirtualServices(composite.Namespace).Get(virtualServiceName)
+	states = append(states, controller.NewResourceState("VirtualService", virtualServiceName,
+		desiredVirtualService, desiredErr, virtualService, err))
+
+	return states, nil
+}
@@ -59,12 +59,16 @@ func makeLabels(composite *v1alpha2.Composite) map[string]string {
 }
 
 func makeAnnotations(composite *v1alpha2.Composite) map[string]string {
-	return UnionMaps(
+	annotations := UnionMaps(
 		map[string]string{
 			IstioSidecarInjectAnnotationKey: "false",
 		},
 		composite.Annotations,
 	)
+	// Components follow the suspension of the composite, the annotation is not copied since the components
+	// are not updated when it is removed
+	delete(annotations, SuspendedAnnotationKey)
+	return annotations
 }
 
 func createServiceAnnotations(composite *v1alpha2.Composite) map[string]string {
@@ -34,6 +34,8 @@ import (
 	"cellery.io/cellery-controller/pkg/apis/mesh"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
 func MakeSecret(composite *v1alpha2.Composite, cfg config.Interface) (*corev1.Secret, error) {
@@ -83,6 +85,9 @@ func MakeSecret(composite *v1alpha2.Composite, cfg config.Interface) (*corev1.Se
 			Name:      SecretName(composite),
 			Namespace: mesh.SystemNamespace,
 			Labels:    makeLabels(composite),
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: controller.CertificateHash(cfg),
+			},
 		},
 		Type: mesh.GroupName + "/key-and-cert",
 		Data: map[string][]byte{
@@ -94,6 +99,12 @@ func MakeSecret(composite *v1alpha2.Composite, cfg config.Interface) (*corev1.Se
 	}, nil
 }
 
+func CopySecret(source, destination *corev1.Secret) {
+	destination.Data = source.Data
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
 func StatusFromSecret(composite *v1alpha2.Composite, secret *corev1.Secret) {
 	composite.Status.SecretGeneration = secret.Generation
 }
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+// HandleConfigOverride returns an event handler which calls the given handler with the namespace of a
+// ConfigMap or Secret which overrides the global configuration. An update is handled if either the
+// previous or the current object is an override so that removing the override label is handled too.
+func HandleConfigOverride(h func(namespace string)) cache.ResourceEventHandler {
+	isOverride := func(obj interface{}) (string, bool) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		object, ok := obj.(metav1.Object)
+		if !ok {
+			return "", false
+		}
+		return object.GetNamespace(), object.GetLabels()[meta.ConfigOverrideLabelKey] == "true"
+	}
+	handle := func(obj interface{}) {
+		if namespace, ok := isOverride(obj); ok {
+			h(namespace)
+		}
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: handle,
+		UpdateFunc: func(first, second interface{}) {
+			_, wasOverride := isOverride(first)
+			if namespace, ok := isOverride(second); ok || wasOverride {
+				h(namespace)
+			}
+		},
+		DeleteFunc: handle,
+	}
+}
+
+// CertificateHash returns a hash of the signing certificate and the certificate bundle of the
+// configuration. Secrets holding keys signed with the configuration record it instead of the hash of
+// the whole configuration so that they are only regenerated when the signing keys change.
+func CertificateHash(cfg config.Interface) string {
+	var certRaw []byte
+	if certificate, err := cfg.Certificate(); err == nil {
+		certRaw = certificate.Raw
+	}
+	return meta.Hash(certRaw, cfg.CertificateBundle())
+}
+
+// AddConfigHash records the hash of the configuration the object is built from.
+func AddConfigHash(obj metav1.Object, hash string) {
+	obj.SetAnnotations(meta.UnionMaps(obj.GetAnnotations(), map[string]string{
+		meta.ConfigHashAnnotationKey: hash,
+	}))
+}
+
+// ConfigHashEqual reports whether the object is built from the configuration with the given hash.
+func ConfigHashEqual(obj metav1.Object, hash string) bool {
+	return obj.GetAnnotations()[meta.ConfigHashAnnotationKey] == hash
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+func overrideConfigMap(namespace string, override bool) *corev1.ConfigMap {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cellery-config", Namespace: namespace},
+	}
+	if override {
+		configMap.Labels = map[string]string{meta.ConfigOverrideLabelKey: "true"}
+	}
+	return configMap
+}
+
+func TestHandleConfigOverride(t *testing.T) {
+	var namespaces []string
+	h := HandleConfigOverride(func(namespace string) { namespaces = append(namespaces, namespace) })
+
+	h.OnAdd(overrideConfigMap("foo", true))
+	h.OnAdd(overrideConfigMap("bar", false))
+	h.OnUpdate(overrideConfigMap("foo", true), overrideConfigMap("foo", true))
+	h.OnUpdate(overrideConfigMap("baz", true), overrideConfigMap("baz", false))
+	h.OnUpdate(overrideConfigMap("bar", false), overrideConfigMap("bar", false))
+	h.OnUpdate(&corev1.Secret{}, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name: "cellery-secret", Namespace: "qux", Labels: map[string]string{meta.ConfigOverrideLabelKey: "true"}}})
+	h.OnDelete(cache.DeletedFinalStateUnknown{Key: "foo/cellery-config", Obj: overrideConfigMap("foo", true)})
+
+	want := []string{"foo", "foo", "baz", "qux", "foo"}
+	if diff := cmp.Diff(want, namespaces); diff != "" {
+		t.Errorf("handled namespaces (-want, +got) = %v", diff)
+	}
+}
+
+func TestConfigHash(t *testing.T) {
+	cfg := fakeconfig.New(nil)
+	hash := CertificateHash(cfg)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "foo--secret", Namespace: "foo"}}
+	if ConfigHashEqual(secret, hash) {
+		t.Errorf("ConfigHashEqual() of a secret without a config hash = true, want false")
+	}
+	AddConfigHash(secret, hash)
+	if !ConfigHashEqual(secret, hash) {
+		t.Errorf("ConfigHashEqual() of a secret with the same config hash = false, want true")
+	}
+	cfg.CertBundle = []byte("bundle")
+	if ConfigHashEqual(secret, CertificateHash(cfg)) {
+		t.Errorf("ConfigHashEqual() after the certificate bundle changed = true, want false")
+	}
+}
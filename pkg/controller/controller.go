@@ -19,10 +19,13 @@
 package controller
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -33,41 +36,107 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	meshscheme "cellery.io/cellery-controller/pkg/generated/clientset/versioned/scheme"
+	"cellery.io/cellery-controller/pkg/logging"
+	"cellery.io/cellery-controller/pkg/metrics"
+	"cellery.io/cellery-controller/pkg/tracing"
 )
 
+const (
+	// DefaultReconcileTimeout is the maximum time a single reconcile of a key is allowed to take.
+	DefaultReconcileTimeout = 2 * time.Minute
+	// DefaultMaxRetries is the number of times a failing key is retried before the object is marked as failed.
+	DefaultMaxRetries = 15
+)
+
+// Result is returned by a reconciler along with the error of the reconcile.
+type Result struct {
+	// RequeueAfter requeues the key after the given duration if the reconcile succeeded.
+	RequeueAfter time.Duration
+}
+
+// Reconciler reconciles the object identified by the key. The context carries the deadline of
+// the reconcile and a logger with the key attached, and is cancelled when the controller stops.
 type Reconciler interface {
-	Reconcile(key string) error
+	Reconcile(ctx context.Context, key string) (Result, error)
+}
+
+// FailureRecorder can be implemented by a Reconciler to record the failure of an object
+// once the key has exhausted its retries.
+type FailureRecorder interface {
+	RecordFailure(ctx context.Context, key string, err error) error
 }
 
 type Controller struct {
-	reconciler Reconciler
-	name       string
-	workqueue  workqueue.RateLimitingInterface
-	logger     *zap.SugaredLogger
+	reconciler       Reconciler
+	name             string
+	workqueue        workqueue.RateLimitingInterface
+	logger           *zap.SugaredLogger
+	reconcileTimeout time.Duration
+	maxRetries       int
+	// Transient errors are retried using the rate limiter of the work queue while permanent
+	// errors are retried with a slower backoff
+	permanentRateLimiter workqueue.RateLimiter
+
+	// Reconcile state of the keys exposed for debugging
+	stateMu sync.Mutex
+	states  map[string]*KeyState
 }
 
 func New(r Reconciler, logger *zap.SugaredLogger, workQueueName string) *Controller {
 	return &Controller{
-		reconciler: r,
-		name:       workQueueName,
-		workqueue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), workQueueName),
-		logger:     logger,
+		reconciler:           r,
+		name:                 workQueueName,
+		workqueue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), workQueueName),
+		logger:               logger,
+		reconcileTimeout:     DefaultReconcileTimeout,
+		maxRetries:           DefaultMaxRetries,
+		permanentRateLimiter: workqueue.NewItemExponentialFailureRateLimiter(30*time.Second, 10*time.Minute),
+		states:               make(map[string]*KeyState),
+	}
+}
+
+// SetReconcileTimeout sets the deadline of each reconcile. The default timeout is used if the given value is not positive.
+func (c *Controller) SetReconcileTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = DefaultReconcileTimeout
+	}
+	c.reconcileTimeout = timeout
+}
+
+// SetMaxRetries sets the number of retries of a failing key. The default is used if the given value is not positive.
+func (c *Controller) SetMaxRetries(maxRetries int) {
+	if maxRetries <= 0 {
+		maxRetries = DefaultMaxRetries
 	}
+	c.maxRetries = maxRetries
 }
 
+// Run starts the workers and blocks until the stop channel is closed. On stop, the in-flight
+// reconciles are cancelled and Run returns once all the workers have drained.
 func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 	defer runtime.HandleCrash()
-	defer c.workqueue.ShutDown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	c.logger.Infof("Starting %s controller", c.name)
 
+	var wg sync.WaitGroup
 	for i := 0; i < threadiness; i++ {
-		go wait.Until(c.runWorker, time.Second, stopCh)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.Until(func() { c.runWorker(ctx) }, time.Second, ctx.Done())
+		}()
 	}
 
 	// wait until we're told to stop
 	<-stopCh
 	c.logger.Infof("Shutting down the %s controller", c.name)
+	cancel()
+	c.workqueue.ShutDown()
+	wg.Wait()
+	c.logger.Infof("Workers of the %s controller drained", c.name)
 }
 
 func (c *Controller) Enqueue(obj interface{}) {
@@ -102,21 +171,27 @@ func (c *Controller) EnqueueControllerOf(obj interface{}) {
 }
 
 func (c *Controller) EnqueueKey(key string) {
-	c.workqueue.AddRateLimited(key)
+	c.workqueue.Add(key)
+	c.markQueued(key)
 	c.logger.Debugf("Adding key %q to queue (depth: %d)", key, c.workqueue.Len())
 }
 
-func (c *Controller) runWorker() {
-	for c.processNextWorkItem() {
+func (c *Controller) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
 	}
 }
 
-func (c *Controller) processNextWorkItem() bool {
+func (c *Controller) processNextWorkItem(ctx context.Context) bool {
 	obj, shutdown := c.workqueue.Get()
 
 	if shutdown {
 		return false
 	}
+	if ctx.Err() != nil {
+		// The controller is stopping, leave the remaining keys in the queue
+		c.workqueue.Done(obj)
+		return false
+	}
 	c.logger.Debugf("Processing %q from queue (depth: %d)", obj, c.workqueue.Len())
 
 	// We wrap this block in a func so we can defer c.workqueue.Done.
@@ -144,15 +219,39 @@ func (c *Controller) processNextWorkItem() bool {
 			return nil
 		}
 		t := time.Now()
+		c.markProcessing(key)
+		reconcileCtx, cancel := context.WithTimeout(ctx, c.reconcileTimeout)
+		defer cancel()
+		reconcileCtx, span := tracing.Start(reconcileCtx, c.name+" reconcile",
+			attribute.String("controller", c.name),
+			attribute.String("key", key),
+		)
+		logger := c.logger.With("key", key)
+		if sc := span.SpanContext(); sc.IsValid() {
+			logger = logger.With("traceID", sc.TraceID().String())
+		}
+		reconcileCtx = logging.WithLogger(reconcileCtx, logger)
 		// Run the reconciler, passing it the namespace/name string of the resource.
-		if err := c.reconciler.Reconcile(key); err != nil {
+		result, err := c.reconciler.Reconcile(reconcileCtx, key)
+		span.Finish(err)
+		if err != nil {
 			c.logger.Infow("Reconcile failed", "key", key, "time", time.Since(t))
-			return fmt.Errorf("error reconciling '%s': %s", key, err.Error())
+			handleErr := c.handleErr(ctx, key, err)
+			c.markReconciled(key, t, err)
+			c.forgetState(key)
+			return handleErr
 		}
 		// Finally, if no error occurs we Forget this item so it does not
 		// get queued again until another change happens.
-		c.workqueue.Forget(obj)
-		c.logger.Infow("Reconcile succeeded", "key", key, "time", time.Since(t))
+		c.forget(key)
+		c.markReconciled(key, t, nil)
+		if result.RequeueAfter > 0 {
+			c.workqueue.AddAfter(key, result.RequeueAfter)
+			c.markQueued(key)
+		} else {
+			c.forgetState(key)
+		}
+		c.logger.Infow("Reconcile succeeded", "key", key, "time", time.Since(t), "requeueAfter", result.RequeueAfter)
 		return nil
 	}(obj)
 
@@ -164,6 +263,40 @@ func (c *Controller) processNextWorkItem() bool {
 	return true
 }
 
+// handleErr requeues the failed key with the backoff of the error class until the retries are
+// exhausted, after which the object is recorded as failed and the key is dropped.
+func (c *Controller) handleErr(ctx context.Context, key string, err error) error {
+	if ctx.Err() != nil {
+		// The controller is stopping, the key will be reconciled again on the next start
+		return fmt.Errorf("error reconciling '%s': %s", key, err.Error())
+	}
+	retries := c.workqueue.NumRequeues(key) + c.permanentRateLimiter.NumRequeues(key)
+	if retries >= c.maxRetries {
+		c.forget(key)
+		metrics.ReconcileFailures.WithLabelValues(c.name).Inc()
+		if recorder, ok := c.reconciler.(FailureRecorder); ok {
+			if rErr := recorder.RecordFailure(logging.WithLogger(ctx, c.logger.With("key", key)), key, err); rErr != nil {
+				c.logger.Errorf("Failed to record the failure of %q: %v", key, rErr)
+			}
+		}
+		return fmt.Errorf("giving up reconciling '%s' after %d retries: %s", key, retries, err.Error())
+	}
+	if IsPermanentError(err) {
+		metrics.ReconcileRetries.WithLabelValues(c.name, "permanent").Inc()
+		c.workqueue.AddAfter(key, c.permanentRateLimiter.When(key))
+	} else {
+		metrics.ReconcileRetries.WithLabelValues(c.name, "transient").Inc()
+		c.workqueue.AddRateLimited(key)
+	}
+	c.markQueued(key)
+	return fmt.Errorf("error reconciling '%s': %s", key, err.Error())
+}
+
+func (c *Controller) forget(key string) {
+	c.workqueue.Forget(key)
+	c.permanentRateLimiter.Forget(key)
+}
+
 type ReconcileErrors struct {
 	errors []error
 }
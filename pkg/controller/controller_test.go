@@ -0,0 +1,281 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+)
+
+type blockingReconciler struct {
+	started chan struct{}
+	err     chan error
+}
+
+func (r *blockingReconciler) Reconcile(ctx context.Context, key string) (Result, error) {
+	close(r.started)
+	<-ctx.Done()
+	r.err <- ctx.Err()
+	return Result{}, ctx.Err()
+}
+
+func TestRunCancelsInFlightReconciles(t *testing.T) {
+	r := &blockingReconciler{started: make(chan struct{}), err: make(chan error, 1)}
+	c := New(r, zap.NewNop().Sugar(), "Test")
+
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		c.Run(1, stopCh)
+		close(done)
+	}()
+
+	c.EnqueueKey("foo/bar")
+	select {
+	case <-r.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("reconcile was not started")
+	}
+
+	close(stopCh)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after the workers were drained")
+	}
+	if err := <-r.err; err != context.Canceled {
+		t.Errorf("reconcile context error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestReconcileTimeout(t *testing.T) {
+	r := &blockingReconciler{started: make(chan struct{}), err: make(chan error, 1)}
+	c := New(r, zap.NewNop().Sugar(), "Test")
+	c.SetReconcileTimeout(10 * time.Millisecond)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go c.Run(1, stopCh)
+
+	c.EnqueueKey("foo/bar")
+	select {
+	case err := <-r.err:
+		if err != context.DeadlineExceeded {
+			t.Errorf("reconcile context error = %v, want %v", err, context.DeadlineExceeded)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("reconcile did not time out")
+	}
+}
+
+type failingReconciler struct {
+	sync.Mutex
+	calls    int
+	err      error
+	result   Result
+	failures chan string
+}
+
+func (r *failingReconciler) Reconcile(ctx context.Context, key string) (Result, error) {
+	r.Lock()
+	defer r.Unlock()
+	r.calls++
+	return r.result, r.err
+}
+
+func (r *failingReconciler) RecordFailure(ctx context.Context, key string, err error) error {
+	r.failures <- key
+	return nil
+}
+
+func (r *failingReconciler) Calls() int {
+	r.Lock()
+	defer r.Unlock()
+	return r.calls
+}
+
+func newTestController(r Reconciler) *Controller {
+	c := New(r, zap.NewNop().Sugar(), "Test")
+	c.workqueue = workqueue.NewRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Millisecond, time.Millisecond))
+	c.permanentRateLimiter = workqueue.NewItemExponentialFailureRateLimiter(time.Millisecond, time.Millisecond)
+	return c
+}
+
+func TestMaxRetries(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "transient error",
+			err:  fmt.Errorf("connection refused"),
+		},
+		{
+			name: "permanent error",
+			err:  NewPermanentError(fmt.Errorf("not owned")),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &failingReconciler{err: test.err, failures: make(chan string, 1)}
+			c := newTestController(r)
+			c.SetMaxRetries(3)
+
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			go c.Run(1, stopCh)
+
+			c.EnqueueKey("foo/bar")
+			select {
+			case key := <-r.failures:
+				if key != "foo/bar" {
+					t.Errorf("recorded failure of %q, want %q", key, "foo/bar")
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("failure was not recorded")
+			}
+			// The initial reconcile and the retries
+			if got, want := r.Calls(), 4; got != want {
+				t.Errorf("reconcile called %d times, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestRequeueAfter(t *testing.T) {
+	r := &failingReconciler{result: Result{RequeueAfter: 10 * time.Millisecond}}
+	c := newTestController(r)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go c.Run(1, stopCh)
+
+	c.EnqueueKey("foo/bar")
+	deadline := time.Now().Add(5 * time.Second)
+	for r.Calls() < 3 {
+		if time.Now().After(deadline) {
+			t.Fatalf("reconcile called %d times, want at least 3", r.Calls())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestQueueStateIsForgotten(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		result   Result
+		wantKeys int
+	}{
+		{
+			name: "reconciled",
+		},
+		{
+			name: "retries exhausted",
+			err:  NewPermanentError(fmt.Errorf("not owned")),
+		},
+		{
+			name:     "requeued",
+			result:   Result{RequeueAfter: time.Hour},
+			wantKeys: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &failingReconciler{err: test.err, result: test.result, failures: make(chan string, 1)}
+			c := newTestController(r)
+			c.SetMaxRetries(1)
+
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			go c.Run(1, stopCh)
+
+			c.EnqueueKey("foo/bar")
+			deadline := time.Now().Add(5 * time.Second)
+			for {
+				s := c.QueueState()
+				if len(s.Keys) == test.wantKeys && (len(s.Keys) == 0 || !s.Keys[0].Processing) && r.Calls() > 0 {
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatalf("queue state has %d keys, want %d", len(s.Keys), test.wantKeys)
+				}
+				time.Sleep(5 * time.Millisecond)
+			}
+		})
+	}
+}
+
+func TestIsPermanentError(t *testing.T) {
+	gr := schema.GroupResource{Group: "mesh.cellery.io", Resource: "cells"}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "plain error",
+			err:  fmt.Errorf("foo"),
+		},
+		{
+			name: "permanent error",
+			err:  NewPermanentError(fmt.Errorf("foo")),
+			want: true,
+		},
+		{
+			name: "invalid",
+			err:  errors.NewInvalid(schema.GroupKind{Group: "mesh.cellery.io", Kind: "Cell"}, "foo", nil),
+			want: true,
+		},
+		{
+			name: "conflict",
+			err:  errors.NewConflict(gr, "foo", fmt.Errorf("bar")),
+		},
+		{
+			name: "all permanent",
+			err:  &ReconcileErrors{errors: []error{NewPermanentError(fmt.Errorf("foo")), errors.NewBadRequest("bar")}},
+			want: true,
+		},
+		{
+			name: "mixed",
+			err:  &ReconcileErrors{errors: []error{NewPermanentError(fmt.Errorf("foo")), errors.NewServerTimeout(gr, "get", 1)}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsPermanentError(test.err); got != test.want {
+				t.Errorf("IsPermanentError() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
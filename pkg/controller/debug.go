@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// KeyState is the reconcile state of a key which is tracked for debugging.
+type KeyState struct {
+	Key                   string    `json:"key"`
+	Queued                bool      `json:"queued"`
+	Processing            bool      `json:"processing"`
+	Retries               int       `json:"retries"`
+	LastError             string    `json:"lastError,omitempty"`
+	LastReconcileTime     time.Time `json:"lastReconcileTime,omitempty"`
+	LastReconcileDuration string    `json:"lastReconcileDuration,omitempty"`
+}
+
+// QueueState is a snapshot of the work queue of a controller.
+type QueueState struct {
+	Controller string     `json:"controller"`
+	Depth      int        `json:"depth"`
+	Keys       []KeyState `json:"keys"`
+}
+
+// ResourceState is the desired state of a child resource as produced by the resource builders along with
+// the state currently held by the listers.
+type ResourceState struct {
+	Kind    string      `json:"kind"`
+	Name    string      `json:"name"`
+	Desired interface{} `json:"desired,omitempty"`
+	Actual  interface{} `json:"actual,omitempty"`
+	Error   string      `json:"error,omitempty"`
+}
+
+// Inspector can be implemented by a Reconciler to expose the desired and the current state of the
+// child resources of the object identified by the key.
+type Inspector interface {
+	Inspect(key string) ([]ResourceState, error)
+}
+
+// NewResourceState builds the state of a child resource. A not found error of the lister is not
+// treated as an error since the resource is yet to be created.
+func NewResourceState(kind, name string, desired interface{}, desiredErr error, actual interface{}, actualErr error) ResourceState {
+	s := ResourceState{
+		Kind:    kind,
+		Name:    name,
+		Desired: desired,
+	}
+	if actualErr == nil {
+		s.Actual = actual
+	} else if !errors.IsNotFound(actualErr) {
+		s.Error = actualErr.Error()
+	}
+	if desiredErr != nil {
+		s.Error = desiredErr.Error()
+	}
+	return s
+}
+
+// RedactSecret returns a copy of the secret without the values of its data so that it can be exposed
+// for debugging.
+func RedactSecret(secret *corev1.Secret) *corev1.Secret {
+	if secret == nil {
+		return nil
+	}
+	redacted := secret.DeepCopy()
+	for k := range redacted.Data {
+		redacted.Data[k] = []byte("<redacted>")
+	}
+	for k := range redacted.StringData {
+		redacted.StringData[k] = "<redacted>"
+	}
+	return redacted
+}
+
+// DiscardEvents is an event recorder which drops the events, e.g. the ones recorded while an object
+// is prepared for inspection.
+var DiscardEvents record.EventRecorder = discardRecorder{}
+
+type discardRecorder struct{}
+
+func (discardRecorder) Event(object runtime.Object, eventtype, reason, message string) {}
+
+func (discardRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func (discardRecorder) PastEventf(object runtime.Object, timestamp metav1.Time, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+func (discardRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+}
+
+// Name returns the name of the controller.
+func (c *Controller) Name() string {
+	return c.name
+}
+
+// QueueState returns the state of the queued, in flight and retried keys sorted by the key.
+func (c *Controller) QueueState() QueueState {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	s := QueueState{
+		Controller: c.name,
+		Depth:      c.workqueue.Len(),
+		Keys:       make([]KeyState, 0, len(c.states)),
+	}
+	for _, state := range c.states {
+		s.Keys = append(s.Keys, *state)
+	}
+	sort.Slice(s.Keys, func(i, j int) bool {
+		return s.Keys[i].Key < s.Keys[j].Key
+	})
+	return s
+}
+
+// Inspect returns the desired and the current state of the child resources of the object identified
+// by the key if the reconciler supports it.
+func (c *Controller) Inspect(key string) ([]ResourceState, error) {
+	inspector, ok := c.reconciler.(Inspector)
+	if !ok {
+		return nil, fmt.Errorf("%s controller does not support inspecting objects", c.name)
+	}
+	return inspector.Inspect(key)
+}
+
+func (c *Controller) state(key string) *KeyState {
+	state, ok := c.states[key]
+	if !ok {
+		state = &KeyState{Key: key}
+		c.states[key] = state
+	}
+	return state
+}
+
+func (c *Controller) markQueued(key string) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	c.state(key).Queued = true
+}
+
+func (c *Controller) markProcessing(key string) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	state := c.state(key)
+	state.Queued = false
+	state.Processing = true
+}
+
+func (c *Controller) markReconciled(key string, start time.Time, err error) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	state := c.state(key)
+	state.Processing = false
+	state.LastReconcileTime = start
+	state.LastReconcileDuration = time.Since(start).String()
+	state.Retries = c.workqueue.NumRequeues(key) + c.permanentRateLimiter.NumRequeues(key)
+	if err != nil {
+		state.LastError = err.Error()
+	} else {
+		state.LastError = ""
+	}
+}
+
+// forgetState drops the state of a key which is neither queued nor being processed, i.e. the key was
+// reconciled without a requeue or dropped after exhausting its retries, so that the states of deleted
+// objects are not kept for the life of the controller.
+func (c *Controller) forgetState(key string) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	if state, ok := c.states[key]; ok && !state.Queued && !state.Processing {
+		delete(c.states, key)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// permanentError wraps an error which is not expected to be resolved by retrying the reconcile
+// without a change to the object or the cluster.
+type permanentError struct {
+	err error
+}
+
+// NewPermanentError marks the given error as permanent so that the key is retried with a long backoff.
+func NewPermanentError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+// IsPermanentError returns true if the error is marked as permanent or is an API error caused by
+// an invalid request. An aggregated error is permanent only if all of its errors are permanent.
+func IsPermanentError(err error) bool {
+	switch e := err.(type) {
+	case nil:
+		return false
+	case *permanentError:
+		return true
+	case *ReconcileErrors:
+		if e.Empty() {
+			return false
+		}
+		for _, err := range e.errors {
+			if !IsPermanentError(err) {
+				return false
+			}
+		}
+		return true
+	}
+	return errors.IsInvalid(err) || errors.IsBadRequest(err)
+}
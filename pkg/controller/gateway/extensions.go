@@ -19,6 +19,7 @@
 package gateway
 
 import (
+	"context"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
@@ -26,18 +27,25 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	certmanagerv1 "cellery.io/cellery-controller/pkg/apis/certmanager/v1"
 	istionetworkingv1alpha3 "cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	k8snetworkingv1 "cellery.io/cellery-controller/pkg/apis/k8snetworking/v1"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/controller"
 	"cellery.io/cellery-controller/pkg/controller/gateway/resources"
 	"cellery.io/cellery-controller/pkg/meta"
+	"cellery.io/cellery-controller/pkg/tracing"
 )
 
-func (r *reconciler) reconcileApiPublisherConfigMap(gateway *v1alpha2.Gateway) error {
+func (r *reconciler) reconcileApiPublisherConfigMap(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
 	configMapName := resources.ApiPublisherConfigMap(gateway)
+	_, span := controller.StartStepSpan(ctx, "ApiPublisherConfigMap", configMapName)
+	defer func() { span.Finish(err) }()
 	configMap, err := r.configMapLister.ConfigMaps(gateway.Namespace).Get(configMapName)
 
 	if !resources.IsApiPublishingRequired(gateway) {
 		if err == nil && metav1.IsControlledBy(configMap, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.BatchV1().Jobs(gateway.Namespace).Delete(configMapName, meta.DeleteWithPropagationBackground())
 			if err != nil {
 				r.logger.Errorf("Failed to delete api publisher config map %q: %v", configMapName, err)
@@ -48,7 +56,8 @@ func (r *reconciler) reconcileApiPublisherConfigMap(gateway *v1alpha2.Gateway) e
 	}
 
 	if errors.IsNotFound(err) {
-		desiredConfigMap, err := resources.CreateGatewayConfigMap(gateway, r.cfg)
+		controller.SetAction(span, controller.ActionCreate)
+		desiredConfigMap, err := resources.CreateGatewayConfigMap(gateway, r.cfg.ForNamespace(gateway.Namespace))
 		configMap, err = r.kubeClient.CoreV1().ConfigMaps(gateway.Namespace).Create(desiredConfigMap)
 		if err != nil {
 			r.logger.Errorf("Failed to create api publisher ConfigMap %q: %v", configMapName, err)
@@ -60,35 +69,48 @@ func (r *reconciler) reconcileApiPublisherConfigMap(gateway *v1alpha2.Gateway) e
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve api publisher ConfigMap %q: %v", configMapName, err)
 		return err
-	} else if !metav1.IsControlledBy(configMap, gateway) {
-		return fmt.Errorf("gateway: %q does not own the api publisher ConfigMap: %q", gateway.Name, configMapName)
+	} else if !metav1.IsControlledBy(configMap, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), configMap) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the api publisher ConfigMap: %q", gateway.Name, configMapName))
 	} else {
+		adopt := !metav1.IsControlledBy(configMap, gateway)
 		configMap, err = func(gateway *v1alpha2.Gateway, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
-			if !resources.RequireGatewayConfigMapUpdate(gateway, configMap) {
+			if !adopt && !resources.RequireGatewayConfigMapUpdate(gateway, configMap) &&
+				controller.ConfigHashEqual(configMap, r.cfg.ForNamespace(gateway.Namespace).Hash()) {
+				controller.SetAction(span, controller.ActionSkip)
 				return configMap, nil
 			}
-			desiredConfigMap, err := resources.CreateGatewayConfigMap(gateway, r.cfg)
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredConfigMap, err := resources.CreateGatewayConfigMap(gateway, r.cfg.ForNamespace(gateway.Namespace))
 			if err != nil {
 				return nil, err
 			}
 			existingConfigMap := configMap.DeepCopy()
 			resources.CopyGatewayConfigMap(desiredConfigMap, existingConfigMap)
+			if adopt {
+				controller.Adopt(existingConfigMap, desiredConfigMap)
+			}
 			return r.kubeClient.CoreV1().ConfigMaps(gateway.Namespace).Update(existingConfigMap)
 		}(gateway, configMap)
 		if err != nil {
 			r.logger.Errorf("Failed to update api publisher ConfigMap %q: %v", configMapName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(gateway, "ConfigMap", configMapName)
+		}
 	}
 	resources.StatusFromConfigMap(gateway, configMap)
 	return nil
 }
 
-func (r *reconciler) reconcileApiPublisherJob(gateway *v1alpha2.Gateway) error {
+func (r *reconciler) reconcileApiPublisherJob(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
 	jobName := resources.JobName(gateway)
+	_, span := controller.StartStepSpan(ctx, "ApiPublisherJob", jobName)
+	defer func() { span.Finish(err) }()
 	job, err := r.jobLister.Jobs(gateway.Namespace).Get(jobName)
 	if !resources.RequireApiPublisherJob(gateway) {
 		if err == nil && metav1.IsControlledBy(job, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.BatchV1().Jobs(gateway.Namespace).Delete(jobName, meta.DeleteWithPropagationBackground())
 			if err != nil {
 				r.logger.Errorf("Failed to delete api publisher Job %q: %v", jobName, err)
@@ -99,7 +121,8 @@ func (r *reconciler) reconcileApiPublisherJob(gateway *v1alpha2.Gateway) error {
 	}
 
 	if errors.IsNotFound(err) {
-		job, err = r.kubeClient.BatchV1().Jobs(gateway.Namespace).Create(resources.MakeApiPublisherJob(gateway, r.cfg))
+		controller.SetAction(span, controller.ActionCreate)
+		job, err = r.kubeClient.BatchV1().Jobs(gateway.Namespace).Create(resources.MakeApiPublisherJob(gateway, r.cfg.ForNamespace(gateway.Namespace)))
 		if err != nil {
 			r.logger.Errorf("Failed to create api publisher Job %q: %v", jobName, err)
 			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create api publisher Job %q: %v", jobName, err)
@@ -109,10 +132,23 @@ func (r *reconciler) reconcileApiPublisherJob(gateway *v1alpha2.Gateway) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve api publisher Job %q: %v", jobName, err)
 		return err
+	} else if !metav1.IsControlledBy(job, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), job) {
+		return controller.NewPermanentError(fmt.Errorf("component: %q does not own the api publisher Job: %q", gateway.Name, jobName))
 	} else if !metav1.IsControlledBy(job, gateway) {
-		return fmt.Errorf("component: %q does not own the api publisher Job: %q", gateway.Name, jobName)
+		controller.SetAction(span, controller.ActionUpdate)
+		existingJob := job.DeepCopy()
+		controller.AdoptWithOwnerRef(existingJob, controller.CreateGatewayOwnerRef(gateway))
+		job, err = r.kubeClient.BatchV1().Jobs(gateway.Namespace).Update(existingJob)
+		if err != nil {
+			r.logger.Errorf("Failed to adopt api publisher Job %q: %v", jobName, err)
+			return err
+		}
+		r.recordAdoption(gateway, "Job", jobName)
 	} else {
-		if resources.RequireApiPublisherJobUpdate(gateway, job) {
+		if !resources.RequireApiPublisherJobUpdate(gateway, job) && controller.ConfigHashEqual(job, r.cfg.ForNamespace(gateway.Namespace).Hash()) {
+			controller.SetAction(span, controller.ActionSkip)
+		} else {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.BatchV1().Jobs(gateway.Namespace).Delete(jobName, meta.DeleteWithPropagationBackground())
 			if err != nil {
 				r.logger.Errorf("Failed to delete api publisher Job %q: %v", jobName, err)
@@ -124,12 +160,18 @@ func (r *reconciler) reconcileApiPublisherJob(gateway *v1alpha2.Gateway) error {
 	return nil
 }
 
-func (r *reconciler) reconcileClusterIngress(gateway *v1alpha2.Gateway) error {
+func (r *reconciler) reconcileClusterIngress(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
 	ingressName := resources.ClusterIngressName(gateway)
+	_, span := controller.StartStepSpan(ctx, "ClusterIngress", ingressName)
+	defer func() { span.Finish(err) }()
+	if !r.useIngressV1 {
+		return r.reconcileLegacyClusterIngress(span, gateway, ingressName)
+	}
 	ingress, err := r.clusterIngressLister.Ingresses(gateway.Namespace).Get(ingressName)
 	if !resources.RequireClusterIngress(gateway) {
 		if err == nil && metav1.IsControlledBy(ingress, gateway) {
-			err = r.kubeClient.ExtensionsV1beta1().Ingresses(gateway.Namespace).Delete(ingressName, &metav1.DeleteOptions{})
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.K8sNetworkingV1().Ingresses(gateway.Namespace).Delete(ingressName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete Ingress %q: %v", ingressName, err)
 				return err
@@ -139,7 +181,8 @@ func (r *reconciler) reconcileClusterIngress(gateway *v1alpha2.Gateway) error {
 	}
 
 	if errors.IsNotFound(err) {
-		ingress, err = r.kubeClient.ExtensionsV1beta1().Ingresses(gateway.Namespace).Create(resources.MakeClusterIngress(gateway))
+		controller.SetAction(span, controller.ActionCreate)
+		ingress, err = r.meshClient.K8sNetworkingV1().Ingresses(gateway.Namespace).Create(resources.MakeClusterIngress(gateway))
 		if err != nil {
 			r.logger.Errorf("Failed to create Ingress %q: %v", ingressName, err)
 			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create Ingress %q: %v", ingressName, err)
@@ -149,35 +192,108 @@ func (r *reconciler) reconcileClusterIngress(gateway *v1alpha2.Gateway) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Ingress %q: %v", ingressName, err)
 		return err
-	} else if !metav1.IsControlledBy(ingress, gateway) {
-		return fmt.Errorf("gateway: %q does not own the Ingress: %q", gateway.Name, ingressName)
+	} else if !metav1.IsControlledBy(ingress, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), ingress) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the Ingress: %q", gateway.Name, ingressName))
 	} else {
-		ingress, err = func(gateway *v1alpha2.Gateway, ingress *extensionsv1beta1.Ingress) (*extensionsv1beta1.Ingress, error) {
-			if !resources.RequireClusterIngressUpdate(gateway, ingress) {
+		adopt := !metav1.IsControlledBy(ingress, gateway)
+		ingress, err = func(gateway *v1alpha2.Gateway, ingress *k8snetworkingv1.Ingress) (*k8snetworkingv1.Ingress, error) {
+			if !adopt && !resources.RequireClusterIngressUpdate(gateway, ingress) {
+				controller.SetAction(span, controller.ActionSkip)
 				return ingress, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredIngress := resources.MakeClusterIngress(gateway)
 			if err != nil {
 				return nil, err
 			}
 			existingIngress := ingress.DeepCopy()
 			resources.CopyClusterIngress(desiredIngress, existingIngress)
-			return r.kubeClient.ExtensionsV1beta1().Ingresses(gateway.Namespace).Update(existingIngress)
+			if adopt {
+				controller.Adopt(existingIngress, desiredIngress)
+			}
+			return r.meshClient.K8sNetworkingV1().Ingresses(gateway.Namespace).Update(existingIngress)
 		}(gateway, ingress)
 		if err != nil {
 			r.logger.Errorf("Failed to update Ingress %q: %v", ingressName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(gateway, "Ingress", ingressName)
+		}
 	}
 	resources.StatusFromClusterIngress(gateway, ingress)
 	return nil
 }
 
-func (r *reconciler) reconcileClusterIngressSecret(gateway *v1alpha2.Gateway) error {
+// reconcileLegacyClusterIngress reconciles the extensions/v1beta1 Ingress on the clusters which do not serve
+// the networking.k8s.io/v1 API.
+func (r *reconciler) reconcileLegacyClusterIngress(span *tracing.Span, gateway *v1alpha2.Gateway, ingressName string) error {
+	ingress, err := r.legacyClusterIngressLister.Ingresses(gateway.Namespace).Get(ingressName)
+	if !resources.RequireClusterIngress(gateway) {
+		if err == nil && metav1.IsControlledBy(ingress, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.kubeClient.ExtensionsV1beta1().Ingresses(gateway.Namespace).Delete(ingressName, &metav1.DeleteOptions{})
+			if err != nil {
+				r.logger.Errorf("Failed to delete Ingress %q: %v", ingressName, err)
+				return err
+			}
+		}
+		return nil
+	}
+
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		ingress, err = r.kubeClient.ExtensionsV1beta1().Ingresses(gateway.Namespace).Create(resources.MakeLegacyClusterIngress(gateway))
+		if err != nil {
+			r.logger.Errorf("Failed to create Ingress %q: %v", ingressName, err)
+			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create Ingress %q: %v", ingressName, err)
+			return err
+		}
+		r.recorder.Eventf(gateway, corev1.EventTypeNormal, "Created", "Created Ingress %q", ingressName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve Ingress %q: %v", ingressName, err)
+		return err
+	} else if !metav1.IsControlledBy(ingress, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), ingress) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the Ingress: %q", gateway.Name, ingressName))
+	} else {
+		adopt := !metav1.IsControlledBy(ingress, gateway)
+		ingress, err = func(gateway *v1alpha2.Gateway, ingress *extensionsv1beta1.Ingress) (*extensionsv1beta1.Ingress, error) {
+			if !adopt && !resources.RequireLegacyClusterIngressUpdate(gateway, ingress) {
+				controller.SetAction(span, controller.ActionSkip)
+				return ingress, nil
+			}
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredIngress := resources.MakeLegacyClusterIngress(gateway)
+			if err != nil {
+				return nil, err
+			}
+			existingIngress := ingress.DeepCopy()
+			resources.CopyLegacyClusterIngress(desiredIngress, existingIngress)
+			if adopt {
+				controller.Adopt(existingIngress, desiredIngress)
+			}
+			return r.kubeClient.ExtensionsV1beta1().Ingresses(gateway.Namespace).Update(existingIngress)
+		}(gateway, ingress)
+		if err != nil {
+			r.logger.Errorf("Failed to update Ingress %q: %v", ingressName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(gateway, "Ingress", ingressName)
+		}
+	}
+	resources.StatusFromLegacyClusterIngress(gateway, ingress)
+	return nil
+}
+
+func (r *reconciler) reconcileClusterIngressSecret(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
 	secretName := resources.ClusterIngressSecretName(gateway)
+	_, span := controller.StartStepSpan(ctx, "ClusterIngressSecret", secretName)
+	defer func() { span.Finish(err) }()
 	secret, err := r.secretLister.Secrets(gateway.Namespace).Get(secretName)
 	if !resources.RequireClusterIngressSecret(gateway) {
 		if err == nil && metav1.IsControlledBy(secret, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.CoreV1().Secrets(gateway.Namespace).Delete(secretName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete ingress Secret %q: %v", secretName, err)
@@ -188,8 +304,9 @@ func (r *reconciler) reconcileClusterIngressSecret(gateway *v1alpha2.Gateway) er
 	}
 
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		secret, err = func(gateway *v1alpha2.Gateway) (*corev1.Secret, error) {
-			desiredSecret, err := resources.MakeClusterIngressSecret(gateway, r.cfg)
+			desiredSecret, err := resources.MakeClusterIngressSecret(gateway, r.cfg.ForNamespace(gateway.Namespace))
 			if err != nil {
 				return nil, err
 			}
@@ -204,35 +321,125 @@ func (r *reconciler) reconcileClusterIngressSecret(gateway *v1alpha2.Gateway) er
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve ingress Secret %q: %v", secretName, err)
 		return err
-	} else if !metav1.IsControlledBy(secret, gateway) {
-		return fmt.Errorf("gateway: %q does not own the ingress Secret: %q", gateway.Name, secretName)
+	} else if !metav1.IsControlledBy(secret, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), secret) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the ingress Secret: %q", gateway.Name, secretName))
 	} else {
+		adopt := !metav1.IsControlledBy(secret, gateway)
 		secret, err = func(gateway *v1alpha2.Gateway, secret *corev1.Secret) (*corev1.Secret, error) {
-			if !resources.RequireClusterIngressSecretUpdate(gateway, secret) {
+			if !adopt && !resources.RequireClusterIngressSecretUpdate(gateway, secret) &&
+				controller.ConfigHashEqual(secret, controller.CertificateHash(r.cfg.ForNamespace(gateway.Namespace))) {
+				controller.SetAction(span, controller.ActionSkip)
 				return secret, nil
 			}
-			desiredSecret, err := resources.MakeClusterIngressSecret(gateway, r.cfg)
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredSecret, err := resources.MakeClusterIngressSecret(gateway, r.cfg.ForNamespace(gateway.Namespace))
 			if err != nil {
 				return nil, err
 			}
 			existingSecret := secret.DeepCopy()
 			resources.CopyClusterIngressSecret(desiredSecret, existingSecret)
+			if adopt {
+				controller.Adopt(existingSecret, desiredSecret)
+			}
 			return r.kubeClient.CoreV1().Secrets(gateway.Namespace).Update(existingSecret)
 		}(gateway, secret)
 		if err != nil {
 			r.logger.Errorf("Failed to update ingress Secret %q: %v", secretName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(gateway, "Secret", secretName)
+		}
 	}
 	resources.StatusFromClusterIngressSecret(gateway, secret)
 	return nil
 }
 
-func (r *reconciler) reconcileOidcEnvoyFilter(gateway *v1alpha2.Gateway) error {
+func (r *reconciler) reconcileClusterIngressCertificate(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
+	certificateName := resources.ClusterIngressCertificateName(gateway)
+	_, span := controller.StartStepSpan(ctx, "ClusterIngressCertificate", certificateName)
+	defer func() { span.Finish(err) }()
+	if !r.certificatesAvailable {
+		gateway.Status.RemoveCondition(v1alpha2.GatewayCertificateReady)
+		if resources.RequireClusterIngressCertificate(gateway) {
+			return controller.NewPermanentError(fmt.Errorf("gateway: %q requires a Certificate but cert-manager is not installed in the cluster", gateway.Name))
+		}
+		return nil
+	}
+	certificate, err := r.certificateLister.Certificates(gateway.Namespace).Get(certificateName)
+	if !resources.RequireClusterIngressCertificate(gateway) {
+		gateway.Status.RemoveCondition(v1alpha2.GatewayCertificateReady)
+		if err == nil && metav1.IsControlledBy(certificate, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.CertmanagerV1().Certificates(gateway.Namespace).Delete(certificateName, &metav1.DeleteOptions{})
+			if err != nil {
+				r.logger.Errorf("Failed to delete ingress Certificate %q: %v", certificateName, err)
+				return err
+			}
+		}
+		return nil
+	}
+
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		certificate, err = func(gateway *v1alpha2.Gateway) (*certmanagerv1.Certificate, error) {
+			desiredCertificate, err := resources.MakeClusterIngressCertificate(gateway, r.cfg.ForNamespace(gateway.Namespace))
+			if err != nil {
+				return nil, err
+			}
+			return r.meshClient.CertmanagerV1().Certificates(gateway.Namespace).Create(desiredCertificate)
+		}(gateway)
+		if err != nil {
+			r.logger.Errorf("Failed to create ingress Certificate %q: %v", certificateName, err)
+			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create ingress Certificate %q: %v", certificateName, err)
+			return err
+		}
+		r.recorder.Eventf(gateway, corev1.EventTypeNormal, "Created", "Created ingress Certificate %q", certificateName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve ingress Certificate %q: %v", certificateName, err)
+		return err
+	} else if !metav1.IsControlledBy(certificate, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), certificate) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the ingress Certificate: %q", gateway.Name, certificateName))
+	} else {
+		adopt := !metav1.IsControlledBy(certificate, gateway)
+		certificate, err = func(gateway *v1alpha2.Gateway, certificate *certmanagerv1.Certificate) (*certmanagerv1.Certificate, error) {
+			if !adopt && !resources.RequireClusterIngressCertificateUpdate(gateway, certificate) &&
+				controller.ConfigHashEqual(certificate, r.cfg.ForNamespace(gateway.Namespace).Hash()) {
+				controller.SetAction(span, controller.ActionSkip)
+				return certificate, nil
+			}
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredCertificate, err := resources.MakeClusterIngressCertificate(gateway, r.cfg.ForNamespace(gateway.Namespace))
+			if err != nil {
+				return nil, err
+			}
+			existingCertificate := certificate.DeepCopy()
+			resources.CopyClusterIngressCertificate(desiredCertificate, existingCertificate)
+			if adopt {
+				controller.Adopt(existingCertificate, desiredCertificate)
+			}
+			return r.meshClient.CertmanagerV1().Certificates(gateway.Namespace).Update(existingCertificate)
+		}(gateway, certificate)
+		if err != nil {
+			r.logger.Errorf("Failed to update ingress Certificate %q: %v", certificateName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(gateway, "Certificate", certificateName)
+		}
+	}
+	resources.StatusFromClusterIngressCertificate(gateway, certificate)
+	return nil
+}
+
+func (r *reconciler) reconcileOidcEnvoyFilter(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
 	envoyFilterName := resources.OidcEnvoyFilterName(gateway)
+	_, span := controller.StartStepSpan(ctx, "OidcEnvoyFilter", envoyFilterName)
+	defer func() { span.Finish(err) }()
 	envoyFilter, err := r.istioEnvoyFilterLister.EnvoyFilters(gateway.Namespace).Get(envoyFilterName)
 	if !resources.RequireOidcEnvoyFilter(gateway) {
 		if err == nil && metav1.IsControlledBy(envoyFilter, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.meshClient.NetworkingV1alpha3().EnvoyFilters(gateway.Namespace).Delete(envoyFilterName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete oidc EnvoyFilter %q: %v", envoyFilterName, err)
@@ -242,8 +449,10 @@ func (r *reconciler) reconcileOidcEnvoyFilter(gateway *v1alpha2.Gateway) error {
 		return nil
 	}
 
+	desiredEnvoyFilter := resources.MakeOidcEnvoyFilter(gateway, r.cfg.ForNamespace(gateway.Namespace))
 	if errors.IsNotFound(err) {
-		envoyFilter, err = r.meshClient.NetworkingV1alpha3().EnvoyFilters(gateway.Namespace).Create(resources.MakeOidcEnvoyFilter(gateway))
+		controller.SetAction(span, controller.ActionCreate)
+		envoyFilter, err = r.meshClient.NetworkingV1alpha3().EnvoyFilters(gateway.Namespace).Create(desiredEnvoyFilter)
 		if err != nil {
 			r.logger.Errorf("Failed to create oidc EnvoyFilter %q: %v", envoyFilterName, err)
 			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create oidc EnvoyFilter %q: %v", envoyFilterName, err)
@@ -253,25 +462,30 @@ func (r *reconciler) reconcileOidcEnvoyFilter(gateway *v1alpha2.Gateway) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve oidc EnvoyFilter %q: %v", envoyFilterName, err)
 		return err
-	} else if !metav1.IsControlledBy(envoyFilter, gateway) {
-		return fmt.Errorf("gateway: %q does not own the oidc EnvoyFilter: %q", gateway.Name, envoyFilterName)
+	} else if !metav1.IsControlledBy(envoyFilter, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), envoyFilter) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the oidc EnvoyFilter: %q", gateway.Name, envoyFilterName))
 	} else {
+		adopt := !metav1.IsControlledBy(envoyFilter, gateway)
 		envoyFilter, err = func(gateway *v1alpha2.Gateway, envoyFilter *istionetworkingv1alpha3.EnvoyFilter) (*istionetworkingv1alpha3.EnvoyFilter, error) {
-			if !resources.RequireOidcEnvoyFilterUpdate(gateway, envoyFilter) {
+			if !adopt && !resources.RequireOidcEnvoyFilterUpdate(gateway, desiredEnvoyFilter, envoyFilter) {
+				controller.SetAction(span, controller.ActionSkip)
 				return envoyFilter, nil
 			}
-			desiredEnvoyFilter := resources.MakeOidcEnvoyFilter(gateway)
-			if err != nil {
-				return nil, err
-			}
+			controller.SetAction(span, controller.ActionUpdate)
 			existingEnvoyFilter := envoyFilter.DeepCopy()
 			resources.CopyOidcEnvoyFilter(desiredEnvoyFilter, existingEnvoyFilter)
+			if adopt {
+				controller.Adopt(existingEnvoyFilter, desiredEnvoyFilter)
+			}
 			return r.meshClient.NetworkingV1alpha3().EnvoyFilters(gateway.Namespace).Update(existingEnvoyFilter)
 		}(gateway, envoyFilter)
 		if err != nil {
 			r.logger.Errorf("Failed to update oidc EnvoyFilter %q: %v", envoyFilterName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(gateway, "EnvoyFilter", envoyFilterName)
+		}
 	}
 	resources.StatusFromOidcEnvoyFilter(gateway, envoyFilter)
 	return nil
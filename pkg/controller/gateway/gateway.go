@@ -19,9 +19,11 @@
 package gateway
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 
 	"cellery.io/cellery-controller/pkg/meta"
 
@@ -31,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -41,67 +44,77 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
-	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
-	autoscalingv2beta1lister "k8s.io/client-go/listers/autoscaling/v2beta1"
-
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
 	istionetworkingv1alpha3 "cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	istiosecurityv1beta1 "cellery.io/cellery-controller/pkg/apis/istio/security/v1beta1"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/clients"
+	"cellery.io/cellery-controller/pkg/cloudevents"
 	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
 	"cellery.io/cellery-controller/pkg/controller/gateway/resources"
 	meshclientset "cellery.io/cellery-controller/pkg/generated/clientset/versioned"
+	autoscalingv2listers "cellery.io/cellery-controller/pkg/generated/listers/autoscaling/v2"
+	certmanagerv1listers "cellery.io/cellery-controller/pkg/generated/listers/certmanager/v1"
+	gatewayapiv1listers "cellery.io/cellery-controller/pkg/generated/listers/gatewayapi/v1"
+	gatewayapiv1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/gatewayapi/v1alpha2"
+	k8snetworkingv1listers "cellery.io/cellery-controller/pkg/generated/listers/k8snetworking/v1"
 	mesh1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
 	istionetwork1alpha3listers "cellery.io/cellery-controller/pkg/generated/listers/networking/v1alpha3"
+	istiosecurityv1beta1listers "cellery.io/cellery-controller/pkg/generated/listers/security/v1beta1"
 	"cellery.io/cellery-controller/pkg/informers"
+	"cellery.io/cellery-controller/pkg/logging"
 )
 
 type reconciler struct {
-	kubeClient                 kubernetes.Interface
-	meshClient                 meshclientset.Interface
-	deploymentLister           appsv1listers.DeploymentLister
-	serviceLister              corev1listers.ServiceLister
-	jobLister                  batchv1listers.JobLister
-	clusterIngressLister       extensionsv1beta1listers.IngressLister
-	secretLister               corev1listers.SecretLister
-	istioGatewayLister         istionetwork1alpha3listers.GatewayLister
-	istioDestinationRuleLister istionetwork1alpha3listers.DestinationRuleLister
-	istioVirtualServiceLister  istionetwork1alpha3listers.VirtualServiceLister
-	istioEnvoyFilterLister     istionetwork1alpha3listers.EnvoyFilterLister
-	configMapLister            corev1listers.ConfigMapLister
-	gatewayLister              mesh1alpha2listers.GatewayLister
-	hpaLister                  autoscalingv2beta1lister.HorizontalPodAutoscalerLister
-
-	cfg      config.Interface
-	logger   *zap.SugaredLogger
-	recorder record.EventRecorder
+	kubeClient                       kubernetes.Interface
+	meshClient                       meshclientset.Interface
+	deploymentLister                 appsv1listers.DeploymentLister
+	serviceLister                    corev1listers.ServiceLister
+	jobLister                        batchv1listers.JobLister
+	clusterIngressLister             k8snetworkingv1listers.IngressLister
+	legacyClusterIngressLister       extensionsv1beta1listers.IngressLister
+	secretLister                     corev1listers.SecretLister
+	istioGatewayLister               istionetwork1alpha3listers.GatewayLister
+	istioDestinationRuleLister       istionetwork1alpha3listers.DestinationRuleLister
+	istioVirtualServiceLister        istionetwork1alpha3listers.VirtualServiceLister
+	istioEnvoyFilterLister           istionetwork1alpha3listers.EnvoyFilterLister
+	istioRequestAuthenticationLister istiosecurityv1beta1listers.RequestAuthenticationLister
+	istioAuthorizationPolicyLister   istiosecurityv1beta1listers.AuthorizationPolicyLister
+	configMapLister                  corev1listers.ConfigMapLister
+	gatewayLister                    mesh1alpha2listers.GatewayLister
+	cellLister                       mesh1alpha2listers.CellLister
+	compositeLister                  mesh1alpha2listers.CompositeLister
+	hpaLister                        autoscalingv2listers.HorizontalPodAutoscalerLister
+	autoscaleOverrideLister          mesh1alpha2listers.AutoscaleOverrideLister
+	httpRouteLister                  gatewayapiv1listers.HTTPRouteLister
+	grpcRouteLister                  gatewayapiv1listers.GRPCRouteLister
+	tcpRouteLister                   gatewayapiv1alpha2listers.TCPRouteLister
+	certificateLister                certmanagerv1listers.CertificateLister
+
+	// useIngressV1 is set if the cluster serves the networking.k8s.io/v1 Ingress API
+	useIngressV1 bool
+	// gatewayAPIRoutes holds the Gateway API routes served by the cluster
+	gatewayAPIRoutes clients.GatewayAPIRoutes
+	// certificatesAvailable is set if cert-manager is installed in the cluster
+	certificatesAvailable bool
+	// istioSecurityAvailable is set if the cluster serves the security.istio.io/v1beta1 API
+	istioSecurityAvailable bool
+
+	cfg       config.Interface
+	publisher cloudevents.Interface
+	logger    *zap.SugaredLogger
+	recorder  record.EventRecorder
 }
 
 func NewController(
 	clientset clients.Interface,
 	informerset informers.Interface,
 	cfg config.Interface,
+	publisher cloudevents.Interface,
 	logger *zap.SugaredLogger,
 ) *controller.Controller {
-
-	r := &reconciler{
-		kubeClient:                 clientset.Kubernetes(),
-		meshClient:                 clientset.Mesh(),
-		deploymentLister:           informerset.Deployments().Lister(),
-		serviceLister:              informerset.Services().Lister(),
-		jobLister:                  informerset.Jobs().Lister(),
-		clusterIngressLister:       informerset.Ingresses().Lister(),
-		secretLister:               informerset.Secrets().Lister(),
-		istioGatewayLister:         informerset.IstioGateways().Lister(),
-		istioDestinationRuleLister: informerset.IstioDestinationRules().Lister(),
-		istioVirtualServiceLister:  informerset.IstioVirtualServices().Lister(),
-		istioEnvoyFilterLister:     informerset.IstioEnvoyFilters().Lister(),
-		configMapLister:            informerset.ConfigMaps().Lister(),
-		gatewayLister:              informerset.Gateways().Lister(),
-		hpaLister:                  informerset.HorizontalPodAutoscalers().Lister(),
-		cfg:                        cfg,
-		logger:                     logger.Named("gateway-controller"),
-	}
+	r := newReconciler(clientset, informerset, cfg, publisher, logger)
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(r.logger.Named("events").Infof)
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: r.kubeClient.CoreV1().Events("")})
@@ -112,6 +125,11 @@ func NewController(
 	r.logger.Info("Setting up event handlers")
 	informerset.Gateways().Informer().AddEventHandler(informers.HandleAll(c.Enqueue))
 
+	informerset.Cells().Informer().AddEventHandler(controller.HandlePauseChange(c.EnqueueControlledBy(informerset.Gateways().Informer().GetIndexer())))
+	informerset.Cells().Informer().AddEventHandler(controller.HandleSuspendChange(c.EnqueueControlledBy(informerset.Gateways().Informer().GetIndexer())))
+
+	informerset.AutoscaleOverrides().Informer().AddEventHandler(controller.HandleAutoscaleOverride("Gateway", c.EnqueueKey))
+
 	informerset.Services().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
@@ -132,16 +150,65 @@ func NewController(
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
+	informerset.IstioDestinationRules().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
+		Handler:    informers.HandleAll(c.EnqueueControllerOf),
+	})
+
 	informerset.IstioEnvoyFilters().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
-	informerset.Ingresses().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+	if r.istioSecurityAvailable {
+		informerset.IstioRequestAuthentications().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
+			Handler:    informers.HandleAll(c.EnqueueControllerOf),
+		})
+
+		informerset.IstioAuthorizationPolicies().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
+			Handler:    informers.HandleAll(c.EnqueueControllerOf),
+		})
+	}
+
+	ingressInformer := informerset.LegacyIngresses().Informer
+	if r.useIngressV1 {
+		ingressInformer = informerset.Ingresses().Informer
+	}
+	ingressInformer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
+	if r.gatewayAPIRoutes.HTTPRoutes {
+		informerset.GatewayAPIHTTPRoutes().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
+			Handler:    informers.HandleAll(c.EnqueueControllerOf),
+		})
+	}
+
+	if r.gatewayAPIRoutes.GRPCRoutes {
+		informerset.GatewayAPIGRPCRoutes().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
+			Handler:    informers.HandleAll(c.EnqueueControllerOf),
+		})
+	}
+
+	if r.gatewayAPIRoutes.TCPRoutes {
+		informerset.GatewayAPITCPRoutes().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
+			Handler:    informers.HandleAll(c.EnqueueControllerOf),
+		})
+	}
+
+	if r.certificatesAvailable {
+		informerset.CertManagerCertificates().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
+			Handler:    informers.HandleAll(c.EnqueueControllerOf),
+		})
+	}
+
 	informerset.Secrets().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("Gateway")),
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
@@ -152,117 +219,242 @@ func NewController(
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
+	// The configuration of a gateway depends on the config overrides of its namespace
+	enqueueNamespace := func(namespace string) {
+		gateways, err := r.gatewayLister.Gateways(namespace).List(labels.Everything())
+		if err != nil {
+			r.logger.Errorf("Failed to list the gateways of namespace %q: %v", namespace, err)
+			return
+		}
+		for _, gateway := range gateways {
+			c.Enqueue(gateway)
+		}
+	}
+	informerset.ConfigMaps().Informer().AddEventHandler(controller.HandleConfigOverride(enqueueNamespace))
+	informerset.Secrets().Informer().AddEventHandler(controller.HandleConfigOverride(enqueueNamespace))
+
 	return c
 }
 
-func (r *reconciler) Reconcile(key string) error {
-	r.logger.Infof("Reconcile called with %s", key)
+func newReconciler(
+	clientset clients.Interface,
+	informerset informers.Interface,
+	cfg config.Interface,
+	publisher cloudevents.Interface,
+	logger *zap.SugaredLogger,
+) *reconciler {
+	r := &reconciler{
+		kubeClient:                 clientset.Kubernetes(),
+		meshClient:                 clientset.Mesh(),
+		deploymentLister:           informerset.Deployments().Lister(),
+		serviceLister:              informerset.Services().Lister(),
+		jobLister:                  informerset.Jobs().Lister(),
+		secretLister:               informerset.Secrets().Lister(),
+		istioGatewayLister:         informerset.IstioGateways().Lister(),
+		istioDestinationRuleLister: informerset.IstioDestinationRules().Lister(),
+		istioVirtualServiceLister:  informerset.IstioVirtualServices().Lister(),
+		istioEnvoyFilterLister:     informerset.IstioEnvoyFilters().Lister(),
+		configMapLister:            informerset.ConfigMaps().Lister(),
+		gatewayLister:              informerset.Gateways().Lister(),
+		cellLister:                 informerset.Cells().Lister(),
+		compositeLister:            informerset.Composites().Lister(),
+		hpaLister:                  informerset.HorizontalPodAutoscalers().Lister(),
+		autoscaleOverrideLister:    informerset.AutoscaleOverrides().Lister(),
+		cfg:                        cfg,
+		publisher:                  publisher,
+		logger:                     logger.Named("gateway-controller"),
+		useIngressV1:               clients.IngressV1Available(clientset),
+		gatewayAPIRoutes:           clients.ServedGatewayAPIRoutes(clientset),
+		certificatesAvailable:      clients.CertificatesAvailable(clientset),
+		istioSecurityAvailable:     clients.IstioSecurityAvailable(clientset),
+	}
+	// Only the lister of the served Ingress API is retrieved since it registers the informer to be started.
+	if r.useIngressV1 {
+		r.clusterIngressLister = informerset.Ingresses().Lister()
+	} else {
+		r.legacyClusterIngressLister = informerset.LegacyIngresses().Lister()
+	}
+	if r.gatewayAPIRoutes.HTTPRoutes {
+		r.httpRouteLister = informerset.GatewayAPIHTTPRoutes().Lister()
+	}
+	if r.gatewayAPIRoutes.GRPCRoutes {
+		r.grpcRouteLister = informerset.GatewayAPIGRPCRoutes().Lister()
+	}
+	if r.gatewayAPIRoutes.TCPRoutes {
+		r.tcpRouteLister = informerset.GatewayAPITCPRoutes().Lister()
+	}
+	if r.certificatesAvailable {
+		r.certificateLister = informerset.CertManagerCertificates().Lister()
+	}
+	if r.istioSecurityAvailable {
+		r.istioRequestAuthenticationLister = informerset.IstioRequestAuthentications().Lister()
+		r.istioAuthorizationPolicyLister = informerset.IstioAuthorizationPolicies().Lister()
+	}
+	return r
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, key string) (_ controller.Result, err error) {
+	logger := logging.FromContext(ctx)
+	logger.Infof("Reconcile called with %s", key)
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
-		r.logger.Errorf("invalid resource key: %s", key)
-		return nil
+		logger.Errorf("invalid resource key: %s", key)
+		return controller.Result{}, nil
 	}
 	original, err := r.gatewayLister.Gateways(namespace).Get(name)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			r.logger.Errorf("gateway '%s' in work queue no longer exists", key)
-			return nil
+			logger.Errorf("gateway '%s' in work queue no longer exists", key)
+			return controller.Result{}, nil
 		}
-		return err
+		return controller.Result{}, err
 	}
 
 	gateway := original.DeepCopy()
 
-	if err = r.reconcile(gateway); err != nil {
-		r.recorder.Eventf(gateway, corev1.EventTypeWarning, "InternalError", "Failed to update cluster: %v", err)
-		return err
+	ctx, span := controller.StartReconcileSpan(ctx, "Gateway", gateway, gateway.Generation != gateway.Status.ObservedGeneration)
+	defer func() { span.Finish(err) }()
+
+	var result controller.Result
+	if controller.IsPaused(gateway) || controller.IsOwnerPaused(gateway, r.cellLister, r.compositeLister) {
+		if gateway.Status.SetCondition(v1alpha2.GatewayReconcilePaused, corev1.ConditionTrue) {
+			r.recorder.Eventf(gateway, corev1.EventTypeNormal, "ReconcilePaused", "Reconciliation of Gateway %q is paused", gateway.Name)
+		}
+	} else {
+		if gateway.Status.RemoveCondition(v1alpha2.GatewayReconcilePaused) {
+			r.recorder.Eventf(gateway, corev1.EventTypeNormal, "ReconcileResumed", "Reconciliation of Gateway %q is resumed", gateway.Name)
+		}
+		result, err = r.prepare(gateway, r.recorder)
+		if err == nil {
+			err = r.reconcile(ctx, gateway)
+		}
+		if err != nil {
+			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "InternalError", "Failed to update cluster: %v", err)
+			return controller.Result{}, err
+		}
+		gateway.Status.RemoveCondition(v1alpha2.GatewayReconcileFailed)
+	}
+
+	// Do not update the status from a cancelled or timed out reconcile
+	if err = ctx.Err(); err != nil {
+		return controller.Result{}, err
 	}
 
 	if equality.Semantic.DeepEqual(original.Status, gateway.Status) {
-		return nil
+		return result, nil
 	}
 
 	if _, err = r.updateStatus(gateway); err != nil {
 		r.recorder.Eventf(gateway, corev1.EventTypeWarning, "UpdateFailed", "Failed to update status: %v", err)
-		return err
+		return controller.Result{}, err
 	}
 	r.recorder.Eventf(gateway, corev1.EventTypeNormal, "Updated", "Updated Gateway status %q", gateway.GetName())
-	return nil
+	if original.Status.PublisherStatus != v1alpha2.PublisherCurrentStatusSucceeded &&
+		gateway.Status.PublisherStatus == v1alpha2.PublisherCurrentStatusSucceeded {
+		r.publisher.Publish(cloudevents.NewEvent(cloudevents.TypeGatewayPublished, "Gateway", gateway))
+	}
+	return result, nil
 }
 
-func (r *reconciler) reconcile(gateway *v1alpha2.Gateway) error {
-	gateway.Default()
+// RecordFailure marks the Gateway as failed once its key has exhausted the retries.
+func (r *reconciler) RecordFailure(ctx context.Context, key string, reconcileErr error) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	original, err := r.gatewayLister.Gateways(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+
+	gateway := original.DeepCopy()
+	if !gateway.Status.SetCondition(v1alpha2.GatewayReconcileFailed, corev1.ConditionTrue) {
+		return nil
+	}
+	r.recorder.Eventf(gateway, corev1.EventTypeWarning, "ReconcileFailed", "Reconciliation of Gateway %q failed after retries: %v", name, reconcileErr)
+	_, err = r.updateStatus(gateway)
+	return err
+}
+
+func (r *reconciler) reconcile(ctx context.Context, gateway *v1alpha2.Gateway) error {
 	rErrs := &controller.ReconcileErrors{}
 
-	rErrs.Add(r.reconcileService(gateway))
-	rErrs.Add(r.reconcileDeployment(gateway))
-	rErrs.Add(r.reconcileIstioVirtualService(gateway))
-	rErrs.Add(r.reconcileIstioGateway(gateway))
-	rErrs.Add(r.reconcileHpa(gateway))
+	rErrs.Add(r.reconcileService(ctx, gateway))
+	rErrs.Add(r.reconcileDeployment(ctx, gateway))
+	rErrs.Add(r.reconcileIstioVirtualService(ctx, gateway))
+	rErrs.Add(r.reconcileIstioGateway(ctx, gateway))
+	rErrs.Add(r.reconcileHTTPRoute(ctx, gateway))
+	rErrs.Add(r.reconcileGRPCRoutes(ctx, gateway))
+	rErrs.Add(r.reconcileTCPRoutes(ctx, gateway))
+	rErrs.Add(r.reconcileIstioDestinationRules(ctx, gateway))
+	rErrs.Add(r.reconcileRequestAuthentication(ctx, gateway))
+	rErrs.Add(r.reconcileAuthorizationPolicy(ctx, gateway))
+	rErrs.Add(r.reconcileHpa(ctx, gateway))
 
 	// Extensions
-	rErrs.Add(r.reconcileApiPublisherConfigMap(gateway))
-	rErrs.Add(r.reconcileApiPublisherJob(gateway))
+	rErrs.Add(r.reconcileApiPublisherConfigMap(ctx, gateway))
+	rErrs.Add(r.reconcileApiPublisherJob(ctx, gateway))
 
-	rErrs.Add(r.reconcileClusterIngressSecret(gateway))
-	rErrs.Add(r.reconcileClusterIngress(gateway))
+	rErrs.Add(r.reconcileClusterIngressSecret(ctx, gateway))
+	rErrs.Add(r.reconcileClusterIngressCertificate(ctx, gateway))
+	rErrs.Add(r.reconcileClusterIngress(ctx, gateway))
 
-	rErrs.Add(r.reconcileOidcEnvoyFilter(gateway))
+	rErrs.Add(r.reconcileOidcEnvoyFilter(ctx, gateway))
 
-	rErrs.Add(r.reconcileRoutingK8sService(gateway))
+	rErrs.Add(r.reconcileRoutingK8sService(ctx, gateway))
 	// if gateway.Spec.Empty() {
 	// 	gateway.Status.Status = "Ready"
 	// 	gateway.Status.HostName = "N/A"
 	// } else {
 
-	// 	if err := r.reconcileConfigMap(gateway); err != nil {
+	// 	if err := r.reconcileConfigMap(ctx, gateway); err != nil {
 	// 		return err
 	// 	}
 
-	// 	if err := r.reconcileDeployment(gateway); err != nil {
+	// 	if err := r.reconcileDeployment(ctx, gateway); err != nil {
 	// 		return err
 	// 	}
 
-	// 	if err := r.reconcileAutoscalePolicy(gateway); err != nil {
+	// 	if err := r.reconcileAutoscalePolicy(ctx, gateway); err != nil {
 	// 		return err
 	// 	}
 
-	// 	if err := r.reconcileK8sService(gateway); err != nil {
+	// 	if err := r.reconcileK8sService(ctx, gateway); err != nil {
 	// 		return err
 	// 	}
 
 	// 	if gateway.Spec.Type != v1alpha1.GatewayTypeMicroGateway {
-	// 		if err := r.reconcileIstioVirtualService(gateway); err != nil {
+	// 		if err := r.reconcileIstioVirtualService(ctx, gateway); err != nil {
 	// 			return err
 	// 		}
 
-	// 		if err := r.reconcileIstioGateway(gateway); err != nil {
+	// 		if err := r.reconcileIstioGateway(ctx, gateway); err != nil {
 	// 			return err
 	// 		}
 	// 	}
 
 	// 	if gateway.Spec.OidcConfig != nil {
-	// 		if err := r.reconcileEnvoyFilter(gateway); err != nil {
+	// 		if err := r.reconcileEnvoyFilter(ctx, gateway); err != nil {
 	// 			return err
 	// 		}
 	// 	}
 
 	// 	if len(gateway.Spec.Host) > 0 {
 	// 		if len(gateway.Spec.Tls.Key) > 0 && len(gateway.Spec.Tls.Cert) > 0 {
-	// 			if err := r.reconcileClusterIngressSecret(gateway); err != nil {
+	// 			if err := r.reconcileClusterIngressSecret(ctx, gateway); err != nil {
 	// 				return err
 	// 			}
 	// 		}
-	// 		if err := r.reconcileClusterIngress(gateway); err != nil {
+	// 		if err := r.reconcileClusterIngress(ctx, gateway); err != nil {
 	// 			return err
 	// 		}
 	// 	}
 	// }
-	//if err := r.reconcileIstioVirtualServicesForIngress(gateway); err != nil {
+	//if err := r.reconcileIstioVirtualServicesForIngress(ctx, gateway); err != nil {
 	//	return err
 	//}
 
-	// if err := r.reconcileIstioDestinationRules(gateway); err != nil {
+	// if err := r.reconcileIstioDestinationRules(ctx, gateway); err != nil {
 	// 	return err
 	// }
 	if !rErrs.Empty() {
@@ -273,11 +465,81 @@ func (r *reconciler) reconcile(gateway *v1alpha2.Gateway) error {
 	return nil
 }
 
-func (r *reconciler) reconcileService(gateway *v1alpha2.Gateway) error {
+// isSuspended returns true if the gateway or the Cell or the Composite which controls it is suspended.
+func (r *reconciler) isSuspended(gateway *v1alpha2.Gateway) bool {
+	return controller.IsSuspended(gateway) || controller.IsOwnerSuspended(gateway, r.cellLister, r.compositeLister)
+}
+
+// prepare applies the active scaling schedule, the defaults and the AutoscaleOverride to the gateway
+// before its child resources are built. Both the reconcile and Inspect prepare the gateway so that
+// the inspected desired state matches the reconciled one.
+func (r *reconciler) prepare(gateway *v1alpha2.Gateway, recorder record.EventRecorder) (controller.Result, error) {
+	result := r.applySchedule(gateway, recorder)
+	gateway.Default()
+	if err := r.applyAutoscaleOverride(gateway, recorder); err != nil {
+		return controller.Result{}, err
+	}
+	return result, nil
+}
+
+// applySchedule applies the active scaling schedule to the scaling policy of the gateway and returns
+// the result which requeues the gateway when the active schedule changes.
+func (r *reconciler) applySchedule(gateway *v1alpha2.Gateway, recorder record.EventRecorder) controller.Result {
+	active, next, err := controller.ActiveSchedule(gateway.Spec.ScalingPolicy.Schedules, time.Now())
+	if err != nil {
+		recorder.Eventf(gateway, corev1.EventTypeWarning, "InvalidSchedule", "Failed to evaluate the scaling schedules: %v", err)
+		return controller.Result{}
+	}
+	var activeSchedule string
+	if active != nil {
+		activeSchedule = active.Name
+		gateway.Spec.ScalingPolicy.ApplySchedule(active)
+	}
+	if activeSchedule != gateway.Status.ActiveSchedule {
+		if len(activeSchedule) > 0 {
+			recorder.Eventf(gateway, corev1.EventTypeNormal, "ScheduleActivated", "Applied scaling schedule %q", activeSchedule)
+		} else {
+			recorder.Eventf(gateway, corev1.EventTypeNormal, "ScheduleDeactivated", "Removed scaling schedule %q", gateway.Status.ActiveSchedule)
+		}
+		gateway.Status.ActiveSchedule = activeSchedule
+	}
+	return controller.Result{RequeueAfter: next}
+}
+
+// applyAutoscaleOverride merges the AutoscaleOverride which targets the gateway into its HPA unless
+// the HPA is not overridable.
+func (r *reconciler) applyAutoscaleOverride(gateway *v1alpha2.Gateway, recorder record.EventRecorder) error {
+	override, err := controller.GetAutoscaleOverride(gateway, "Gateway", r.autoscaleOverrideLister)
+	if err != nil {
+		r.logger.Errorf("Failed to retrieve the AutoscaleOverrides of Gateway %q: %v", gateway.Name, err)
+		return err
+	}
+	hpa := gateway.Spec.ScalingPolicy.Hpa
+	if override == nil || hpa == nil || !hpa.IsOverridable() {
+		if len(gateway.Status.AutoscaleOverride) > 0 {
+			recorder.Eventf(gateway, corev1.EventTypeNormal, "AutoscaleOverrideRemoved", "Removed AutoscaleOverride %q", gateway.Status.AutoscaleOverride)
+		}
+		gateway.Status.AutoscaleOverride = ""
+		gateway.Status.AutoscaleOverrideGeneration = 0
+		return nil
+	}
+	override.DeepCopy().ApplyTo(hpa)
+	if override.Name != gateway.Status.AutoscaleOverride || override.Generation != gateway.Status.AutoscaleOverrideGeneration {
+		recorder.Eventf(gateway, corev1.EventTypeNormal, "AutoscaleOverrideApplied", "Applied AutoscaleOverride %q", override.Name)
+	}
+	gateway.Status.AutoscaleOverride = override.Name
+	gateway.Status.AutoscaleOverrideGeneration = override.Generation
+	return nil
+}
+
+func (r *reconciler) reconcileService(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
 	serviceName := resources.ServiceName(gateway)
+	_, span := controller.StartStepSpan(ctx, "Service", serviceName)
+	defer func() { span.Finish(err) }()
 	service, err := r.serviceLister.Services(gateway.Namespace).Get(serviceName)
 	if !resources.RequireService(gateway) {
 		if err == nil && metav1.IsControlledBy(service, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.CoreV1().Services(gateway.Namespace).Delete(serviceName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete Service %q: %v", serviceName, err)
@@ -289,6 +551,7 @@ func (r *reconciler) reconcileService(gateway *v1alpha2.Gateway) error {
 	}
 
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		service, err = r.kubeClient.CoreV1().Services(gateway.Namespace).Create(resources.MakeService(gateway))
 		if err != nil {
 			r.logger.Errorf("Failed to create Service %q: %v", serviceName, err)
@@ -299,32 +562,45 @@ func (r *reconciler) reconcileService(gateway *v1alpha2.Gateway) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Service %q: %v", serviceName, err)
 		return err
-	} else if !metav1.IsControlledBy(service, gateway) {
-		return fmt.Errorf("gateway: %q does not own the Service: %q", gateway.Name, serviceName)
+	} else if !metav1.IsControlledBy(service, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), service) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the Service: %q", gateway.Name, serviceName))
 	} else {
+		adopt := !metav1.IsControlledBy(service, gateway)
 		service, err = func(gateway *v1alpha2.Gateway, service *corev1.Service) (*corev1.Service, error) {
-			if !resources.RequireServiceUpdate(gateway, service) {
+			if !adopt && !resources.RequireServiceUpdate(gateway, service) {
+				controller.SetAction(span, controller.ActionSkip)
 				return service, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredService := resources.MakeService(gateway)
 			existingService := service.DeepCopy()
 			resources.CopyService(desiredService, existingService)
+			if adopt {
+				controller.Adopt(existingService, desiredService)
+			}
 			return r.kubeClient.CoreV1().Services(gateway.Namespace).Update(existingService)
 		}(gateway, service)
 		if err != nil {
 			r.logger.Errorf("Failed to update Service %q: %v", serviceName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(gateway, "Service", serviceName)
+		}
 	}
 	resources.StatusFromService(gateway, service)
 	return nil
 }
 
-func (r *reconciler) reconcileDeployment(gateway *v1alpha2.Gateway) error {
+func (r *reconciler) reconcileDeployment(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
 	deploymentName := resources.DeploymentName(gateway)
+	_, span := controller.StartStepSpan(ctx, "Deployment", deploymentName)
+	defer func() { span.Finish(err) }()
 	deployment, err := r.deploymentLister.Deployments(gateway.Namespace).Get(deploymentName)
+	suspended := r.isSuspended(gateway)
 	if !resources.RequireDeployment(gateway) {
 		if err == nil && metav1.IsControlledBy(deployment, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.kubeClient.AppsV1().Deployments(gateway.Namespace).Delete(deploymentName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete Deployment %q: %v", deploymentName, err)
@@ -336,11 +612,15 @@ func (r *reconciler) reconcileDeployment(gateway *v1alpha2.Gateway) error {
 	}
 
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		deployment, err = func(gateway *v1alpha2.Gateway) (*appsv1.Deployment, error) {
-			desiredDeployment, err := resources.MakeDeployment(gateway, r.cfg)
+			desiredDeployment, err := resources.MakeDeployment(gateway, r.cfg.ForNamespace(gateway.Namespace))
 			if err != nil {
 				return nil, err
 			}
+			if suspended {
+				desiredDeployment.Spec.Replicas = controller.SuspendReplicas(desiredDeployment, desiredDeployment.Spec.Replicas, desiredDeployment)
+			}
 			return r.kubeClient.AppsV1().Deployments(gateway.Namespace).Create(desiredDeployment)
 		}(gateway)
 		if err != nil {
@@ -352,35 +632,51 @@ func (r *reconciler) reconcileDeployment(gateway *v1alpha2.Gateway) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Deployment %q: %v", deploymentName, err)
 		return err
-	} else if !metav1.IsControlledBy(deployment, gateway) {
-		return fmt.Errorf("gateway: %q does not own the Deployment: %q", gateway.Name, deploymentName)
+	} else if !metav1.IsControlledBy(deployment, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), deployment) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the Deployment: %q", gateway.Name, deploymentName))
 	} else {
+		adopt := !metav1.IsControlledBy(deployment, gateway)
 		deployment, err = func(gateway *v1alpha2.Gateway, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
-			if !resources.RequireDeploymentUpdate(gateway, deployment) {
+			if !adopt && !resources.RequireDeploymentUpdate(gateway, deployment) && suspended == controller.IsWorkloadSuspended(deployment) &&
+				controller.ConfigHashEqual(deployment, r.cfg.ForNamespace(gateway.Namespace).Hash()) {
+				controller.SetAction(span, controller.ActionSkip)
 				return deployment, nil
 			}
-			desiredDeployment, err := resources.MakeDeployment(gateway, r.cfg)
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredDeployment, err := resources.MakeDeployment(gateway, r.cfg.ForNamespace(gateway.Namespace))
 			if err != nil {
 				return nil, err
 			}
 			existingDeployment := deployment.DeepCopy()
 			resources.CopyDeployment(desiredDeployment, existingDeployment)
+			if suspended {
+				existingDeployment.Spec.Replicas = controller.SuspendReplicas(deployment, deployment.Spec.Replicas, existingDeployment)
+			}
+			if adopt {
+				controller.Adopt(existingDeployment, desiredDeployment)
+			}
 			return r.kubeClient.AppsV1().Deployments(gateway.Namespace).Update(existingDeployment)
 		}(gateway, deployment)
 		if err != nil {
 			r.logger.Errorf("Failed to update Deployment %q: %v", deploymentName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(gateway, "Deployment", deploymentName)
+		}
 	}
 	resources.StatusFromDeployment(gateway, deployment)
 	return nil
 }
 
-func (r *reconciler) reconcileIstioGateway(gateway *v1alpha2.Gateway) error {
+func (r *reconciler) reconcileIstioGateway(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
 	istioGatewayName := resources.IstioGatewayName(gateway)
+	_, span := controller.StartStepSpan(ctx, "IstioGateway", istioGatewayName)
+	defer func() { span.Finish(err) }()
 	istioGateway, err := r.istioGatewayLister.Gateways(gateway.Namespace).Get(istioGatewayName)
 	if !resources.RequireIstioGateway(gateway) {
 		if err == nil && metav1.IsControlledBy(istioGateway, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.meshClient.NetworkingV1alpha3().Gateways(gateway.Namespace).Delete(istioGatewayName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete Istio Gateway %q: %v", istioGatewayName, err)
@@ -391,6 +687,7 @@ func (r *reconciler) reconcileIstioGateway(gateway *v1alpha2.Gateway) error {
 	}
 
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		istioGateway, err = r.meshClient.NetworkingV1alpha3().Gateways(gateway.Namespace).Create(resources.MakeIstioGateway(gateway))
 		if err != nil {
 			r.logger.Errorf("Failed to create Istio Gateway %q: %v", istioGatewayName, err)
@@ -401,32 +698,44 @@ func (r *reconciler) reconcileIstioGateway(gateway *v1alpha2.Gateway) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Istio Gateway %q: %v", istioGatewayName, err)
 		return err
-	} else if !metav1.IsControlledBy(istioGateway, gateway) {
-		return fmt.Errorf("gateway: %q does not own the Istio Gateway: %q", gateway.Name, istioGatewayName)
+	} else if !metav1.IsControlledBy(istioGateway, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), istioGateway) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the Istio Gateway: %q", gateway.Name, istioGatewayName))
 	} else {
+		adopt := !metav1.IsControlledBy(istioGateway, gateway)
 		istioGateway, err = func(gateway *v1alpha2.Gateway, istioGateway *istionetworkingv1alpha3.Gateway) (*istionetworkingv1alpha3.Gateway, error) {
-			if !resources.RequireIstioGatewayUpdate(gateway, istioGateway) {
+			if !adopt && !resources.RequireIstioGatewayUpdate(gateway, istioGateway) {
+				controller.SetAction(span, controller.ActionSkip)
 				return istioGateway, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredIstioGateway := resources.MakeIstioGateway(gateway)
 			existingIstioGateway := istioGateway.DeepCopy()
 			resources.CopyIstioGateway(desiredIstioGateway, existingIstioGateway)
+			if adopt {
+				controller.Adopt(existingIstioGateway, desiredIstioGateway)
+			}
 			return r.meshClient.NetworkingV1alpha3().Gateways(gateway.Namespace).Update(existingIstioGateway)
 		}(gateway, istioGateway)
 		if err != nil {
 			r.logger.Errorf("Failed to update Istio Gateway %q: %v", istioGatewayName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(gateway, "Gateway", istioGatewayName)
+		}
 	}
 	resources.StatusFromIstioGateway(gateway, istioGateway)
 	return nil
 }
 
-func (r *reconciler) reconcileIstioVirtualService(gateway *v1alpha2.Gateway) error {
+func (r *reconciler) reconcileIstioVirtualService(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
 	virtualServiceName := resources.IstioVirtualServiceName(gateway)
+	_, span := controller.StartStepSpan(ctx, "IstioVirtualService", virtualServiceName)
+	defer func() { span.Finish(err) }()
 	virtualService, err := r.istioVirtualServiceLister.VirtualServices(gateway.Namespace).Get(virtualServiceName)
 	if !resources.RequireVirtualService(gateway) {
 		if err == nil && metav1.IsControlledBy(virtualService, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.meshClient.NetworkingV1alpha3().VirtualServices(gateway.Namespace).Delete(virtualServiceName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete VirtualService %q: %v", virtualServiceName, err)
@@ -437,6 +746,7 @@ func (r *reconciler) reconcileIstioVirtualService(gateway *v1alpha2.Gateway) err
 	}
 
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		virtualService, err = r.meshClient.NetworkingV1alpha3().VirtualServices(gateway.Namespace).Create(resources.MakeVirtualService(gateway))
 		if err != nil {
 			r.logger.Errorf("Failed to create VirtualService %q: %v", virtualServiceName, err)
@@ -447,27 +757,172 @@ func (r *reconciler) reconcileIstioVirtualService(gateway *v1alpha2.Gateway) err
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve VirtualService %q: %v", virtualServiceName, err)
 		return err
-	} else if !metav1.IsControlledBy(virtualService, gateway) {
-		return fmt.Errorf("gateway: %q does not own the VirtualService: %q", gateway.Name, virtualServiceName)
+	} else if !metav1.IsControlledBy(virtualService, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), virtualService) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the VirtualService: %q", gateway.Name, virtualServiceName))
 	} else {
+		adopt := !metav1.IsControlledBy(virtualService, gateway)
 		virtualService, err = func(gateway *v1alpha2.Gateway, virtualService *istionetworkingv1alpha3.VirtualService) (*istionetworkingv1alpha3.VirtualService, error) {
-			if !resources.RequireVirtualServiceUpdate(gateway, virtualService) {
+			if !adopt && !resources.RequireVirtualServiceUpdate(gateway, virtualService) {
+				controller.SetAction(span, controller.ActionSkip)
 				return virtualService, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredVirtualService := resources.MakeVirtualService(gateway)
 			existingVirtualService := virtualService.DeepCopy()
 			resources.CopyVirtualService(desiredVirtualService, existingVirtualService)
+			if adopt {
+				controller.Adopt(existingVirtualService, desiredVirtualService)
+			}
 			return r.meshClient.NetworkingV1alpha3().VirtualServices(gateway.Namespace).Update(existingVirtualService)
 		}(gateway, virtualService)
 		if err != nil {
 			r.logger.Errorf("Failed to update VirtualService %q: %v", virtualServiceName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(gateway, "VirtualService", virtualServiceName)
+		}
 	}
 	resources.StatusFromVirtualService(gateway, virtualService)
 	return nil
 }
 
+func (r *reconciler) reconcileRequestAuthentication(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
+	requestAuthenticationName := resources.RequestAuthenticationName(gateway)
+	_, span := controller.StartStepSpan(ctx, "RequestAuthentication", requestAuthenticationName)
+	defer func() { span.Finish(err) }()
+	if !r.istioSecurityAvailable {
+		if resources.RequireRequestAuthentication(gateway, r.cfg.ForNamespace(gateway.Namespace)) {
+			return controller.NewPermanentError(fmt.Errorf("gateway: %q requires RequestAuthentications which are not served by the cluster, "+
+				"the configured istio-version must match the installed istio version", gateway.Name))
+		}
+		controller.SetAction(span, controller.ActionSkip)
+		return nil
+	}
+	requestAuthentication, err := r.istioRequestAuthenticationLister.RequestAuthentications(gateway.Namespace).Get(requestAuthenticationName)
+	if !resources.RequireRequestAuthentication(gateway, r.cfg.ForNamespace(gateway.Namespace)) {
+		if err == nil && metav1.IsControlledBy(requestAuthentication, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.SecurityV1beta1().RequestAuthentications(gateway.Namespace).Delete(requestAuthenticationName, &metav1.DeleteOptions{})
+			if err != nil {
+				r.logger.Errorf("Failed to delete RequestAuthentication %q: %v", requestAuthenticationName, err)
+				return err
+			}
+		}
+		gateway.Status.RequestAuthenticationGeneration = 0
+		return nil
+	}
+
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		requestAuthentication, err = r.meshClient.SecurityV1beta1().RequestAuthentications(gateway.Namespace).Create(resources.MakeRequestAuthentication(gateway, r.cfg.ForNamespace(gateway.Namespace)))
+		if err != nil {
+			r.logger.Errorf("Failed to create RequestAuthentication %q: %v", requestAuthenticationName, err)
+			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create RequestAuthentication %q: %v", requestAuthenticationName, err)
+			return err
+		}
+		r.recorder.Eventf(gateway, corev1.EventTypeNormal, "Created", "Created RequestAuthentication %q", requestAuthenticationName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve RequestAuthentication %q: %v", requestAuthenticationName, err)
+		return err
+	} else if !metav1.IsControlledBy(requestAuthentication, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), requestAuthentication) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the RequestAuthentication: %q", gateway.Name, requestAuthenticationName))
+	} else {
+		adopt := !metav1.IsControlledBy(requestAuthentication, gateway)
+		requestAuthentication, err = func(gateway *v1alpha2.Gateway, requestAuthentication *istiosecurityv1beta1.RequestAuthentication) (*istiosecurityv1beta1.RequestAuthentication, error) {
+			if !adopt && !resources.RequireRequestAuthenticationUpdate(gateway, requestAuthentication) {
+				controller.SetAction(span, controller.ActionSkip)
+				return requestAuthentication, nil
+			}
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredRequestAuthentication := resources.MakeRequestAuthentication(gateway, r.cfg.ForNamespace(gateway.Namespace))
+			existingRequestAuthentication := requestAuthentication.DeepCopy()
+			resources.CopyRequestAuthentication(desiredRequestAuthentication, existingRequestAuthentication)
+			if adopt {
+				controller.Adopt(existingRequestAuthentication, desiredRequestAuthentication)
+			}
+			return r.meshClient.SecurityV1beta1().RequestAuthentications(gateway.Namespace).Update(existingRequestAuthentication)
+		}(gateway, requestAuthentication)
+		if err != nil {
+			r.logger.Errorf("Failed to update RequestAuthentication %q: %v", requestAuthenticationName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(gateway, "RequestAuthentication", requestAuthenticationName)
+		}
+	}
+	resources.StatusFromRequestAuthentication(gateway, requestAuthentication)
+	return nil
+}
+
+func (r *reconciler) reconcileAuthorizationPolicy(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
+	authorizationPolicyName := resources.AuthorizationPolicyName(gateway)
+	_, span := controller.StartStepSpan(ctx, "AuthorizationPolicy", authorizationPolicyName)
+	defer func() { span.Finish(err) }()
+	if !r.istioSecurityAvailable {
+		if resources.RequireAuthorizationPolicy(gateway, r.cfg.ForNamespace(gateway.Namespace)) {
+			return controller.NewPermanentError(fmt.Errorf("gateway: %q requires AuthorizationPolicies which are not served by the cluster, "+
+				"the configured istio-version must match the installed istio version", gateway.Name))
+		}
+		controller.SetAction(span, controller.ActionSkip)
+		return nil
+	}
+	authorizationPolicy, err := r.istioAuthorizationPolicyLister.AuthorizationPolicies(gateway.Namespace).Get(authorizationPolicyName)
+	if !resources.RequireAuthorizationPolicy(gateway, r.cfg.ForNamespace(gateway.Namespace)) {
+		if err == nil && metav1.IsControlledBy(authorizationPolicy, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.SecurityV1beta1().AuthorizationPolicies(gateway.Namespace).Delete(authorizationPolicyName, &metav1.DeleteOptions{})
+			if err != nil {
+				r.logger.Errorf("Failed to delete AuthorizationPolicy %q: %v", authorizationPolicyName, err)
+				return err
+			}
+		}
+		gateway.Status.AuthorizationPolicyGeneration = 0
+		return nil
+	}
+
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		authorizationPolicy, err = r.meshClient.SecurityV1beta1().AuthorizationPolicies(gateway.Namespace).Create(resources.MakeAuthorizationPolicy(gateway))
+		if err != nil {
+			r.logger.Errorf("Failed to create AuthorizationPolicy %q: %v", authorizationPolicyName, err)
+			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create AuthorizationPolicy %q: %v", authorizationPolicyName, err)
+			return err
+		}
+		r.recorder.Eventf(gateway, corev1.EventTypeNormal, "Created", "Created AuthorizationPolicy %q", authorizationPolicyName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve AuthorizationPolicy %q: %v", authorizationPolicyName, err)
+		return err
+	} else if !metav1.IsControlledBy(authorizationPolicy, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), authorizationPolicy) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the AuthorizationPolicy: %q", gateway.Name, authorizationPolicyName))
+	} else {
+		adopt := !metav1.IsControlledBy(authorizationPolicy, gateway)
+		authorizationPolicy, err = func(gateway *v1alpha2.Gateway, authorizationPolicy *istiosecurityv1beta1.AuthorizationPolicy) (*istiosecurityv1beta1.AuthorizationPolicy, error) {
+			if !adopt && !resources.RequireAuthorizationPolicyUpdate(gateway, authorizationPolicy) {
+				controller.SetAction(span, controller.ActionSkip)
+				return authorizationPolicy, nil
+			}
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredAuthorizationPolicy := resources.MakeAuthorizationPolicy(gateway)
+			existingAuthorizationPolicy := authorizationPolicy.DeepCopy()
+			resources.CopyAuthorizationPolicy(desiredAuthorizationPolicy, existingAuthorizationPolicy)
+			if adopt {
+				controller.Adopt(existingAuthorizationPolicy, desiredAuthorizationPolicy)
+			}
+			return r.meshClient.SecurityV1beta1().AuthorizationPolicies(gateway.Namespace).Update(existingAuthorizationPolicy)
+		}(gateway, authorizationPolicy)
+		if err != nil {
+			r.logger.Errorf("Failed to update AuthorizationPolicy %q: %v", authorizationPolicyName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(gateway, "AuthorizationPolicy", authorizationPolicyName)
+		}
+	}
+	resources.StatusFromAuthorizationPolicy(gateway, authorizationPolicy)
+	return nil
+}
+
 // func (r *reconciler) reconcileConfigMap(gateway *v1alpha2.Gateway) error {
 // 	configMap, err := r.configMapLister.ConfigMaps(gateway.Namespace).Get(resources.ApiPublisherConfigMap(gateway))
 // 	if errors.IsNotFound(err) {
@@ -552,7 +1007,10 @@ func (r *reconciler) reconcileIstioVirtualService(gateway *v1alpha2.Gateway) err
 // 	return nil
 // }
 
-func (r *reconciler) reconcileRoutingK8sService(gateway *v1alpha2.Gateway) error {
+func (r *reconciler) reconcileRoutingK8sService(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
+	_, span := controller.StartStepSpan(ctx, "Service", gateway.Annotations[meta.CellOriginalGatewaySvcKey])
+	defer func() { span.Finish(err) }()
+
 	// This is a workaround for an issue with switching traffic 100% to a new instance, and terminating the old one.
 	// When the old instance is terminated, the associated gateway k8s service will be deleted as well. Since the
 	// Istio Virtual Service uses that particular gateway k8s service name as a hostname, once its deleted the DNS
@@ -565,6 +1023,7 @@ func (r *reconciler) reconcileRoutingK8sService(gateway *v1alpha2.Gateway) error
 	if originalGwK8sSvcName != "" {
 		k8sService, err := r.serviceLister.Services(gateway.Namespace).Get(originalGwK8sSvcName)
 		if errors.IsNotFound(err) {
+			controller.SetAction(span, controller.ActionCreate)
 			k8sService, err = r.kubeClient.CoreV1().Services(gateway.Namespace).Create(resources.MakeOriginalGatewayK8sService(gateway, originalGwK8sSvcName))
 			if err != nil {
 				r.logger.Errorf("Failed to create K8s service for original gateway %v", err)
@@ -625,22 +1084,107 @@ func (r *reconciler) reconcileRoutingK8sService(gateway *v1alpha2.Gateway) error
 // 	return nil
 // }
 
-// func (r *reconciler) reconcileIstioDestinationRules(gateway *v1alpha2.Gateway) error {
-// 	istioDestinationRule, err := r.istioDestinationRuleLister.DestinationRules(gateway.Namespace).Get(resources.IstioDestinationRuleName(gateway))
-// 	if errors.IsNotFound(err) {
-// 		istioDestinationRule, err = r.meshClient.NetworkingV1alpha3().DestinationRules(gateway.Namespace).Create(
-// 			resources.CreateIstioDestinationRule(gateway))
-// 		if err != nil {
-// 			r.logger.Errorf("Failed to create destination rule %v", err)
-// 			return err
-// 		}
-// 	} else if err != nil {
-// 		return err
-// 	}
-// 	r.logger.Debugf("Istio destination rule created %+v", istioDestinationRule)
+// reconcileIstioDestinationRules reconciles a DestinationRule for each destination host of the routes which
+// has a traffic policy and deletes the DestinationRules of the hosts which no longer have one.
+func (r *reconciler) reconcileIstioDestinationRules(ctx context.Context, gateway *v1alpha2.Gateway) error {
+	desired := make(map[string]bool)
+	if resources.RequireIstioDestinationRules(gateway) {
+		destinationRules, err := resources.MakeIstioDestinationRules(gateway, r.cfg.ForNamespace(gateway.Namespace))
+		if err != nil {
+			return controller.NewPermanentError(err)
+		}
+		for _, destinationRule := range destinationRules {
+			desired[destinationRule.Name] = true
+			if err := r.reconcileIstioDestinationRule(ctx, gateway, destinationRule); err != nil {
+				return err
+			}
+		}
+	}
 
-// 	return nil
-// }
+	existing, err := r.istioDestinationRuleLister.DestinationRules(gateway.Namespace).List(makeGatewaySelector(gateway))
+	if err != nil {
+		r.logger.Errorf("Failed to list the DestinationRules of gateway %q: %v", gateway.Name, err)
+		return err
+	}
+	for _, destinationRule := range existing {
+		if desired[destinationRule.Name] || !metav1.IsControlledBy(destinationRule, gateway) {
+			continue
+		}
+		err = r.meshClient.NetworkingV1alpha3().DestinationRules(gateway.Namespace).Delete(destinationRule.Name, &metav1.DeleteOptions{})
+		if err != nil {
+			r.logger.Errorf("Failed to delete DestinationRule %q: %v", destinationRule.Name, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) reconcileIstioDestinationRule(ctx context.Context, gateway *v1alpha2.Gateway, desiredDestinationRule *istionetworkingv1alpha3.DestinationRule) (err error) {
+	destinationRuleName := desiredDestinationRule.Name
+	_, span := controller.StartStepSpan(ctx, "DestinationRule", destinationRuleName)
+	defer func() { span.Finish(err) }()
+	conflicting, err := controller.ConflictingDestinationRule(r.istioDestinationRuleLister, gateway, destinationRuleName, desiredDestinationRule.Spec.Host)
+	if err != nil {
+		r.logger.Errorf("Failed to list the DestinationRules of host %q: %v", desiredDestinationRule.Spec.Host, err)
+		return err
+	}
+	destinationRule, err := r.istioDestinationRuleLister.DestinationRules(gateway.Namespace).Get(destinationRuleName)
+	if conflicting != nil {
+		// Remove the DestinationRule of the gateway if it lost to an older one of the host
+		if err == nil && metav1.IsControlledBy(destinationRule, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.NetworkingV1alpha3().DestinationRules(gateway.Namespace).Delete(destinationRuleName, &metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				r.logger.Errorf("Failed to delete DestinationRule %q: %v", destinationRuleName, err)
+				return err
+			}
+		}
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q cannot apply the traffic policy of the host %q which is applied by the DestinationRule: %q",
+			gateway.Name, desiredDestinationRule.Spec.Host, conflicting.Name))
+	}
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		_, err = r.meshClient.NetworkingV1alpha3().DestinationRules(gateway.Namespace).Create(desiredDestinationRule)
+		if err != nil {
+			r.logger.Errorf("Failed to create DestinationRule %q: %v", destinationRuleName, err)
+			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create DestinationRule %q: %v", destinationRuleName, err)
+			return err
+		}
+		r.recorder.Eventf(gateway, corev1.EventTypeNormal, "Created", "Created DestinationRule %q", destinationRuleName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve DestinationRule %q: %v", destinationRuleName, err)
+		return err
+	} else if !metav1.IsControlledBy(destinationRule, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), destinationRule) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the DestinationRule: %q", gateway.Name, destinationRuleName))
+	} else {
+		adopt := !metav1.IsControlledBy(destinationRule, gateway)
+		if !adopt && !resources.RequireIstioDestinationRuleUpdate(desiredDestinationRule, destinationRule) {
+			controller.SetAction(span, controller.ActionSkip)
+			return nil
+		}
+		controller.SetAction(span, controller.ActionUpdate)
+		existingDestinationRule := destinationRule.DeepCopy()
+		resources.CopyIstioDestinationRule(desiredDestinationRule, existingDestinationRule)
+		if adopt {
+			controller.Adopt(existingDestinationRule, desiredDestinationRule)
+		}
+		_, err = r.meshClient.NetworkingV1alpha3().DestinationRules(gateway.Namespace).Update(existingDestinationRule)
+		if err != nil {
+			r.logger.Errorf("Failed to update DestinationRule %q: %v", destinationRuleName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(gateway, "DestinationRule", destinationRuleName)
+		}
+	}
+	return nil
+}
+
+// recordAdoption records the adoption of an existing resource in the events and the status of the gateway.
+func (r *reconciler) recordAdoption(gateway *v1alpha2.Gateway, kind string, name string) {
+	r.recorder.Eventf(gateway, corev1.EventTypeNormal, "Adopted", "Adopted %s %q", kind, name)
+	gateway.Status.AdoptedResources = controller.AddAdoptedResource(gateway.Status.AdoptedResources, kind, name)
+}
 
 func (r *reconciler) updateStatus(desired *v1alpha2.Gateway) (*v1alpha2.Gateway, error) {
 	gateway, err := r.gatewayLister.Gateways(desired.Namespace).Get(desired.Name)
@@ -655,12 +1199,16 @@ func (r *reconciler) updateStatus(desired *v1alpha2.Gateway) (*v1alpha2.Gateway,
 	return desired, nil
 }
 
-func (r *reconciler) reconcileHpa(gw *v1alpha2.Gateway) error {
+func (r *reconciler) reconcileHpa(ctx context.Context, gw *v1alpha2.Gateway) (err error) {
 	hpaName := resources.HpaName(gw)
+	_, span := controller.StartStepSpan(ctx, "Hpa", hpaName)
+	defer func() { span.Finish(err) }()
 	hpa, err := r.hpaLister.HorizontalPodAutoscalers(gw.Namespace).Get(hpaName)
-	if !resources.RequireHpa(gw) {
+	// The HPA is removed while the gateway is suspended and created again on resume
+	if !resources.RequireHpa(gw) || r.isSuspended(gw) {
 		if err == nil && metav1.IsControlledBy(hpa, gw) {
-			err = r.kubeClient.AutoscalingV2beta1().HorizontalPodAutoscalers(gw.Namespace).Delete(hpaName, &metav1.DeleteOptions{})
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.AutoscalingV2().HorizontalPodAutoscalers(gw.Namespace).Delete(hpaName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete HPA %q: %v", hpaName, err)
 				return err
@@ -670,7 +1218,8 @@ func (r *reconciler) reconcileHpa(gw *v1alpha2.Gateway) error {
 	}
 
 	if errors.IsNotFound(err) {
-		hpa, err = r.kubeClient.AutoscalingV2beta1().HorizontalPodAutoscalers(gw.Namespace).Create(resources.MakeHpa(gw))
+		controller.SetAction(span, controller.ActionCreate)
+		hpa, err = r.meshClient.AutoscalingV2().HorizontalPodAutoscalers(gw.Namespace).Create(resources.MakeHpa(gw))
 		if err != nil {
 			r.logger.Errorf("Failed to create HPA %q: %v", hpaName, err)
 			r.recorder.Eventf(gw, corev1.EventTypeWarning, "CreationFailed", "Failed to create HPA %q: %v", hpaName, err)
@@ -680,22 +1229,31 @@ func (r *reconciler) reconcileHpa(gw *v1alpha2.Gateway) error {
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve HPA %q: %v", hpaName, err)
 		return err
-	} else if !metav1.IsControlledBy(hpa, gw) {
-		return fmt.Errorf("gw: %q does not own the HPA: %q", gw.Name, hpaName)
+	} else if !metav1.IsControlledBy(hpa, gw) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gw, r.cellLister, r.compositeLister), hpa) {
+		return controller.NewPermanentError(fmt.Errorf("gw: %q does not own the HPA: %q", gw.Name, hpaName))
 	} else {
-		hpa, err = func(gw *v1alpha2.Gateway, hpa *autoscalingv2beta1.HorizontalPodAutoscaler) (*autoscalingv2beta1.HorizontalPodAutoscaler, error) {
-			if !resources.RequireHpaUpdate(gw, hpa) {
+		adopt := !metav1.IsControlledBy(hpa, gw)
+		hpa, err = func(gw *v1alpha2.Gateway, hpa *autoscalingv2.HorizontalPodAutoscaler) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+			if !adopt && !resources.RequireHpaUpdate(gw, hpa) {
+				controller.SetAction(span, controller.ActionSkip)
 				return hpa, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredHpa := resources.MakeHpa(gw)
 			existingHpa := hpa.DeepCopy()
 			resources.CopyHpa(desiredHpa, existingHpa)
-			return r.kubeClient.AutoscalingV2beta1().HorizontalPodAutoscalers(gw.Namespace).Update(existingHpa)
+			if adopt {
+				controller.Adopt(existingHpa, desiredHpa)
+			}
+			return r.meshClient.AutoscalingV2().HorizontalPodAutoscalers(gw.Namespace).Update(existingHpa)
 		}(gw, hpa)
 		if err != nil {
 			r.logger.Errorf("Failed to update HPA %q: %v", hpaName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(gw, "HorizontalPodAutoscaler", hpaName)
+		}
 	}
 	resources.StatusFromHpa(gw, hpa)
 	return nil
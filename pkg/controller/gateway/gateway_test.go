@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	fakeclients "cellery.io/cellery-controller/pkg/clients/fake"
+	"cellery.io/cellery-controller/pkg/cloudevents"
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
+	fakeinformers "cellery.io/cellery-controller/pkg/informers/fake"
+	"cellery.io/cellery-controller/pkg/meta"
+	. "cellery.io/cellery-controller/pkg/testing/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/testing/table"
+)
+
+func newTestReconciler(clients *fakeclients.Clients, informers *fakeinformers.Informers, cfg config.Interface,
+	recorder record.EventRecorder) controller.Reconciler {
+	clients.Serve("security.istio.io/v1beta1", "peerauthentications", "requestauthentications", "authorizationpolicies")
+	r := newReconciler(clients, informers, cfg, &cloudevents.Deferred{}, zap.NewNop().Sugar())
+	r.recorder = recorder
+	return r
+}
+
+func testGateway(opt ...GatewayOption) *v1alpha2.Gateway {
+	return Gateway("foo", "bar", append([]GatewayOption{
+		WithGatewayHTTPRoute("/hello", true, "hello", 80),
+	}, opt...)...)
+}
+
+func TestReconcile(t *testing.T) {
+	table.Table{
+		{
+			Name: "invalid key",
+			Key:  "foo/bar/baz",
+		},
+		{
+			Name: "non existing key",
+			Key:  "bar/foo",
+		},
+		{
+			Name:    "create gateway resources",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testGateway()},
+			WantStatusUpdates: []runtime.Object{
+				testGateway(WithGatewayStatus(v1alpha2.GatewayStatus{
+					PublisherStatus: v1alpha2.PublisherCurrentStatusUnknown,
+					ServiceName:     "foo-service",
+					Status:          v1alpha2.GatewayCurrentStatusNotReady,
+				})),
+			},
+			WantEvents: []string{
+				`Normal Created Created Service "foo-service"`,
+				`Normal Created Created Deployment "foo-deployment"`,
+				`Normal Created Created VirtualService "foo"`,
+				`Normal Created Created Istio Gateway "foo"`,
+				`Normal Created Created Api Publisher ConfigMap "foo-config"`,
+				`Normal Created Created api publisher Job "foo-api-publisher"`,
+				`Normal Updated Updated Gateway status "foo"`,
+			},
+			Golden: "create-gateway-resources",
+		},
+		{
+			Name:    "pause reconciliation",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testGateway(WithGatewayAnnotation(meta.ReconcileAnnotationKey, meta.ReconcilePausedValue))},
+			WantStatusUpdates: []runtime.Object{
+				testGateway(
+					WithGatewayAnnotation(meta.ReconcileAnnotationKey, meta.ReconcilePausedValue),
+					WithGatewayStatus(v1alpha2.GatewayStatus{
+						Conditions: []v1alpha2.GatewayCondition{
+							{Type: v1alpha2.GatewayReconcilePaused, Status: corev1.ConditionTrue},
+						},
+					}),
+				),
+			},
+			WantEvents: []string{
+				`Normal ReconcilePaused Reconciliation of Gateway "foo" is paused`,
+				`Normal Updated Updated Gateway status "foo"`,
+			},
+		},
+		{
+			Name:    "deployment creation failure",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testGateway()},
+			WithReactors: []table.Reactor{{
+				Verb:     "create",
+				Resource: "deployments",
+				Reaction: func(action clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("inducing failure for create deployments")
+				},
+			}},
+			WantErr: true,
+			WantEvents: []string{
+				`Normal Created Created Service "foo-service"`,
+				`Warning CreationFailed Failed to create Deployment "foo-deployment": inducing failure for create deployments`,
+				`Normal Created Created VirtualService "foo"`,
+				`Normal Created Created Istio Gateway "foo"`,
+				`Normal Created Created Api Publisher ConfigMap "foo-config"`,
+				`Normal Created Created api publisher Job "foo-api-publisher"`,
+				"Warning InternalError Failed to update cluster: inducing failure for create deployments",
+			},
+			// The failed create is recorded as well
+			Golden: "create-gateway-resources",
+		},
+	}.Test(t, newTestReconciler)
+}
@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	gatewayapiv1 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1"
+	gatewayapiv1alpha2 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1alpha2"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/controller/gateway/resources"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+func (r *reconciler) reconcileHTTPRoute(ctx context.Context, gateway *v1alpha2.Gateway) (err error) {
+	httpRouteName := resources.HTTPRouteName(gateway)
+	_, span := controller.StartStepSpan(ctx, "HTTPRoute", httpRouteName)
+	defer func() { span.Finish(err) }()
+	if !r.gatewayAPIRoutes.HTTPRoutes {
+		if resources.RequireHTTPRoute(gateway) {
+			return controller.NewPermanentError(fmt.Errorf("gateway: %q requires HTTPRoutes which are not served by the cluster", gateway.Name))
+		}
+		return nil
+	}
+	httpRoute, err := r.httpRouteLister.HTTPRoutes(gateway.Namespace).Get(httpRouteName)
+	if !resources.RequireHTTPRoute(gateway) {
+		if err == nil && metav1.IsControlledBy(httpRoute, gateway) {
+			controller.SetAction(span, controller.ActionDelete)
+			err = r.meshClient.GatewayAPIV1().HTTPRoutes(gateway.Namespace).Delete(httpRouteName, &metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				r.logger.Errorf("Failed to delete HTTPRoute %q: %v", httpRouteName, err)
+				return err
+			}
+		}
+		return nil
+	}
+
+	desiredHTTPRoute, makeErr := resources.MakeHTTPRoute(gateway, r.cfg.ForNamespace(gateway.Namespace))
+	if makeErr != nil {
+		return controller.NewPermanentError(makeErr)
+	}
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		httpRoute, err = r.meshClient.GatewayAPIV1().HTTPRoutes(gateway.Namespace).Create(desiredHTTPRoute)
+		if err != nil {
+			r.logger.Errorf("Failed to create HTTPRoute %q: %v", httpRouteName, err)
+			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create HTTPRoute %q: %v", httpRouteName, err)
+			return err
+		}
+		r.recorder.Eventf(gateway, corev1.EventTypeNormal, "Created", "Created HTTPRoute %q", httpRouteName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve HTTPRoute %q: %v", httpRouteName, err)
+		return err
+	} else if !metav1.IsControlledBy(httpRoute, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), httpRoute) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the HTTPRoute: %q", gateway.Name, httpRouteName))
+	} else {
+		adopt := !metav1.IsControlledBy(httpRoute, gateway)
+		httpRoute, err = func(gateway *v1alpha2.Gateway, httpRoute *gatewayapiv1.HTTPRoute) (*gatewayapiv1.HTTPRoute, error) {
+			if !adopt && !resources.RequireHTTPRouteUpdate(gateway, httpRoute) {
+				controller.SetAction(span, controller.ActionSkip)
+				return httpRoute, nil
+			}
+			controller.SetAction(span, controller.ActionUpdate)
+			existingHTTPRoute := httpRoute.DeepCopy()
+			resources.CopyHTTPRoute(desiredHTTPRoute, existingHTTPRoute)
+			if adopt {
+				controller.Adopt(existingHTTPRoute, desiredHTTPRoute)
+			}
+			return r.meshClient.GatewayAPIV1().HTTPRoutes(gateway.Namespace).Update(existingHTTPRoute)
+		}(gateway, httpRoute)
+		if err != nil {
+			r.logger.Errorf("Failed to update HTTPRoute %q: %v", httpRouteName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(gateway, "HTTPRoute", httpRouteName)
+		}
+	}
+	resources.StatusFromHTTPRoute(gateway, httpRoute)
+	return nil
+}
+
+// reconcileGRPCRoutes reconciles a GRPCRoute for each gRPC route of the gateway and deletes the GRPCRoutes of
+// the routes which were removed.
+func (r *reconciler) reconcileGRPCRoutes(ctx context.Context, gateway *v1alpha2.Gateway) error {
+	if !r.gatewayAPIRoutes.GRPCRoutes {
+		if resources.RequireGRPCRoutes(gateway) {
+			return controller.NewPermanentError(fmt.Errorf("gateway: %q requires GRPCRoutes which are not served by the cluster", gateway.Name))
+		}
+		return nil
+	}
+	desired := make(map[string]bool)
+	if resources.RequireGRPCRoutes(gateway) {
+		grpcRoutes, err := resources.MakeGRPCRoutes(gateway, r.cfg.ForNamespace(gateway.Namespace))
+		if err != nil {
+			return controller.NewPermanentError(err)
+		}
+		for _, grpcRoute := range grpcRoutes {
+			desired[grpcRoute.Name] = true
+			if err := r.reconcileGRPCRoute(ctx, gateway, grpcRoute); err != nil {
+				return err
+			}
+		}
+	}
+
+	existing, err := r.grpcRouteLister.GRPCRoutes(gateway.Namespace).List(makeGatewaySelector(gateway))
+	if err != nil {
+		r.logger.Errorf("Failed to list the GRPCRoutes of gateway %q: %v", gateway.Name, err)
+		return err
+	}
+	for _, grpcRoute := range existing {
+		if desired[grpcRoute.Name] || !metav1.IsControlledBy(grpcRoute, gateway) {
+			continue
+		}
+		err = r.meshClient.GatewayAPIV1().GRPCRoutes(gateway.Namespace).Delete(grpcRoute.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			r.logger.Errorf("Failed to delete GRPCRoute %q: %v", grpcRoute.Name, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) reconcileGRPCRoute(ctx context.Context, gateway *v1alpha2.Gateway, desiredGRPCRoute *gatewayapiv1.GRPCRoute) (err error) {
+	grpcRouteName := desiredGRPCRoute.Name
+	_, span := controller.StartStepSpan(ctx, "GRPCRoute", grpcRouteName)
+	defer func() { span.Finish(err) }()
+	grpcRoute, err := r.grpcRouteLister.GRPCRoutes(gateway.Namespace).Get(grpcRouteName)
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		_, err = r.meshClient.GatewayAPIV1().GRPCRoutes(gateway.Namespace).Create(desiredGRPCRoute)
+		if err != nil {
+			r.logger.Errorf("Failed to create GRPCRoute %q: %v", grpcRouteName, err)
+			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create GRPCRoute %q: %v", grpcRouteName, err)
+			return err
+		}
+		r.recorder.Eventf(gateway, corev1.EventTypeNormal, "Created", "Created GRPCRoute %q", grpcRouteName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve GRPCRoute %q: %v", grpcRouteName, err)
+		return err
+	} else if !metav1.IsControlledBy(grpcRoute, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), grpcRoute) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the GRPCRoute: %q", gateway.Name, grpcRouteName))
+	} else {
+		adopt := !metav1.IsControlledBy(grpcRoute, gateway)
+		if !adopt && !resources.RequireGRPCRouteUpdate(gateway, desiredGRPCRoute, grpcRoute) {
+			controller.SetAction(span, controller.ActionSkip)
+			return nil
+		}
+		controller.SetAction(span, controller.ActionUpdate)
+		existingGRPCRoute := grpcRoute.DeepCopy()
+		resources.CopyGRPCRoute(desiredGRPCRoute, existingGRPCRoute)
+		if adopt {
+			controller.Adopt(existingGRPCRoute, desiredGRPCRoute)
+		}
+		_, err = r.meshClient.GatewayAPIV1().GRPCRoutes(gateway.Namespace).Update(existingGRPCRoute)
+		if err != nil {
+			r.logger.Errorf("Failed to update GRPCRoute %q: %v", grpcRouteName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(gateway, "GRPCRoute", grpcRouteName)
+		}
+	}
+	return nil
+}
+
+// reconcileTCPRoutes reconciles a TCPRoute for each TCP route of the gateway and deletes the TCPRoutes of
+// the routes which were removed.
+func (r *reconciler) reconcileTCPRoutes(ctx context.Context, gateway *v1alpha2.Gateway) error {
+	if !r.gatewayAPIRoutes.TCPRoutes {
+		if resources.RequireTCPRoutes(gateway) {
+			return controller.NewPermanentError(fmt.Errorf("gateway: %q requires TCPRoutes which are not served by the cluster", gateway.Name))
+		}
+		return nil
+	}
+	desired := make(map[string]bool)
+	if resources.RequireTCPRoutes(gateway) {
+		tcpRoutes, err := resources.MakeTCPRoutes(gateway, r.cfg.ForNamespace(gateway.Namespace))
+		if err != nil {
+			return controller.NewPermanentError(err)
+		}
+		for _, tcpRoute := range tcpRoutes {
+			desired[tcpRoute.Name] = true
+			if err := r.reconcileTCPRoute(ctx, gateway, tcpRoute); err != nil {
+				return err
+			}
+		}
+	}
+
+	existing, err := r.tcpRouteLister.TCPRoutes(gateway.Namespace).List(makeGatewaySelector(gateway))
+	if err != nil {
+		r.logger.Errorf("Failed to list the TCPRoutes of gateway %q: %v", gateway.Name, err)
+		return err
+	}
+	for _, tcpRoute := range existing {
+		if desired[tcpRoute.Name] || !metav1.IsControlledBy(tcpRoute, gateway) {
+			continue
+		}
+		err = r.meshClient.GatewayAPIV1alpha2().TCPRoutes(gateway.Namespace).Delete(tcpRoute.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			r.logger.Errorf("Failed to delete TCPRoute %q: %v", tcpRoute.Name, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) reconcileTCPRoute(ctx context.Context, gateway *v1alpha2.Gateway, desiredTCPRoute *gatewayapiv1alpha2.TCPRoute) (err error) {
+	tcpRouteName := desiredTCPRoute.Name
+	_, span := controller.StartStepSpan(ctx, "TCPRoute", tcpRouteName)
+	defer func() { span.Finish(err) }()
+	tcpRoute, err := r.tcpRouteLister.TCPRoutes(gateway.Namespace).Get(tcpRouteName)
+	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
+		_, err = r.meshClient.GatewayAPIV1alpha2().TCPRoutes(gateway.Namespace).Create(desiredTCPRoute)
+		if err != nil {
+			r.logger.Errorf("Failed to create TCPRoute %q: %v", tcpRouteName, err)
+			r.recorder.Eventf(gateway, corev1.EventTypeWarning, "CreationFailed", "Failed to create TCPRoute %q: %v", tcpRouteName, err)
+			return err
+		}
+		r.recorder.Eventf(gateway, corev1.EventTypeNormal, "Created", "Created TCPRoute %q", tcpRouteName)
+	} else if err != nil {
+		r.logger.Errorf("Failed to retrieve TCPRoute %q: %v", tcpRouteName, err)
+		return err
+	} else if !metav1.IsControlledBy(tcpRoute, gateway) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(gateway, r.cellLister, r.compositeLister), tcpRoute) {
+		return controller.NewPermanentError(fmt.Errorf("gateway: %q does not own the TCPRoute: %q", gateway.Name, tcpRouteName))
+	} else {
+		adopt := !metav1.IsControlledBy(tcpRoute, gateway)
+		if !adopt && !resources.RequireTCPRouteUpdate(gateway, desiredTCPRoute, tcpRoute) {
+			controller.SetAction(span, controller.ActionSkip)
+			return nil
+		}
+		controller.SetAction(span, controller.ActionUpdate)
+		existingTCPRoute := tcpRoute.DeepCopy()
+		resources.CopyTCPRoute(desiredTCPRoute, existingTCPRoute)
+		if adopt {
+			controller.Adopt(existingTCPRoute, desiredTCPRoute)
+		}
+		_, err = r.meshClient.GatewayAPIV1alpha2().TCPRoutes(gateway.Namespace).Update(existingTCPRoute)
+		if err != nil {
+			r.logger.Errorf("Failed to update TCPRoute %q: %v", tcpRouteName, err)
+			return err
+		}
+		if adopt {
+			r.recordAdoption(gateway, "TCPRoute", tcpRouteName)
+		}
+	}
+	return nil
+}
+
+// makeGatewaySelector selects the routes created for the gateway.
+func makeGatewaySelector(gateway *v1alpha2.Gateway) labels.Selector {
+	return labels.SelectorFromSet(map[string]string{meta.GatewayLabelKey: gateway.Name})
+}
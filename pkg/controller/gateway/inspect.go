@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package gateway
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/controller/gateway/resources"
+)
+
+// Inspect returns the desired child resources of the gateway along with the ones held by the listers.
+// The desired state of a resource which is not required by the gateway is empty.
+func (r *reconciler) Inspect(key string) ([]controller.ResourceState, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, err
+	}
+	original, err := r.gatewayLister.Gateways(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	gateway := original.DeepCopy()
+	if _, err := r.prepare(gateway, controller.DiscardEvents); err != nil {
+		return nil, err
+	}
+	cfg := r.cfg.ForNamespace(gateway.Namespace)
+
+	desiredIf := func(required bool, build func() (interface{}, error)) (interface{}, error) {
+		if !required {
+			return nil, nil
+		}
+		return build()
+	}
+
+	var states []controller.ResourceState
+
+	serviceName := resources.ServiceName(gateway)
+	desired, desiredErr := desiredIf(resources.RequireService(gateway), func() (interface{}, error) {
+		return resources.MakeService(gateway), nil
+	})
+	service, err := r.serviceLister.Services(gateway.Namespace).Get(serviceName)
+	states = append(states, controller.NewResourceState("Service", serviceName, desired, desiredErr, service, err))
+
+	deploymentName := resources.DeploymentName(gateway)
+	desired, desiredErr = desiredIf(resources.RequireDeployment(gateway), func() (interface{}, error) {
+		return resources.MakeDeployment(gateway, cfg)
+	})
+	deployment, err := r.deploymentLister.Deployments(gateway.Namespace).Get(deploymentName)
+	states = append(states, controller.NewResourceState("Deployment", deploymentName, desired, desiredErr, deployment, err))
+
+	virtualServiceName := resources.IstioVirtualServiceName(gateway)
+	desired, desiredErr = desiredIf(resources.RequireVirtualService(gateway), func() (interface{}, error) {
+		return resources.MakeVirtualService(gateway), nil
+	})
+	virtualService, err := r.istioVirtualServiceLister.VirtualServices(gateway.Namespace).Get(virtualServiceName)
+	states = append(states, controller.NewResourceState("VirtualService", virtualServiceName, desired, desiredErr, virtualService, err))
+
+	istioGatewayName := resources.IstioGatewayName(gateway)
+	desired, desiredErr = desiredIf(resources.RequireIstioGateway(gateway), func() (interface{}, error) {
+		return resources.MakeIstioGateway(gateway), nil
+	})
+	istioGateway, err := r.istioGatewayLister.Gateways(gateway.Namespace).Get(istioGatewayName)
+	states = append(states, controller.NewResourceState("IstioGateway", istioGatewayName, desired, desiredErr, istioGateway, err))
+
+	// The routes of the Gateway API are only inspected if the cluster serves them.
+	if r.gatewayAPIRoutes.HTTPRoutes {
+		httpRouteName := resources.HTTPRouteName(gateway)
+		desired, desiredErr = desiredIf(resources.RequireHTTPRoute(gateway), func() (interface{}, error) {
+			return resources.MakeHTTPRoute(gateway, cfg)
+		})
+		httpRoute, err := r.httpRouteLister.HTTPRoutes(gateway.Namespace).Get(httpRouteName)
+		states = append(states, controller.NewResourceState("HTTPRoute", httpRouteName, desired, desiredErr, httpRoute, err))
+	}
+
+	if r.gatewayAPIRoutes.GRPCRoutes && resources.RequireGRPCRoutes(gateway) {
+		grpcRoutes, desiredErr := resources.MakeGRPCRoutes(gateway, cfg)
+		if desiredErr != nil {
+			states = append(states, controller.NewResourceState("GRPCRoute", "", nil, desiredErr, nil, nil))
+		}
+		for _, grpcRoute := range grpcRoutes {
+			existing, err := r.grpcRouteLister.GRPCRoutes(gateway.Namespace).Get(grpcRoute.Name)
+			states = append(states, controller.NewResourceState("GRPCRoute", grpcRoute.Name, grpcRoute, desiredErr, existing, err))
+		}
+	}
+
+	if r.gatewayAPIRoutes.TCPRoutes && resources.RequireTCPRoutes(gateway) {
+		tcpRoutes, desiredErr := resources.MakeTCPRoutes(gateway, cfg)
+		if desiredErr != nil {
+			states = append(states, controller.NewResourceState("TCPRoute", "", nil, desiredErr, nil, nil))
+		}
+		for _, tcpRoute := range tcpRoutes {
+			existing, err := r.tcpRouteLister.TCPRoutes(gateway.Namespace).Get(tcpRoute.Name)
+			states = append(states, controller.NewResourceState("TCPRoute", tcpRoute.Name, tcpRoute, desiredErr, existing, err))
+		}
+	}
+
+	if resources.RequireIstioDestinationRules(gateway) {
+		destinationRules, desiredErr := resources.MakeIstioDestinationRules(gateway, cfg)
+		if desiredErr != nil {
+			states = append(states, controller.NewResourceState("DestinationRule", "", nil, desiredErr, nil, nil))
+		}
+		for _, destinationRule := range destinationRules {
+			existing, err := r.istioDestinationRuleLister.DestinationRules(gateway.Namespace).Get(destinationRule.Name)
+			states = append(states, controller.NewResourceState("DestinationRule", destinationRule.Name, destinationRule, desiredErr, existing, err))
+		}
+	}
+
+	hpaName := resources.HpaName(gateway)
+	desired, desiredErr = desiredIf(resources.RequireHpa(gateway) && !r.isSuspended(gateway), func() (interface{}, error) {
+		return resources.MakeHpa(gateway), nil
+	})
+	hpa, err := r.hpaLister.HorizontalPodAutoscalers(gateway.Namespace).Get(hpaName)
+	states = append(states, controller.NewResourceState("HorizontalPodAutoscaler", hpaName, desired, desiredErr, hpa, err))
+
+	configMapName := resources.ApiPublisherConfigMap(gateway)
+	desired, desiredErr = desiredIf(resources.IsApiPublishingRequired(gateway), func() (interface{}, error) {
+		return resources.CreateGatewayConfigMap(gateway, cfg)
+	})
+	configMap, err := r.configMapLister.ConfigMaps(gateway.Namespace).Get(configMapName)
+	states = append(states, controller.NewResourceState("ConfigMap", configMapName, desired, desiredErr, configMap, err))
+
+	jobName := resources.JobName(gateway)
+	desired, desiredErr = desiredIf(resources.RequireApiPublisherJob(gateway), func() (interface{}, error) {
+		return resources.MakeApiPublisherJob(gateway, cfg), nil
+	})
+	job, err := r.jobLister.Jobs(gateway.Namespace).Get(jobName)
+	states = append(states, controller.NewResourceState("Job", jobName, desired, desiredErr, job, err))
+
+	secretName := resources.ClusterIngressSecretName(gateway)
+	desired, desiredErr = desiredIf(resources.RequireClusterIngressSecret(gateway), func() (interface{}, error) {
+		secret, err := resources.MakeClusterIngressSecret(gateway, cfg)
+		return controller.RedactSecret(secret), err
+	})
+	secret, err := r.secretLister.Secrets(gateway.Namespace).Get(secretName)
+	states = append(states, controller.NewResourceState("Secret", secretName, desired, desiredErr, controller.RedactSecret(secret), err))
+
+	if r.certificatesAvailable {
+		certificateName := resources.ClusterIngressCertificateName(gateway)
+		desired, desiredErr = desiredIf(resources.RequireClusterIngressCertificate(gateway), func() (interface{}, error) {
+			return resources.MakeClusterIngressCertificate(gateway, cfg)
+		})
+		certificate, err := r.certificateLister.Certificates(gateway.Namespace).Get(certificateName)
+		states = append(states, controller.NewResourceState("Certificate", certificateName, desired, desiredErr, certificate, err))
+	}
+
+	ingressName := resources.ClusterIngressName(gateway)
+	if r.useIngressV1 {
+		desired, desiredErr = desiredIf(resources.RequireClusterIngress(gateway), func() (interface{}, error) {
+			return resources.MakeClusterIngress(gateway), nil
+		})
+		ingress, err := r.clusterIngressLister.Ingresses(gateway.Namespace).Get(ingressName)
+		states = append(states, controller.NewResourceState("Ingress", ingressName, desired, desiredErr, ingress, err))
+	} else {
+		desired, desiredErr = desiredIf(resources.RequireClusterIngress(gateway), func() (interface{}, error) {
+			return resources.MakeLegacyClusterIngress(gateway), nil
+		})
+		ingress, err := r.legacyClusterIngressLister.Ingresses(gateway.Namespace).Get(ingressName)
+		states = append(states, controller.NewResourceState("Ingress", ingressName, desired, desiredErr, ingress, err))
+	}
+
+	// The security.istio.io API is only inspected if the cluster serves it.
+	if r.istioSecurityAvailable {
+		requestAuthenticationName := resources.RequestAuthenticationName(gateway)
+		desired, desiredErr = desiredIf(resources.RequireRequestAuthentication(gateway, cfg), func() (interface{}, error) {
+			return resources.MakeRequestAuthentication(gateway, cfg), nil
+		})
+		requestAuthentication, err := r.istioRequestAuthenticationLister.RequestAuthentications(gateway.Namespace).Get(requestAuthenticationName)
+		states = append(states, controller.NewResourceState("RequestAuthentication", requestAuthenticationName, desired, desiredErr, requestAuthentication, err))
+
+		authorizationPolicyName := resources.AuthorizationPolicyName(gateway)
+		desired, desiredErr = desiredIf(resources.RequireAuthorizationPolicy(gateway, cfg), func() (interface{}, error) {
+			return resources.MakeAuthorizationPolicy(gateway), nil
+		})
+		authorizationPolicy, err := r.istioAuthorizationPolicyLister.AuthorizationPolicies(gateway.Namespace).Get(authorizationPolicyName)
+		states = append(states, controller.NewResourceState("AuthorizationPolicy", authorizationPolicyName, desired, desiredErr, authorizationPolicy, err))
+	}
+
+	envoyFilterName := resources.OidcEnvoyFilterName(gateway)
+	desired, desiredErr = desiredIf(resources.RequireOidcEnvoyFilter(gateway), func() (interface{}, error) {
+		return resources.MakeOidcEnvoyFilter(gateway, cfg), nil
+	})
+	envoyFilter, err := r.istioEnvoyFilterLister.EnvoyFilters(gateway.Namespace).Get(envoyFilterName)
+	states = append(states, controller.NewResourceState("EnvoyFilter", envoyFilterName, desired, desiredErr, envoyFilter, err))
+
+	return states, nil
+}
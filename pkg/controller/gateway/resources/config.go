@@ -71,6 +71,9 @@ func CreateGatewayConfigMap(gateway *v1alpha2.Gateway, cfg config.Interface) (*c
 			Name:      ApiPublisherConfigMap(gateway),
 			Namespace: gateway.Namespace,
 			Labels:    makeLabels(gateway),
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: cfg.Hash(),
+			},
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateGatewayOwnerRef(gateway),
 			},
@@ -25,108 +25,112 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"cellery.io/cellery-controller/pkg/apis/mesh"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
-	"cellery.io/cellery-controller/pkg/controller/gateway/config"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
 func TestCreateGatewayConfigMap(t *testing.T) {
+	withoutSpec := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo",
+		},
+	}
+	withSpec := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo--gateway",
+			Labels: map[string]string{
+				meta.CellLabelKey: "foo",
+				"my-label-key":    "my-label-value",
+			},
+		},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				HTTPRoutes: []v1alpha2.HTTPRoute{
+					{
+						Context:      "/context-1",
+						Global:       true,
+						Authenticate: true,
+						Port:         80,
+						Destination:  v1alpha2.Destination{Host: "my-service", Port: 8080},
+						Definitions: []v1alpha2.APIDefinition{
+							{
+								Path:   "path1",
+								Method: "GET",
+							},
+							{
+								Path:   "path2",
+								Method: "POST",
+							},
+						},
+					},
+				},
+				IngressExtensions: v1alpha2.IngressExtensions{
+					ApiPublisher: &v1alpha2.ApiPublisherConfig{
+						Context: "foo",
+						Version: "1.0.0",
+					},
+				},
+			},
+		},
+	}
+
 	tests := []struct {
 		name    string
-		gateway *v1alpha1.Gateway
-		config  config.Gateway
+		gateway *v1alpha2.Gateway
+		config  map[string]string
 		want    *corev1.ConfigMap
 	}{
 		{
-			name: "foo gateway without spec",
-			gateway: &v1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-			},
-			config: config.Gateway{},
+			name:    "foo gateway without spec",
+			gateway: withoutSpec,
 			want: &corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "foo-namespace",
 					Name:      "foo-config",
-					Labels: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
+					Labels:    makeLabels(withoutSpec),
+					Annotations: map[string]string{
+						meta.ConfigHashAnnotationKey: fakeconfig.New(nil).Hash(),
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						*controller.CreateGatewayOwnerRef(withoutSpec),
 					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "Gateway",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
 				},
 				Data: map[string]string{
-					apiConfigKey:          `{"cell":"foo","version":"1.0.0","hostname":"foo-service.foo-namespace","apis":null}`,
-					gatewayConfigKey:      "",
-					gatewaySetupConfigKey: "",
+					apiConfigKey:          `{"cell":"foo","version":"","hostname":"foo-service.foo-namespace","apis":null,"globalContext":""}`,
+					apiPublisherConfigKey: "",
 				},
 			},
 		},
 		{
-			name: "foo gateway with spec and config",
-			gateway: &v1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-					Labels: map[string]string{
-						"my-label-key": "my-label-value",
-					},
-				},
-				Spec: v1alpha1.GatewaySpec{
-					HTTPRoutes: []v1alpha1.HTTPRoute{
-						{
-							Context:      "/context-1",
-							Backend:      "my-service",
-							Global:       true,
-							Authenticate: true,
-							Definitions: []v1alpha1.APIDefinition{
-								{
-									Path:   "path1",
-									Method: "GET",
-								},
-								{
-									Path:   "path2",
-									Method: "POST",
-								},
-							},
-						},
-					},
-				},
-			},
-			config: config.Gateway{
-				InitConfig:  "{key:value}",
-				SetupConfig: "{key:value}",
-				InitImage:   "vick/init-cell-gateway",
-				Image:       "vick/cell-gateway",
+			name:    "foo gateway with spec and config",
+			gateway: withSpec,
+			config: map[string]string{
+				config.ConfigMapKeyApiPublisherConfig: "{key:value}",
 			},
 			want: &corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "foo-namespace",
-					Name:      "foo-config",
-					Labels: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						"my-label-key":           "my-label-value",
-						appLabelKey:              "foo",
+					Name:      "foo--gateway-config",
+					Labels:    makeLabels(withSpec),
+					Annotations: map[string]string{
+						meta.ConfigHashAnnotationKey: fakeconfig.New(map[string]string{
+							config.ConfigMapKeyApiPublisherConfig: "{key:value}",
+						}).Hash(),
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						*controller.CreateGatewayOwnerRef(withSpec),
 					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "Gateway",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
 				},
 				Data: map[string]string{
-					apiConfigKey:          `{"cell":"foo","version":"1.0.0","hostname":"foo-service.foo-namespace","apis":[{"context":"/context-1","definitions":[{"path":"path1","method":"GET"},{"path":"path2","method":"POST"}],"backend":"my-service","global":true,"authenticate":true}]}`,
-					gatewayConfigKey:      "{key:value}",
-					gatewaySetupConfigKey: "{key:value}",
+					apiConfigKey: `{"cell":"foo","version":"1.0.0","hostname":"foo--gateway-service.foo-namespace","apis":[{"context":"/context-1","version":"",` +
+						`"definitions":[{"path":"path1","method":"GET"},{"path":"path2","method":"POST"}],"global":true,"authenticate":true,"port":80,` +
+						`"destination":{"host":"my-service","port":8080}}],"globalContext":"foo"}`,
+					apiPublisherConfigKey: "{key:value}",
 				},
 			},
 		},
@@ -134,7 +138,7 @@ func TestCreateGatewayConfigMap(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, err := CreateGatewayConfigMap(test.gateway, test.config)
+			got, err := CreateGatewayConfigMap(test.gateway, fakeconfig.New(test.config))
 			if err != nil {
 				t.Errorf("Error while creating the config map: %v", err)
 			}
@@ -43,6 +43,9 @@ const (
 	// used for tracing
 	appLabelKey = "app"
 
+	// used to set the IngressClass of the extensions/v1beta1 Ingresses
+	legacyIngressClassAnnotationKey = "kubernetes.io/ingress.class"
+
 	// Envoy filter
 	filterInsertPositionFirst = "FIRST"
 	filterListenerTypeGateway = "GATEWAY"
@@ -50,4 +53,12 @@ const (
 	baseFilterName            = "envoy.ext_authz"
 	statPrefix                = "ext_authz"
 	filterTimeout             = "10s"
+
+	// Envoy filter config patches (istio 1.5+)
+	filterApplyToHTTPFilter         = "HTTP_FILTER"
+	filterOperationInsertFirst      = "INSERT_FIRST"
+	httpConnectionManagerFilterName = "envoy.filters.network.http_connection_manager"
+	extAuthzFilterName              = "envoy.filters.http.ext_authz"
+	extAuthzConfigType              = "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz"
+	extAuthzTransportAPIVersion     = "V3"
 )
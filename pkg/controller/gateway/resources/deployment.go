@@ -31,6 +31,7 @@ import (
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
 func MakeDeployment(gateway *v1alpha2.Gateway, cfg config.Interface) (*appsv1.Deployment, error) {
@@ -297,6 +298,9 @@ func createEnvoyGatewayDeployment(gateway *v1alpha2.Gateway, cfg config.Interfac
 			Name:      DeploymentName(gateway),
 			Namespace: gateway.Namespace,
 			Labels:    makeLabels(gateway),
+			Annotations: meta.UnionMaps(makeScheduleAnnotations(gateway), map[string]string{
+				meta.ConfigHashAnnotationKey: cfg.Hash(),
+			}),
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateGatewayOwnerRef(gateway),
 			},
@@ -549,7 +553,8 @@ func RequireDeployment(gateway *v1alpha2.Gateway) bool {
 
 func RequireDeploymentUpdate(gateway *v1alpha2.Gateway, deployment *appsv1.Deployment) bool {
 	return gateway.Generation != gateway.Status.ObservedGeneration ||
-		deployment.Generation != gateway.Status.DeploymentGeneration
+		deployment.Generation != gateway.Status.DeploymentGeneration ||
+		deployment.Annotations[meta.ActiveScheduleAnnotationKey] != gateway.Status.ActiveSchedule
 }
 
 func CopyDeployment(source, destination *appsv1.Deployment) {
@@ -25,170 +25,157 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
-func TestCreateEnvoyFilterForEnvoyTCPRoutes(t *testing.T) {
-	gateway := &v1alpha1.Gateway{
-		Spec: v1alpha1.GatewaySpec{
-			Type: v1alpha1.GatewayTypeEnvoy,
-			Host: "test.com",
-			TCPRoutes: []v1alpha1.TCPRoute{
-				{
-					Port:        8080,
-					BackendHost: "mysql.com",
-					BackendPort: 3306,
-				},
-			},
+func TestMakeOidcEnvoyFilter(t *testing.T) {
+	gateway := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway",
 		},
-	}
-	filter := CreateEnvoyFilter(gateway)
-	expected := createExpectedEnvoyFilter(gateway)
-
-	if diff := cmp.Diff(expected, filter); diff != "" {
-		t.Errorf("CreateEnvoyFilter (-expected, +actual)\n%v", diff)
-	}
-}
-
-func TestCreateEnvoyFilterForEnvoyHttpRoutes(t *testing.T) {
-	gateway := &v1alpha1.Gateway{
-		Spec: v1alpha1.GatewaySpec{
-			Type: v1alpha1.GatewayTypeEnvoy,
-			Host: "test.com",
-			HTTPRoutes: []v1alpha1.HTTPRoute{
-				{
-					Authenticate: true,
-					Global:       true,
-					Backend:      "mytestservice",
-					Context:      "hello",
-					Definitions: []v1alpha1.APIDefinition{
-						{
-							Path:   "sayHello",
-							Method: "GET",
-						},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				IngressExtensions: v1alpha2.IngressExtensions{
+					OidcConfig: &v1alpha2.OidcConfig{
+						ProviderUrl:  "https://accounts.google.com",
+						ClientId:     "xxxxxxxxxxxxxxxxxxx",
+						ClientSecret: "yyyyyyyyyyyyyyyyyyy",
+						RedirectUrl:  "http://pet-store.com/_auth/callback",
+						BaseUrl:      "http://pet-store.com/",
+						SubjectClaim: "given_name",
 					},
 				},
 			},
 		},
 	}
-	filter := CreateEnvoyFilter(gateway)
-	expected := createExpectedEnvoyFilter(gateway)
-
-	if diff := cmp.Diff(expected, filter); diff != "" {
-		t.Errorf("CreateEnvoyFilter (-expected, +actual)\n%v", diff)
+	grpcService := v1alpha3.GRPCService{
+		GoogleGRPC: v1alpha3.GoogleGRPC{
+			TargetUri:  "127.0.0.1:15800",
+			StatPrefix: "ext_authz",
+		},
+		Timeout: "10s",
 	}
-}
 
-func TestCreateEnvoyFilterForEnvoyMicroGateway(t *testing.T) {
-	gateway := &v1alpha1.Gateway{
-		Spec: v1alpha1.GatewaySpec{
-			Type: v1alpha1.GatewayTypeMicroGateway,
-			Host: "test.com",
-			HTTPRoutes: []v1alpha1.HTTPRoute{
-				{
-					Authenticate: true,
-					Global:       true,
-					Backend:      "mytestservice",
-					Context:      "hello",
-					Definitions: []v1alpha1.APIDefinition{
-						{
-							Path:   "sayHello",
-							Method: "GET",
+	tests := []struct {
+		name   string
+		config map[string]string
+		want   v1alpha3.EnvoyFilterSpec
+	}{
+		{
+			name:   "istio 1.5",
+			config: map[string]string{config.ConfigMapKeyIstioVersion: "1.5.0"},
+			want: v1alpha3.EnvoyFilterSpec{
+				WorkloadSelector: &v1alpha3.WorkloadSelector{
+					Labels: makeLabels(gateway),
+				},
+				ConfigPatches: []v1alpha3.EnvoyConfigObjectPatch{
+					{
+						ApplyTo: "HTTP_FILTER",
+						Match: &v1alpha3.EnvoyConfigObjectMatch{
+							Context: "GATEWAY",
+							Listener: &v1alpha3.ListenerPatchMatch{
+								FilterChain: &v1alpha3.FilterChainMatch{
+									Filter: &v1alpha3.FilterMatch{
+										Name: "envoy.filters.network.http_connection_manager",
+									},
+								},
+							},
+						},
+						Patch: &v1alpha3.Patch{
+							Operation: "INSERT_FIRST",
+							Value: &v1alpha3.HTTPFilter{
+								Name: "envoy.filters.http.ext_authz",
+								TypedConfig: &v1alpha3.ExtAuthzConfig{
+									Type:                "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz",
+									TransportAPIVersion: "V3",
+									GRPCService:         grpcService,
+								},
+							},
 						},
 					},
 				},
 			},
-			Tls: v1alpha1.TlsConfig{
-				Secret: "mytlssecret",
-			},
 		},
-	}
-	filter := CreateEnvoyFilter(gateway)
-	expected := createExpectedEnvoyFilter(gateway)
-
-	if diff := cmp.Diff(expected, filter); diff != "" {
-		t.Errorf("CreateEnvoyFilter (-expected, +actual)\n%v", diff)
-	}
-}
-
-func TestCreateEnvoyFilterForEnvoyWebCell(t *testing.T) {
-	gateway := &v1alpha1.Gateway{
-		Spec: v1alpha1.GatewaySpec{
-			Type: v1alpha1.GatewayTypeMicroGateway,
-			Host: "test.com",
-			HTTPRoutes: []v1alpha1.HTTPRoute{
-				{
-					Authenticate: true,
-					Global:       true,
-					Backend:      "mytestservice",
-					Context:      "hello",
-					Definitions: []v1alpha1.APIDefinition{
-						{
-							Path:   "sayHello",
-							Method: "GET",
+		{
+			name:   "istio 1.0",
+			config: map[string]string{config.ConfigMapKeyIstioVersion: "1.0.2"},
+			want: v1alpha3.EnvoyFilterSpec{
+				WorkloadLabels: makeLabels(gateway),
+				Filters: []v1alpha3.Filter{
+					{
+						InsertPosition: v1alpha3.InsertPosition{
+							Index: "FIRST",
+						},
+						ListenerMatch: v1alpha3.ListenerMatch{
+							ListenerType:     "GATEWAY",
+							ListenerProtocol: "HTTP",
+						},
+						FilterName: "envoy.ext_authz",
+						FilterType: "HTTP",
+						FilterConfig: v1alpha3.FilterConfig{
+							GRPCService: grpcService,
 						},
 					},
 				},
 			},
-			Tls: v1alpha1.TlsConfig{
-				Secret: "mytlssecret",
-			},
-			OidcConfig: &v1alpha1.OidcConfig{
-				ProviderUrl:  "https://accounts.google.com",
-				ClientId:     "xxxxxxxxxxxxxxxxxxx",
-				ClientSecret: "yyyyyyyyyyyyyyyyyyy",
-				RedirectUrl:  "http://pet-store.com/_auth/callback",
-				BaseUrl:      "http://pet-store.com/",
-				SubjectClaim: "given_name",
-				NonSecurePaths: []string{
-					"/*",
-				},
-			},
 		},
 	}
-	filter := CreateEnvoyFilter(gateway)
-	expected := createExpectedEnvoyFilter(gateway)
 
-	if diff := cmp.Diff(expected, filter); diff != "" {
-		t.Errorf("CreateEnvoyFilter (-expected, +actual)\n%v", diff)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			want := &v1alpha3.EnvoyFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "foo-namespace",
+					Name:      "foo-gateway-oidc",
+					Labels:    makeLabels(gateway),
+					OwnerReferences: []metav1.OwnerReference{
+						*controller.CreateGatewayOwnerRef(gateway),
+					},
+				},
+				Spec: test.want,
+			}
+			meta.AddObjectHash(want)
+			got := MakeOidcEnvoyFilter(gateway, fakeconfig.New(test.config))
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("MakeOidcEnvoyFilter (-want, +got)\n%v", diff)
+			}
+		})
 	}
 }
 
-func createExpectedEnvoyFilter(gateway *v1alpha1.Gateway) *v1alpha3.EnvoyFilter {
-	return &v1alpha3.EnvoyFilter{
+func TestRequireOidcEnvoyFilterUpdate(t *testing.T) {
+	gateway := &v1alpha2.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      EnvoyFilterName(gateway),
-			Namespace: gateway.Namespace,
-			Labels:    createGatewayLabels(gateway),
-			OwnerReferences: []metav1.OwnerReference{
-				*controller.CreateGatewayOwnerRef(gateway),
-			},
+			Namespace:  "foo-namespace",
+			Name:       "foo-gateway",
+			Generation: 2,
 		},
-		Spec: v1alpha3.EnvoyFilterSpec{
-			WorkloadLabels: createGatewayLabels(gateway),
-			Filters: []v1alpha3.Filter{
-				{
-					InsertPosition: v1alpha3.InsertPosition{
-						Index: filterInsertPositionFirst,
-					},
-					ListenerMatch: v1alpha3.ListenerMatch{
-						ListenerType:     filterListenerTypeGateway,
-						ListenerProtocol: HTTPProtocol,
-					},
-					FilterName: baseFilterName,
-					FilterType: HTTPProtocol,
-					FilterConfig: v1alpha3.FilterConfig{
-						GRPCService: v1alpha3.GRPCService{
-							GoogleGRPC: v1alpha3.GoogleGRPC{
-								TargetUri:  "127.0.0.1:15800", // filter is attached as a sidecar
-								StatPrefix: statPrefix,
-							},
-							Timeout: filterTimeout,
-						},
-					},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				IngressExtensions: v1alpha2.IngressExtensions{
+					OidcConfig: &v1alpha2.OidcConfig{ProviderUrl: "https://accounts.google.com"},
 				},
 			},
 		},
+		Status: v1alpha2.GatewayStatus{
+			ObservedGeneration:        2,
+			OidcEnvoyFilterGeneration: 1,
+		},
+	}
+
+	legacy := MakeOidcEnvoyFilter(gateway, fakeconfig.New(map[string]string{config.ConfigMapKeyIstioVersion: "1.0.2"}))
+	legacy.Generation = 1
+
+	if RequireOidcEnvoyFilterUpdate(gateway, legacy, legacy) {
+		t.Errorf("RequireOidcEnvoyFilterUpdate() = true without any change, want false")
+	}
+	desired := MakeOidcEnvoyFilter(gateway, fakeconfig.New(map[string]string{config.ConfigMapKeyIstioVersion: "1.5.0"}))
+	if !RequireOidcEnvoyFilterUpdate(gateway, desired, legacy) {
+		t.Errorf("RequireOidcEnvoyFilterUpdate() = false after the istio version is changed, want true")
 	}
 }
@@ -22,99 +22,254 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/api/extensions/v1beta1"
-	networkv1 "k8s.io/api/extensions/v1beta1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	k8snetworkingv1 "cellery.io/cellery-controller/pkg/apis/k8snetworking/v1"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
 	"cellery.io/cellery-controller/pkg/crypto"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
-func MakeClusterIngress(gateway *v1alpha2.Gateway) *v1beta1.Ingress {
+// MakeClusterIngress creates a networking.k8s.io/v1 Ingress which exposes the gateway through the ingress
+// controller of the cluster.
+func MakeClusterIngress(gateway *v1alpha2.Gateway) *k8snetworkingv1.Ingress {
+	ci := gateway.Spec.Ingress.IngressExtensions.ClusterIngress
+	pathType := k8snetworkingv1.PathTypePrefix
 
-	var httpIngressPaths []networkv1.HTTPIngressPath
-	extensions := gateway.Spec.Ingress.IngressExtensions
-	httpIngressPaths = append(httpIngressPaths, networkv1.HTTPIngressPath{
-		Path: "/",
-		Backend: v1beta1.IngressBackend{
-			ServiceName: ServiceName(gateway),
-			ServicePort: intstr.IntOrString{Type: intstr.Int, IntVal: 80},
-		},
-	})
-
-	// add callback endpoint to the ingress if oidc is enabled
-	if extensions.HasOidc() {
-		httpIngressPaths = append(httpIngressPaths, networkv1.HTTPIngressPath{
-			Path: "/_auth",
-			Backend: v1beta1.IngressBackend{
-				ServiceName: ServiceName(gateway),
-				ServicePort: intstr.IntOrString{Type: intstr.Int, IntVal: 15810},
+	var rules []k8snetworkingv1.IngressRule
+	for _, host := range clusterIngressHosts(gateway) {
+		var paths []k8snetworkingv1.HTTPIngressPath
+		for _, p := range host.paths {
+			paths = append(paths, k8snetworkingv1.HTTPIngressPath{
+				Path:     p.path,
+				PathType: &pathType,
+				Backend: k8snetworkingv1.IngressBackend{
+					Service: &k8snetworkingv1.IngressServiceBackend{
+						Name: ServiceName(gateway),
+						Port: k8snetworkingv1.ServiceBackendPort{Number: p.port},
+					},
+				},
+			})
+		}
+		rules = append(rules, k8snetworkingv1.IngressRule{
+			Host: host.host,
+			IngressRuleValue: k8snetworkingv1.IngressRuleValue{
+				HTTP: &k8snetworkingv1.HTTPIngressRuleValue{
+					Paths: paths,
+				},
 			},
 		})
 	}
 
-	var tlsIngressHosts []v1beta1.IngressTLS
-
-	if extensions.ClusterIngress.HasSecret() {
-		tlsIngressHosts = append(tlsIngressHosts, networkv1.IngressTLS{
-			Hosts:      []string{extensions.ClusterIngress.Host},
-			SecretName: extensions.ClusterIngress.Tls.Secret,
-		})
-	} else if extensions.ClusterIngress.HasCertAndKey() {
-		tlsIngressHosts = append(tlsIngressHosts, networkv1.IngressTLS{
-			Hosts:      []string{extensions.ClusterIngress.Host},
-			SecretName: ClusterIngressSecretName(gateway),
+	var tls []k8snetworkingv1.IngressTLS
+	for _, t := range clusterIngressTls(gateway) {
+		tls = append(tls, k8snetworkingv1.IngressTLS{
+			Hosts:      t.hosts,
+			SecretName: t.secretName,
 		})
 	}
 
-	return &v1beta1.Ingress{
+	var ingressClassName *string
+	if len(ci.IngressClassName) > 0 {
+		ingressClassName = &ci.IngressClassName
+	}
+
+	return &k8snetworkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      ClusterIngressName(gateway),
-			Namespace: gateway.Namespace,
-			Labels:    makeLabels(gateway),
+			Name:        ClusterIngressName(gateway),
+			Namespace:   gateway.Namespace,
+			Labels:      makeLabels(gateway),
+			Annotations: ci.Annotations,
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateGatewayOwnerRef(gateway),
 			},
 		},
-		Spec: v1beta1.IngressSpec{
-			Rules: []networkv1.IngressRule{
-				{
-					Host: extensions.ClusterIngress.Host,
-					IngressRuleValue: v1beta1.IngressRuleValue{
-						HTTP: &v1beta1.HTTPIngressRuleValue{
-							Paths: httpIngressPaths,
-						},
-					},
+		Spec: k8snetworkingv1.IngressSpec{
+			IngressClassName: ingressClassName,
+			Rules:            rules,
+			TLS:              tls,
+		},
+	}
+}
+
+// MakeLegacyClusterIngress creates an extensions/v1beta1 Ingress for the clusters which do not serve the
+// networking.k8s.io/v1 API. The IngressClass is set using the annotation which preceded the ingressClassName.
+func MakeLegacyClusterIngress(gateway *v1alpha2.Gateway) *extensionsv1beta1.Ingress {
+	ci := gateway.Spec.Ingress.IngressExtensions.ClusterIngress
+
+	var rules []extensionsv1beta1.IngressRule
+	for _, host := range clusterIngressHosts(gateway) {
+		var paths []extensionsv1beta1.HTTPIngressPath
+		for _, p := range host.paths {
+			paths = append(paths, extensionsv1beta1.HTTPIngressPath{
+				Path: p.path,
+				Backend: extensionsv1beta1.IngressBackend{
+					ServiceName: ServiceName(gateway),
+					ServicePort: intstr.IntOrString{Type: intstr.Int, IntVal: p.port},
+				},
+			})
+		}
+		rules = append(rules, extensionsv1beta1.IngressRule{
+			Host: host.host,
+			IngressRuleValue: extensionsv1beta1.IngressRuleValue{
+				HTTP: &extensionsv1beta1.HTTPIngressRuleValue{
+					Paths: paths,
 				},
 			},
-			TLS: tlsIngressHosts,
+		})
+	}
+
+	var tls []extensionsv1beta1.IngressTLS
+	for _, t := range clusterIngressTls(gateway) {
+		tls = append(tls, extensionsv1beta1.IngressTLS{
+			Hosts:      t.hosts,
+			SecretName: t.secretName,
+		})
+	}
+
+	annotations := ci.Annotations
+	if len(ci.IngressClassName) > 0 {
+		annotations = meta.UnionMaps(annotations, map[string]string{
+			legacyIngressClassAnnotationKey: ci.IngressClassName,
+		})
+	}
+
+	return &extensionsv1beta1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ClusterIngressName(gateway),
+			Namespace:   gateway.Namespace,
+			Labels:      makeLabels(gateway),
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
 		},
+		Spec: extensionsv1beta1.IngressSpec{
+			Rules: rules,
+			TLS:   tls,
+		},
+	}
+}
+
+type clusterIngressPath struct {
+	path string
+	port int32
+}
+
+type clusterIngressHost struct {
+	host  string
+	paths []clusterIngressPath
+}
+
+type clusterIngressTlsHosts struct {
+	hosts      []string
+	secretName string
+}
+
+// clusterIngressHosts returns the hosts of the Ingress along with the paths routed to the gateway. The oidc
+// callback endpoint is routed for every host if oidc is enabled.
+func clusterIngressHosts(gateway *v1alpha2.Gateway) []clusterIngressHost {
+	extensions := gateway.Spec.Ingress.IngressExtensions
+	ci := extensions.ClusterIngress
+
+	makePaths := func(prefixes []string) []clusterIngressPath {
+		if len(prefixes) == 0 {
+			prefixes = []string{"/"}
+		}
+		var paths []clusterIngressPath
+		for _, prefix := range prefixes {
+			paths = append(paths, clusterIngressPath{path: prefix, port: 80})
+		}
+		// add callback endpoint to the ingress if oidc is enabled
+		if extensions.HasOidc() {
+			paths = append(paths, clusterIngressPath{path: "/_auth", port: 15810})
+		}
+		return paths
+	}
+
+	var hosts []clusterIngressHost
+	if len(ci.Host) > 0 || len(ci.Hosts) == 0 {
+		hosts = append(hosts, clusterIngressHost{host: ci.Host, paths: makePaths(nil)})
 	}
+	for _, h := range ci.Hosts {
+		hosts = append(hosts, clusterIngressHost{host: h.Host, paths: makePaths(h.Paths)})
+	}
+	return hosts
+}
+
+// clusterIngressTls returns the TLS configurations of the hosts of the Ingress.
+func clusterIngressTls(gateway *v1alpha2.Gateway) []clusterIngressTlsHosts {
+	ci := gateway.Spec.Ingress.IngressExtensions.ClusterIngress
+
+	var tls []clusterIngressTlsHosts
+	if ci.HasSecret() {
+		tls = append(tls, clusterIngressTlsHosts{
+			hosts:      []string{ci.Host},
+			secretName: ci.Tls.Secret,
+		})
+	} else if ci.HasCertAndKey() {
+		tls = append(tls, clusterIngressTlsHosts{
+			hosts:      []string{ci.Host},
+			secretName: ClusterIngressSecretName(gateway),
+		})
+	} else if ci.HasAutoTls() {
+		tls = append(tls, clusterIngressTlsHosts{
+			hosts:      ci.CertificateHosts(),
+			secretName: ClusterIngressCertificateSecretName(gateway),
+		})
+	}
+	for _, h := range ci.Hosts {
+		if len(h.TlsSecret) > 0 {
+			tls = append(tls, clusterIngressTlsHosts{
+				hosts:      []string{h.Host},
+				secretName: h.TlsSecret,
+			})
+		}
+	}
+	return tls
 }
 
 func ClusterIngressName(gateway *v1alpha2.Gateway) string {
 	return gateway.Name + "-ingress"
 }
 
+// RequireClusterIngress returns true if the gateway is exposed with an Ingress. The cluster ingress only
+// provides the hosts and the parent Gateway of the routes if they are served by the Gateway API.
 func RequireClusterIngress(gateway *v1alpha2.Gateway) bool {
-	return gateway.Spec.Ingress.IngressExtensions.HasClusterIngress()
+	return gateway.Spec.Ingress.IngressExtensions.HasClusterIngress() && !gateway.Spec.Ingress.UsesGatewayAPI()
 }
 
-func RequireClusterIngressUpdate(gateway *v1alpha2.Gateway, ingress *v1beta1.Ingress) bool {
+func RequireClusterIngressUpdate(gateway *v1alpha2.Gateway, ingress *k8snetworkingv1.Ingress) bool {
 	return gateway.Generation != gateway.Status.ObservedGeneration ||
 		ingress.Generation != gateway.Status.ClusterIngressGeneration
 }
 
-func CopyClusterIngress(source, destination *v1beta1.Ingress) {
+func CopyClusterIngress(source, destination *k8snetworkingv1.Ingress) {
 	destination.Spec = source.Spec
 	destination.Labels = source.Labels
 	destination.Annotations = source.Annotations
 }
 
-func StatusFromClusterIngress(gateway *v1alpha2.Gateway, ingress *v1beta1.Ingress) {
+func StatusFromClusterIngress(gateway *v1alpha2.Gateway, ingress *k8snetworkingv1.Ingress) {
+	gateway.Status.ClusterIngressGeneration = ingress.Generation
+}
+
+func RequireLegacyClusterIngressUpdate(gateway *v1alpha2.Gateway, ingress *extensionsv1beta1.Ingress) bool {
+	return gateway.Generation != gateway.Status.ObservedGeneration ||
+		ingress.Generation != gateway.Status.ClusterIngressGeneration
+}
+
+func CopyLegacyClusterIngress(source, destination *extensionsv1beta1.Ingress) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
+func StatusFromLegacyClusterIngress(gateway *v1alpha2.Gateway, ingress *extensionsv1beta1.Ingress) {
 	gateway.Status.ClusterIngressGeneration = ingress.Generation
 }
 
@@ -141,6 +296,9 @@ func MakeClusterIngressSecret(gateway *v1alpha2.Gateway, cfg config.Interface) (
 			Name:      ClusterIngressSecretName(gateway),
 			Namespace: gateway.Namespace,
 			Labels:    makeLabels(gateway),
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: controller.CertificateHash(cfg),
+			},
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateGatewayOwnerRef(gateway),
 			},
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	certmanagerv1 "cellery.io/cellery-controller/pkg/apis/certmanager/v1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+// MakeClusterIngressCertificate creates a cert-manager Certificate for the hosts of the cluster ingress. The
+// issued key and certificate are stored by cert-manager in the TLS secret of the Ingress.
+func MakeClusterIngressCertificate(gateway *v1alpha2.Gateway, cfg config.Interface) (*certmanagerv1.Certificate, error) {
+	ci := gateway.Spec.Ingress.IngressExtensions.ClusterIngress
+
+	issuerRef := certmanagerv1.ObjectReference{
+		Name: cfg.StringValue(config.ConfigMapKeyCertificateIssuer),
+		Kind: certmanagerv1.ClusterIssuerKind,
+	}
+	if issuer := ci.Tls.Issuer; issuer != nil {
+		issuerRef.Name = issuer.Name
+		if len(issuer.Kind) > 0 {
+			issuerRef.Kind = issuer.Kind
+		}
+	}
+	if len(issuerRef.Name) == 0 {
+		return nil, fmt.Errorf("no issuer is configured for the certificate, set %q in the config or the issuer of the cluster ingress",
+			config.ConfigMapKeyCertificateIssuer)
+	}
+
+	hosts := ci.CertificateHosts()
+	var commonName string
+	if len(hosts) > 0 {
+		commonName = hosts[0]
+	}
+
+	return &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ClusterIngressCertificateName(gateway),
+			Namespace: gateway.Namespace,
+			Labels:    makeLabels(gateway),
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: cfg.Hash(),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		},
+		Spec: certmanagerv1.CertificateSpec{
+			SecretName: ClusterIngressCertificateSecretName(gateway),
+			CommonName: commonName,
+			DNSNames:   hosts,
+			IssuerRef:  issuerRef,
+		},
+	}, nil
+}
+
+func ClusterIngressCertificateName(gateway *v1alpha2.Gateway) string {
+	return ClusterIngressName(gateway) + "-certificate"
+}
+
+// ClusterIngressCertificateSecretName returns the name of the secret which cert-manager stores the issued
+// certificate in. It differs from the secret created from the key and cert since that is owned by the gateway.
+func ClusterIngressCertificateSecretName(gateway *v1alpha2.Gateway) string {
+	return ClusterIngressName(gateway) + "-tls"
+}
+
+func RequireClusterIngressCertificate(gateway *v1alpha2.Gateway) bool {
+	return RequireClusterIngress(gateway) && gateway.Spec.Ingress.IngressExtensions.ClusterIngress.HasAutoTls()
+}
+
+func RequireClusterIngressCertificateUpdate(gateway *v1alpha2.Gateway, certificate *certmanagerv1.Certificate) bool {
+	return gateway.Generation != gateway.Status.ObservedGeneration ||
+		certificate.Generation != gateway.Status.CertificateGeneration
+}
+
+func CopyClusterIngressCertificate(source, destination *certmanagerv1.Certificate) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
+// StatusFromClusterIngressCertificate reports whether the certificate is issued by cert-manager. The certificate
+// is not ready until its Ready condition becomes true.
+func StatusFromClusterIngressCertificate(gateway *v1alpha2.Gateway, certificate *certmanagerv1.Certificate) {
+	gateway.Status.CertificateGeneration = certificate.Generation
+	ready := corev1.ConditionFalse
+	for _, c := range certificate.Status.Conditions {
+		if c.Type == certmanagerv1.CertificateConditionReady && c.Status == corev1.ConditionTrue {
+			ready = corev1.ConditionTrue
+		}
+	}
+	gateway.Status.SetCondition(v1alpha2.GatewayCertificateReady, ready)
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	certmanagerv1 "cellery.io/cellery-controller/pkg/apis/certmanager/v1"
+	k8snetworkingv1 "cellery.io/cellery-controller/pkg/apis/k8snetworking/v1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+func testAutoTlsGateway() *v1alpha2.Gateway {
+	return &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway",
+		},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				IngressExtensions: v1alpha2.IngressExtensions{
+					ClusterIngress: &v1alpha2.ClusterIngressConfig{
+						Host: "foo.example.com",
+						Tls: v1alpha2.TlsConfig{
+							AutoTls: true,
+						},
+						Hosts: []v1alpha2.ClusterIngressHost{
+							{
+								Host:      "bar.example.com",
+								TlsSecret: "bar-tls",
+							},
+							{
+								Host: "baz.example.com",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestMakeClusterIngressCertificate(t *testing.T) {
+	tests := []struct {
+		name    string
+		issuer  *v1alpha2.CertificateIssuer
+		config  map[string]string
+		want    certmanagerv1.ObjectReference
+		wantErr bool
+	}{
+		{
+			name:   "cluster issuer from the config",
+			config: map[string]string{config.ConfigMapKeyCertificateIssuer: "letsencrypt"},
+			want: certmanagerv1.ObjectReference{
+				Name: "letsencrypt",
+				Kind: certmanagerv1.ClusterIssuerKind,
+			},
+		},
+		{
+			name:   "issuer of the cluster ingress overrides the config",
+			issuer: &v1alpha2.CertificateIssuer{Name: "foo-issuer", Kind: v1alpha2.CertificateIssuerKindIssuer},
+			config: map[string]string{config.ConfigMapKeyCertificateIssuer: "letsencrypt"},
+			want: certmanagerv1.ObjectReference{
+				Name: "foo-issuer",
+				Kind: certmanagerv1.IssuerKind,
+			},
+		},
+		{
+			name:    "no issuer",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gateway := testAutoTlsGateway()
+			gateway.Spec.Ingress.IngressExtensions.ClusterIngress.Tls.Issuer = test.issuer
+			cfg := fakeconfig.New(test.config)
+			got, err := MakeClusterIngressCertificate(gateway, cfg)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("MakeClusterIngressCertificate() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			want := &certmanagerv1.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "foo-namespace",
+					Name:      "foo-gateway-ingress-certificate",
+					Labels:    makeLabels(gateway),
+					Annotations: map[string]string{
+						meta.ConfigHashAnnotationKey: cfg.Hash(),
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						*controller.CreateGatewayOwnerRef(gateway),
+					},
+				},
+				Spec: certmanagerv1.CertificateSpec{
+					SecretName: "foo-gateway-ingress-tls",
+					CommonName: "foo.example.com",
+					DNSNames:   []string{"foo.example.com", "baz.example.com"},
+					IssuerRef:  test.want,
+				},
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("MakeClusterIngressCertificate() (-want, +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestClusterIngressAutoTls(t *testing.T) {
+	want := []k8snetworkingv1.IngressTLS{
+		{
+			Hosts:      []string{"foo.example.com", "baz.example.com"},
+			SecretName: "foo-gateway-ingress-tls",
+		},
+		{
+			Hosts:      []string{"bar.example.com"},
+			SecretName: "bar-tls",
+		},
+	}
+	got := MakeClusterIngress(testAutoTlsGateway())
+	if diff := cmp.Diff(want, got.Spec.TLS); diff != "" {
+		t.Errorf("MakeClusterIngress() tls (-want, +got)\n%v", diff)
+	}
+}
+
+func TestStatusFromClusterIngressCertificate(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []certmanagerv1.CertificateCondition
+		want       corev1.ConditionStatus
+	}{
+		{
+			name: "issued certificate",
+			conditions: []certmanagerv1.CertificateCondition{
+				{Type: certmanagerv1.CertificateConditionReady, Status: corev1.ConditionTrue},
+			},
+			want: corev1.ConditionTrue,
+		},
+		{
+			name: "certificate being issued",
+			conditions: []certmanagerv1.CertificateCondition{
+				{Type: certmanagerv1.CertificateConditionIssuing, Status: corev1.ConditionTrue},
+				{Type: certmanagerv1.CertificateConditionReady, Status: corev1.ConditionFalse},
+			},
+			want: corev1.ConditionFalse,
+		},
+		{
+			name: "certificate without a status",
+			want: corev1.ConditionFalse,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gateway := testAutoTlsGateway()
+			certificate := &certmanagerv1.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     certmanagerv1.CertificateStatus{Conditions: test.conditions},
+			}
+			StatusFromClusterIngressCertificate(gateway, certificate)
+			want := v1alpha2.GatewayStatus{
+				CertificateGeneration: 2,
+				Conditions: []v1alpha2.GatewayCondition{
+					{Type: v1alpha2.GatewayCertificateReady, Status: test.want},
+				},
+			}
+			if diff := cmp.Diff(want, gateway.Status); diff != "" {
+				t.Errorf("StatusFromClusterIngressCertificate() (-want, +got)\n%v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	k8snetworkingv1 "cellery.io/cellery-controller/pkg/apis/k8snetworking/v1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/controller"
+)
+
+func testClusterIngressGateway() *v1alpha2.Gateway {
+	return &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway",
+		},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				IngressExtensions: v1alpha2.IngressExtensions{
+					ClusterIngress: &v1alpha2.ClusterIngressConfig{
+						Host: "foo.example.com",
+						Tls: v1alpha2.TlsConfig{
+							Secret: "foo-tls",
+						},
+						IngressClassName: "nginx",
+						Hosts: []v1alpha2.ClusterIngressHost{
+							{
+								Host:      "bar.example.com",
+								Paths:     []string{"/api", "/ui"},
+								TlsSecret: "bar-tls",
+							},
+							{
+								Host: "baz.example.com",
+							},
+						},
+						Annotations: map[string]string{
+							"nginx.ingress.kubernetes.io/proxy-body-size": "8m",
+						},
+					},
+					OidcConfig: &v1alpha2.OidcConfig{},
+				},
+			},
+		},
+	}
+}
+
+func TestMakeClusterIngress(t *testing.T) {
+	gateway := testClusterIngressGateway()
+	ingressClassName := "nginx"
+	pathType := k8snetworkingv1.PathTypePrefix
+	backend := func(port int32) k8snetworkingv1.IngressBackend {
+		return k8snetworkingv1.IngressBackend{
+			Service: &k8snetworkingv1.IngressServiceBackend{
+				Name: "foo-gateway-service",
+				Port: k8snetworkingv1.ServiceBackendPort{Number: port},
+			},
+		}
+	}
+	rule := func(host string, paths ...k8snetworkingv1.HTTPIngressPath) k8snetworkingv1.IngressRule {
+		return k8snetworkingv1.IngressRule{
+			Host: host,
+			IngressRuleValue: k8snetworkingv1.IngressRuleValue{
+				HTTP: &k8snetworkingv1.HTTPIngressRuleValue{Paths: paths},
+			},
+		}
+	}
+
+	want := &k8snetworkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway-ingress",
+			Labels:    makeLabels(gateway),
+			Annotations: map[string]string{
+				"nginx.ingress.kubernetes.io/proxy-body-size": "8m",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		},
+		Spec: k8snetworkingv1.IngressSpec{
+			IngressClassName: &ingressClassName,
+			Rules: []k8snetworkingv1.IngressRule{
+				rule("foo.example.com",
+					k8snetworkingv1.HTTPIngressPath{Path: "/", PathType: &pathType, Backend: backend(80)},
+					k8snetworkingv1.HTTPIngressPath{Path: "/_auth", PathType: &pathType, Backend: backend(15810)},
+				),
+				rule("bar.example.com",
+					k8snetworkingv1.HTTPIngressPath{Path: "/api", PathType: &pathType, Backend: backend(80)},
+					k8snetworkingv1.HTTPIngressPath{Path: "/ui", PathType: &pathType, Backend: backend(80)},
+					k8snetworkingv1.HTTPIngressPath{Path: "/_auth", PathType: &pathType, Backend: backend(15810)},
+				),
+				rule("baz.example.com",
+					k8snetworkingv1.HTTPIngressPath{Path: "/", PathType: &pathType, Backend: backend(80)},
+					k8snetworkingv1.HTTPIngressPath{Path: "/_auth", PathType: &pathType, Backend: backend(15810)},
+				),
+			},
+			TLS: []k8snetworkingv1.IngressTLS{
+				{Hosts: []string{"foo.example.com"}, SecretName: "foo-tls"},
+				{Hosts: []string{"bar.example.com"}, SecretName: "bar-tls"},
+			},
+		},
+	}
+	got := MakeClusterIngress(gateway)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MakeClusterIngress (-want, +got)\n%v", diff)
+	}
+}
+
+func TestMakeLegacyClusterIngress(t *testing.T) {
+	gateway := testClusterIngressGateway()
+	path := func(p string, port int32) extensionsv1beta1.HTTPIngressPath {
+		return extensionsv1beta1.HTTPIngressPath{
+			Path: p,
+			Backend: extensionsv1beta1.IngressBackend{
+				ServiceName: "foo-gateway-service",
+				ServicePort: intstr.FromInt(int(port)),
+			},
+		}
+	}
+	rule := func(host string, paths ...extensionsv1beta1.HTTPIngressPath) extensionsv1beta1.IngressRule {
+		return extensionsv1beta1.IngressRule{
+			Host: host,
+			IngressRuleValue: extensionsv1beta1.IngressRuleValue{
+				HTTP: &extensionsv1beta1.HTTPIngressRuleValue{Paths: paths},
+			},
+		}
+	}
+
+	want := &extensionsv1beta1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway-ingress",
+			Labels:    makeLabels(gateway),
+			Annotations: map[string]string{
+				"kubernetes.io/ingress.class":                 "nginx",
+				"nginx.ingress.kubernetes.io/proxy-body-size": "8m",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		},
+		Spec: extensionsv1beta1.IngressSpec{
+			Rules: []extensionsv1beta1.IngressRule{
+				rule("foo.example.com", path("/", 80), path("/_auth", 15810)),
+				rule("bar.example.com", path("/api", 80), path("/ui", 80), path("/_auth", 15810)),
+				rule("baz.example.com", path("/", 80), path("/_auth", 15810)),
+			},
+			TLS: []extensionsv1beta1.IngressTLS{
+				{Hosts: []string{"foo.example.com"}, SecretName: "foo-tls"},
+				{Hosts: []string{"bar.example.com"}, SecretName: "bar-tls"},
+			},
+		},
+	}
+	got := MakeLegacyClusterIngress(gateway)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MakeLegacyClusterIngress (-want, +got)\n%v", diff)
+	}
+}
@@ -23,11 +23,13 @@ import (
 
 	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
-func MakeOidcEnvoyFilter(gateway *v1alpha2.Gateway) *v1alpha3.EnvoyFilter {
-	return &v1alpha3.EnvoyFilter{
+func MakeOidcEnvoyFilter(gateway *v1alpha2.Gateway, cfg config.Interface) *v1alpha3.EnvoyFilter {
+	envoyFilter := &v1alpha3.EnvoyFilter{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      OidcEnvoyFilterName(gateway),
 			Namespace: gateway.Namespace,
@@ -36,7 +38,16 @@ func MakeOidcEnvoyFilter(gateway *v1alpha2.Gateway) *v1alpha3.EnvoyFilter {
 				*controller.CreateGatewayOwnerRef(gateway),
 			},
 		},
-		Spec: v1alpha3.EnvoyFilterSpec{
+	}
+	grpcService := v1alpha3.GRPCService{
+		GoogleGRPC: v1alpha3.GoogleGRPC{
+			TargetUri:  "127.0.0.1:15800", // filter is attached as a sidecar
+			StatPrefix: statPrefix,
+		},
+		Timeout: filterTimeout,
+	}
+	if !controller.UseEnvoyConfigPatches(cfg) {
+		envoyFilter.Spec = v1alpha3.EnvoyFilterSpec{
 			WorkloadLabels: makeLabels(gateway),
 			Filters: []v1alpha3.Filter{
 				{
@@ -50,18 +61,47 @@ func MakeOidcEnvoyFilter(gateway *v1alpha2.Gateway) *v1alpha3.EnvoyFilter {
 					FilterName: baseFilterName,
 					FilterType: HTTPProtocol,
 					FilterConfig: v1alpha3.FilterConfig{
-						GRPCService: v1alpha3.GRPCService{
-							GoogleGRPC: v1alpha3.GoogleGRPC{
-								TargetUri:  "127.0.0.1:15800", // filter is attached as a sidecar
-								StatPrefix: statPrefix,
+						GRPCService: grpcService,
+					},
+				},
+			},
+		}
+		meta.AddObjectHash(envoyFilter)
+		return envoyFilter
+	}
+	envoyFilter.Spec = v1alpha3.EnvoyFilterSpec{
+		WorkloadSelector: &v1alpha3.WorkloadSelector{
+			Labels: makeLabels(gateway),
+		},
+		ConfigPatches: []v1alpha3.EnvoyConfigObjectPatch{
+			{
+				ApplyTo: filterApplyToHTTPFilter,
+				Match: &v1alpha3.EnvoyConfigObjectMatch{
+					Context: filterListenerTypeGateway,
+					Listener: &v1alpha3.ListenerPatchMatch{
+						FilterChain: &v1alpha3.FilterChainMatch{
+							Filter: &v1alpha3.FilterMatch{
+								Name: httpConnectionManagerFilterName,
 							},
-							Timeout: filterTimeout,
+						},
+					},
+				},
+				Patch: &v1alpha3.Patch{
+					Operation: filterOperationInsertFirst,
+					Value: &v1alpha3.HTTPFilter{
+						Name: extAuthzFilterName,
+						TypedConfig: &v1alpha3.ExtAuthzConfig{
+							Type:                extAuthzConfigType,
+							TransportAPIVersion: extAuthzTransportAPIVersion,
+							GRPCService:         grpcService,
 						},
 					},
 				},
 			},
 		},
 	}
+	meta.AddObjectHash(envoyFilter)
+	return envoyFilter
 }
 
 func OidcEnvoyFilterName(gateway *v1alpha2.Gateway) string {
@@ -72,9 +112,12 @@ func RequireOidcEnvoyFilter(gateway *v1alpha2.Gateway) bool {
 	return gateway.Spec.Ingress.IngressExtensions.HasOidc()
 }
 
-func RequireOidcEnvoyFilterUpdate(gateway *v1alpha2.Gateway, envoyFilter *v1alpha3.EnvoyFilter) bool {
+// RequireOidcEnvoyFilterUpdate returns true if the gateway or the EnvoyFilter is changed, or if the desired
+// EnvoyFilter is changed by the istio version of the config.
+func RequireOidcEnvoyFilterUpdate(gateway *v1alpha2.Gateway, desired, envoyFilter *v1alpha3.EnvoyFilter) bool {
 	return gateway.Generation != gateway.Status.ObservedGeneration ||
-		envoyFilter.Generation != gateway.Status.OidcEnvoyFilterGeneration
+		envoyFilter.Generation != gateway.Status.OidcEnvoyFilterGeneration ||
+		!meta.HashEqual(desired, envoyFilter)
 }
 
 func CopyOidcEnvoyFilter(source, destination *v1alpha3.EnvoyFilter) {
@@ -0,0 +1,325 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayapiv1 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1"
+	gatewayapiv1alpha2 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1alpha2"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
+	"cellery.io/cellery-controller/pkg/ptr"
+)
+
+// GatewayAPIParentRef returns the shared Gateway which the routes of the gateway are attached to. The parent
+// set in the cluster ingress of the cell takes precedence over the one configured in the cellery-config as
+// namespace/name.
+func GatewayAPIParentRef(gateway *v1alpha2.Gateway, cfg config.Interface) (gatewayapiv1.ParentReference, error) {
+	if ci := gateway.Spec.Ingress.IngressExtensions.ClusterIngress; ci != nil && ci.ParentRef != nil {
+		ref := gatewayapiv1.ParentReference{
+			Name: gatewayapiv1.ObjectName(ci.ParentRef.Name),
+		}
+		if len(ci.ParentRef.Namespace) > 0 {
+			ns := gatewayapiv1.Namespace(ci.ParentRef.Namespace)
+			ref.Namespace = &ns
+		}
+		return ref, nil
+	}
+	parent := cfg.StringValue(config.ConfigMapKeyGatewayApiParent)
+	if len(parent) == 0 {
+		return gatewayapiv1.ParentReference{}, fmt.Errorf("no parent Gateway is configured for the routes, set %q in the config or the parentRef of the cluster ingress",
+			config.ConfigMapKeyGatewayApiParent)
+	}
+	ref := gatewayapiv1.ParentReference{}
+	if i := strings.Index(parent, "/"); i >= 0 {
+		ns := gatewayapiv1.Namespace(parent[:i])
+		ref.Namespace = &ns
+		parent = parent[i+1:]
+	}
+	ref.Name = gatewayapiv1.ObjectName(parent)
+	return ref, nil
+}
+
+func MakeHTTPRoute(gateway *v1alpha2.Gateway, cfg config.Interface) (*gatewayapiv1.HTTPRoute, error) {
+	parentRef, err := GatewayAPIParentRef(gateway, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if ci := gateway.Spec.Ingress.IngressExtensions.ClusterIngress; ci != nil && ci.ParentRef != nil && len(ci.ParentRef.SectionName) > 0 {
+		sectionName := gatewayapiv1.SectionName(ci.ParentRef.SectionName)
+		parentRef.SectionName = &sectionName
+	}
+
+	pathPrefix := gatewayapiv1.PathMatchPathPrefix
+	var rules []gatewayapiv1.HTTPRouteRule
+	for _, httpRoute := range gateway.Spec.Ingress.HTTPRoutes {
+		path := "/" + strings.Trim(httpRoute.Context, "/")
+		rule := gatewayapiv1.HTTPRouteRule{
+			Matches: []gatewayapiv1.HTTPRouteMatch{
+				{
+					Path: &gatewayapiv1.HTTPPathMatch{
+						Type:  &pathPrefix,
+						Value: &path,
+					},
+				},
+			},
+			Filters: []gatewayapiv1.HTTPRouteFilter{
+				{
+					Type: gatewayapiv1.HTTPRouteFilterURLRewrite,
+					URLRewrite: &gatewayapiv1.HTTPURLRewriteFilter{
+						Path: &gatewayapiv1.HTTPPathModifier{
+							Type:               gatewayapiv1.PrefixMatchHTTPPathModifier,
+							ReplacePrefixMatch: ptr.String("/"),
+						},
+					},
+				},
+			},
+			BackendRefs: []gatewayapiv1.HTTPBackendRef{
+				{
+					BackendRef: makeBackendRef(httpRoute.Destination),
+				},
+			},
+		}
+		if httpRoute.ZeroScale {
+			rule.Filters = append(rule.Filters, gatewayapiv1.HTTPRouteFilter{
+				Type:                  gatewayapiv1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: makeZeroScaleHeaders(gateway, httpRoute.Destination),
+			})
+		}
+		rules = append(rules, rule)
+	}
+
+	return &gatewayapiv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      HTTPRouteName(gateway),
+			Namespace: gateway.Namespace,
+			Labels:    makeLabels(gateway),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		},
+		Spec: gatewayapiv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayapiv1.CommonRouteSpec{
+				ParentRefs: []gatewayapiv1.ParentReference{parentRef},
+			},
+			Hostnames: makeHostnames(gateway),
+			Rules:     rules,
+		},
+	}, nil
+}
+
+// MakeGRPCRoutes creates a GRPCRoute for each gRPC route of the gateway since a GRPCRoute can not match the
+// port of the requests. Each GRPCRoute is attached to the listeners of the parent Gateway on the port of the route.
+func MakeGRPCRoutes(gateway *v1alpha2.Gateway, cfg config.Interface) ([]*gatewayapiv1.GRPCRoute, error) {
+	parentRef, err := GatewayAPIParentRef(gateway, cfg)
+	if err != nil {
+		return nil, err
+	}
+	var grpcRoutes []*gatewayapiv1.GRPCRoute
+	for _, grpcRoute := range gateway.Spec.Ingress.GRPCRoutes {
+		rule := gatewayapiv1.GRPCRouteRule{
+			BackendRefs: []gatewayapiv1.GRPCBackendRef{
+				{
+					BackendRef: makeBackendRef(grpcRoute.Destination),
+				},
+			},
+		}
+		if grpcRoute.ZeroScale {
+			rule.Filters = append(rule.Filters, gatewayapiv1.GRPCRouteFilter{
+				Type:                  gatewayapiv1.GRPCRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: makeZeroScaleHeaders(gateway, grpcRoute.Destination),
+			})
+		}
+		desired := &gatewayapiv1.GRPCRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      GRPCRouteName(gateway, grpcRoute.Port),
+				Namespace: gateway.Namespace,
+				Labels:    makeLabels(gateway),
+				OwnerReferences: []metav1.OwnerReference{
+					*controller.CreateGatewayOwnerRef(gateway),
+				},
+			},
+			Spec: gatewayapiv1.GRPCRouteSpec{
+				CommonRouteSpec: gatewayapiv1.CommonRouteSpec{
+					ParentRefs: []gatewayapiv1.ParentReference{withPort(parentRef, grpcRoute.Port)},
+				},
+				Hostnames: makeHostnames(gateway),
+				Rules:     []gatewayapiv1.GRPCRouteRule{rule},
+			},
+		}
+		meta.AddObjectHash(desired)
+		grpcRoutes = append(grpcRoutes, desired)
+	}
+	return grpcRoutes, nil
+}
+
+// MakeTCPRoutes creates a TCPRoute for each TCP route of the gateway which is attached to the listeners of
+// the parent Gateway on the port of the route.
+func MakeTCPRoutes(gateway *v1alpha2.Gateway, cfg config.Interface) ([]*gatewayapiv1alpha2.TCPRoute, error) {
+	parentRef, err := GatewayAPIParentRef(gateway, cfg)
+	if err != nil {
+		return nil, err
+	}
+	var tcpRoutes []*gatewayapiv1alpha2.TCPRoute
+	for _, tcpRoute := range gateway.Spec.Ingress.TCPRoutes {
+		desired := &gatewayapiv1alpha2.TCPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      TCPRouteName(gateway, tcpRoute.Port),
+				Namespace: gateway.Namespace,
+				Labels:    makeLabels(gateway),
+				OwnerReferences: []metav1.OwnerReference{
+					*controller.CreateGatewayOwnerRef(gateway),
+				},
+			},
+			Spec: gatewayapiv1alpha2.TCPRouteSpec{
+				CommonRouteSpec: gatewayapiv1.CommonRouteSpec{
+					ParentRefs: []gatewayapiv1.ParentReference{withPort(parentRef, tcpRoute.Port)},
+				},
+				Rules: []gatewayapiv1alpha2.TCPRouteRule{
+					{
+						BackendRefs: []gatewayapiv1.BackendRef{makeBackendRef(tcpRoute.Destination)},
+					},
+				},
+			},
+		}
+		meta.AddObjectHash(desired)
+		tcpRoutes = append(tcpRoutes, desired)
+	}
+	return tcpRoutes, nil
+}
+
+// makeBackendRef refers to the Service of the destination. The namespace of the Service is taken from the
+// host if it is qualified as name.namespace.
+func makeBackendRef(destination v1alpha2.Destination) gatewayapiv1.BackendRef {
+	port := gatewayapiv1.PortNumber(destination.Port)
+	ref := gatewayapiv1.BackendRef{
+		BackendObjectReference: gatewayapiv1.BackendObjectReference{
+			Port: &port,
+		},
+	}
+	parts := strings.Split(destination.Host, ".")
+	ref.Name = gatewayapiv1.ObjectName(parts[0])
+	if len(parts) > 1 {
+		ns := gatewayapiv1.Namespace(parts[1])
+		ref.Namespace = &ns
+	}
+	return ref
+}
+
+func makeZeroScaleHeaders(gateway *v1alpha2.Gateway, destination v1alpha2.Destination) *gatewayapiv1.HTTPHeaderFilter {
+	return &gatewayapiv1.HTTPHeaderFilter{
+		Set: []gatewayapiv1.HTTPHeader{
+			{Name: controller.KnativeServingNamespaceHeader, Value: gateway.Namespace},
+			{Name: controller.KnativeServingRevisionHeader, Value: destination.Host},
+		},
+	}
+}
+
+// makeHostnames returns the hosts of the cluster ingress which the routes are limited to.
+func makeHostnames(gateway *v1alpha2.Gateway) []gatewayapiv1.Hostname {
+	ci := gateway.Spec.Ingress.IngressExtensions.ClusterIngress
+	if ci == nil {
+		return nil
+	}
+	var hostnames []gatewayapiv1.Hostname
+	if len(ci.Host) > 0 {
+		hostnames = append(hostnames, gatewayapiv1.Hostname(ci.Host))
+	}
+	for _, h := range ci.Hosts {
+		hostnames = append(hostnames, gatewayapiv1.Hostname(h.Host))
+	}
+	return hostnames
+}
+
+func withPort(ref gatewayapiv1.ParentReference, port uint32) gatewayapiv1.ParentReference {
+	p := gatewayapiv1.PortNumber(port)
+	ref.Port = &p
+	return ref
+}
+
+func HTTPRouteName(gateway *v1alpha2.Gateway) string {
+	return gateway.Name + "-http-route"
+}
+
+func GRPCRouteName(gateway *v1alpha2.Gateway, port uint32) string {
+	return fmt.Sprintf("%s-grpc-route-%d", gateway.Name, port)
+}
+
+func TCPRouteName(gateway *v1alpha2.Gateway, port uint32) string {
+	return fmt.Sprintf("%s-tcp-route-%d", gateway.Name, port)
+}
+
+func RequireHTTPRoute(gateway *v1alpha2.Gateway) bool {
+	return gateway.Spec.Ingress.UsesGatewayAPI() && len(gateway.Spec.Ingress.HTTPRoutes) > 0
+}
+
+func RequireGRPCRoutes(gateway *v1alpha2.Gateway) bool {
+	return gateway.Spec.Ingress.UsesGatewayAPI() && len(gateway.Spec.Ingress.GRPCRoutes) > 0
+}
+
+func RequireTCPRoutes(gateway *v1alpha2.Gateway) bool {
+	return gateway.Spec.Ingress.UsesGatewayAPI() && len(gateway.Spec.Ingress.TCPRoutes) > 0
+}
+
+func RequireHTTPRouteUpdate(gateway *v1alpha2.Gateway, httpRoute *gatewayapiv1.HTTPRoute) bool {
+	return gateway.Generation != gateway.Status.ObservedGeneration ||
+		httpRoute.Generation != gateway.Status.HTTPRouteGeneration
+}
+
+// RequireGRPCRouteUpdate returns true if the GRPCRoute has to be updated. Since there can be many GRPCRoutes,
+// their generations are not tracked in the status, hence the hash of the desired GRPCRoute is compared instead.
+func RequireGRPCRouteUpdate(gateway *v1alpha2.Gateway, desired, grpcRoute *gatewayapiv1.GRPCRoute) bool {
+	return gateway.Generation != gateway.Status.ObservedGeneration ||
+		!meta.HashEqual(desired, grpcRoute)
+}
+
+// RequireTCPRouteUpdate returns true if the TCPRoute has to be updated. Since there can be many TCPRoutes,
+// their generations are not tracked in the status, hence the hash of the desired TCPRoute is compared instead.
+func RequireTCPRouteUpdate(gateway *v1alpha2.Gateway, desired, tcpRoute *gatewayapiv1alpha2.TCPRoute) bool {
+	return gateway.Generation != gateway.Status.ObservedGeneration ||
+		!meta.HashEqual(desired, tcpRoute)
+}
+
+func CopyHTTPRoute(source, destination *gatewayapiv1.HTTPRoute) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
+func CopyGRPCRoute(source, destination *gatewayapiv1.GRPCRoute) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
+func CopyTCPRoute(source, destination *gatewayapiv1alpha2.TCPRoute) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
+func StatusFromHTTPRoute(gateway *v1alpha2.Gateway, httpRoute *gatewayapiv1.HTTPRoute) {
+	gateway.Status.HTTPRouteGeneration = httpRoute.Generation
+}
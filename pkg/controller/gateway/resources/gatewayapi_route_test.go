@@ -0,0 +1,322 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayapiv1 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/ptr"
+)
+
+func testGatewayAPIGateway() *v1alpha2.Gateway {
+	return &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway",
+		},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				Backend: v1alpha2.IngressBackendGatewayAPI,
+				IngressExtensions: v1alpha2.IngressExtensions{
+					ClusterIngress: &v1alpha2.ClusterIngressConfig{
+						Host: "foo.example.com",
+					},
+				},
+				HTTPRoutes: []v1alpha2.HTTPRoute{
+					{
+						Context: "/employee/",
+						Destination: v1alpha2.Destination{
+							Host: "employee-service",
+							Port: 8080,
+						},
+					},
+				},
+				GRPCRoutes: []v1alpha2.GRPCRoute{
+					{
+						Port: 9000,
+						Destination: v1alpha2.Destination{
+							Host: "stock-service.bar-namespace",
+							Port: 9090,
+						},
+					},
+				},
+				TCPRoutes: []v1alpha2.TCPRoute{
+					{
+						Port: 3306,
+						Destination: v1alpha2.Destination{
+							Host: "mysql",
+							Port: 3306,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGatewayAPIParentRef(t *testing.T) {
+	namespace := func(ns string) *gatewayapiv1.Namespace {
+		n := gatewayapiv1.Namespace(ns)
+		return &n
+	}
+	tests := []struct {
+		name      string
+		parentRef *v1alpha2.GatewayParentRef
+		config    map[string]string
+		want      gatewayapiv1.ParentReference
+		wantErr   bool
+	}{
+		{
+			name:   "parent with namespace from the config",
+			config: map[string]string{config.ConfigMapKeyGatewayApiParent: "gateway-system/shared-gateway"},
+			want: gatewayapiv1.ParentReference{
+				Namespace: namespace("gateway-system"),
+				Name:      "shared-gateway",
+			},
+		},
+		{
+			name:   "parent without namespace from the config",
+			config: map[string]string{config.ConfigMapKeyGatewayApiParent: "shared-gateway"},
+			want: gatewayapiv1.ParentReference{
+				Name: "shared-gateway",
+			},
+		},
+		{
+			name: "parent of the cluster ingress overrides the config",
+			parentRef: &v1alpha2.GatewayParentRef{
+				Namespace: "foo-system",
+				Name:      "foo-gateway",
+			},
+			config: map[string]string{config.ConfigMapKeyGatewayApiParent: "gateway-system/shared-gateway"},
+			want: gatewayapiv1.ParentReference{
+				Namespace: namespace("foo-system"),
+				Name:      "foo-gateway",
+			},
+		},
+		{
+			name:    "no parent",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gateway := testGatewayAPIGateway()
+			gateway.Spec.Ingress.IngressExtensions.ClusterIngress.ParentRef = test.parentRef
+			got, err := GatewayAPIParentRef(gateway, fakeconfig.New(test.config))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("GatewayAPIParentRef() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("GatewayAPIParentRef() (-want, +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestMakeHTTPRoute(t *testing.T) {
+	gateway := testGatewayAPIGateway()
+	gateway.Spec.Ingress.IngressExtensions.ClusterIngress.ParentRef = &v1alpha2.GatewayParentRef{
+		Namespace:   "gateway-system",
+		Name:        "shared-gateway",
+		SectionName: "https",
+	}
+	namespace := gatewayapiv1.Namespace("gateway-system")
+	sectionName := gatewayapiv1.SectionName("https")
+	pathPrefix := gatewayapiv1.PathMatchPathPrefix
+	port := gatewayapiv1.PortNumber(8080)
+
+	want := &gatewayapiv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway-http-route",
+			Labels:    makeLabels(gateway),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		},
+		Spec: gatewayapiv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayapiv1.CommonRouteSpec{
+				ParentRefs: []gatewayapiv1.ParentReference{
+					{
+						Namespace:   &namespace,
+						Name:        "shared-gateway",
+						SectionName: &sectionName,
+					},
+				},
+			},
+			Hostnames: []gatewayapiv1.Hostname{"foo.example.com"},
+			Rules: []gatewayapiv1.HTTPRouteRule{
+				{
+					Matches: []gatewayapiv1.HTTPRouteMatch{
+						{
+							Path: &gatewayapiv1.HTTPPathMatch{
+								Type:  &pathPrefix,
+								Value: ptr.String("/employee"),
+							},
+						},
+					},
+					Filters: []gatewayapiv1.HTTPRouteFilter{
+						{
+							Type: gatewayapiv1.HTTPRouteFilterURLRewrite,
+							URLRewrite: &gatewayapiv1.HTTPURLRewriteFilter{
+								Path: &gatewayapiv1.HTTPPathModifier{
+									Type:               gatewayapiv1.PrefixMatchHTTPPathModifier,
+									ReplacePrefixMatch: ptr.String("/"),
+								},
+							},
+						},
+					},
+					BackendRefs: []gatewayapiv1.HTTPBackendRef{
+						{
+							BackendRef: gatewayapiv1.BackendRef{
+								BackendObjectReference: gatewayapiv1.BackendObjectReference{
+									Name: "employee-service",
+									Port: &port,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got, err := MakeHTTPRoute(gateway, fakeconfig.New(nil))
+	if err != nil {
+		t.Fatalf("MakeHTTPRoute() error = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MakeHTTPRoute() (-want, +got)\n%v", diff)
+	}
+}
+
+func TestMakeGRPCAndTCPRoutes(t *testing.T) {
+	gateway := testGatewayAPIGateway()
+	cfg := fakeconfig.New(map[string]string{config.ConfigMapKeyGatewayApiParent: "shared-gateway"})
+
+	grpcRoutes, err := MakeGRPCRoutes(gateway, cfg)
+	if err != nil {
+		t.Fatalf("MakeGRPCRoutes() error = %v", err)
+	}
+	grpcPort := gatewayapiv1.PortNumber(9000)
+	grpcBackendPort := gatewayapiv1.PortNumber(9090)
+	grpcBackendNamespace := gatewayapiv1.Namespace("bar-namespace")
+	wantGRPCSpec := gatewayapiv1.GRPCRouteSpec{
+		CommonRouteSpec: gatewayapiv1.CommonRouteSpec{
+			ParentRefs: []gatewayapiv1.ParentReference{{Name: "shared-gateway", Port: &grpcPort}},
+		},
+		Hostnames: []gatewayapiv1.Hostname{"foo.example.com"},
+		Rules: []gatewayapiv1.GRPCRouteRule{
+			{
+				BackendRefs: []gatewayapiv1.GRPCBackendRef{
+					{
+						BackendRef: gatewayapiv1.BackendRef{
+							BackendObjectReference: gatewayapiv1.BackendObjectReference{
+								Name:      "stock-service",
+								Namespace: &grpcBackendNamespace,
+								Port:      &grpcBackendPort,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if len(grpcRoutes) != 1 || grpcRoutes[0].Name != "foo-gateway-grpc-route-9000" {
+		t.Fatalf("MakeGRPCRoutes() = %v, want a single foo-gateway-grpc-route-9000", grpcRoutes)
+	}
+	if diff := cmp.Diff(wantGRPCSpec, grpcRoutes[0].Spec); diff != "" {
+		t.Errorf("MakeGRPCRoutes() (-want, +got)\n%v", diff)
+	}
+
+	tcpRoutes, err := MakeTCPRoutes(gateway, cfg)
+	if err != nil {
+		t.Fatalf("MakeTCPRoutes() error = %v", err)
+	}
+	tcpPort := gatewayapiv1.PortNumber(3306)
+	wantTCPParentRefs := []gatewayapiv1.ParentReference{{Name: "shared-gateway", Port: &tcpPort}}
+	wantTCPBackendRefs := []gatewayapiv1.BackendRef{
+		{
+			BackendObjectReference: gatewayapiv1.BackendObjectReference{
+				Name: "mysql",
+				Port: &tcpPort,
+			},
+		},
+	}
+	if len(tcpRoutes) != 1 || tcpRoutes[0].Name != "foo-gateway-tcp-route-3306" {
+		t.Fatalf("MakeTCPRoutes() = %v, want a single foo-gateway-tcp-route-3306", tcpRoutes)
+	}
+	if diff := cmp.Diff(wantTCPParentRefs, tcpRoutes[0].Spec.ParentRefs); diff != "" {
+		t.Errorf("MakeTCPRoutes() parentRefs (-want, +got)\n%v", diff)
+	}
+	if diff := cmp.Diff(wantTCPBackendRefs, tcpRoutes[0].Spec.Rules[0].BackendRefs); diff != "" {
+		t.Errorf("MakeTCPRoutes() backendRefs (-want, +got)\n%v", diff)
+	}
+}
+
+func TestRequireGRPCAndTCPRouteUpdate(t *testing.T) {
+	gateway := testGatewayAPIGateway()
+	gateway.Generation = 1
+	gateway.Status.ObservedGeneration = 1
+	cfg := fakeconfig.New(map[string]string{config.ConfigMapKeyGatewayApiParent: "shared-gateway"})
+	changedCfg := fakeconfig.New(map[string]string{config.ConfigMapKeyGatewayApiParent: "other-gateway"})
+
+	grpcRoutes, _ := MakeGRPCRoutes(gateway, cfg)
+	tcpRoutes, _ := MakeTCPRoutes(gateway, cfg)
+	if RequireGRPCRouteUpdate(gateway, grpcRoutes[0], grpcRoutes[0].DeepCopy()) {
+		t.Errorf("an unchanged GRPCRoute requires an update")
+	}
+	if RequireTCPRouteUpdate(gateway, tcpRoutes[0], tcpRoutes[0].DeepCopy()) {
+		t.Errorf("an unchanged TCPRoute requires an update")
+	}
+
+	// Only the parent Gateway in the cellery-config is changed
+	changedGRPCRoutes, _ := MakeGRPCRoutes(gateway, changedCfg)
+	changedTCPRoutes, _ := MakeTCPRoutes(gateway, changedCfg)
+	if !RequireGRPCRouteUpdate(gateway, changedGRPCRoutes[0], grpcRoutes[0]) {
+		t.Errorf("a GRPCRoute with a changed parent does not require an update")
+	}
+	if !RequireTCPRouteUpdate(gateway, changedTCPRoutes[0], tcpRoutes[0]) {
+		t.Errorf("a TCPRoute with a changed parent does not require an update")
+	}
+}
+
+func TestRequireGatewayAPIRoutes(t *testing.T) {
+	gateway := testGatewayAPIGateway()
+	if !RequireHTTPRoute(gateway) || !RequireGRPCRoutes(gateway) || !RequireTCPRoutes(gateway) {
+		t.Errorf("the routes of the GatewayAPI backend are not required")
+	}
+	if RequireIstioGateway(gateway) || RequireVirtualService(gateway) || RequireClusterIngress(gateway) {
+		t.Errorf("the Istio resources are required with the GatewayAPI backend")
+	}
+
+	gateway.Spec.Ingress.Backend = v1alpha2.IngressBackendIstio
+	if RequireHTTPRoute(gateway) || RequireGRPCRoutes(gateway) || RequireTCPRoutes(gateway) {
+		t.Errorf("the routes of the GatewayAPI backend are required with the Istio backend")
+	}
+}
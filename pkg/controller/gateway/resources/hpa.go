@@ -20,60 +20,72 @@ package resources
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
-	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
 func RequireHpa(gw *v1alpha2.Gateway) bool {
 	return gw.IsHpa()
 }
 
-func MakeHpa(gw *v1alpha2.Gateway) *autoscalingv2beta1.HorizontalPodAutoscaler {
-	return &autoscalingv2beta1.HorizontalPodAutoscaler{
+func MakeHpa(gw *v1alpha2.Gateway) *autoscalingv2.HorizontalPodAutoscaler {
+	return &autoscalingv2.HorizontalPodAutoscaler{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      HpaName(gw),
-			Namespace: gw.Namespace,
-			Labels:    makeLabels(gw),
+			Name:        HpaName(gw),
+			Namespace:   gw.Namespace,
+			Labels:      makeLabels(gw),
+			Annotations: makeHpaAnnotations(gw),
 			OwnerReferences: []metav1.OwnerReference{
-				*controller.CreateComponentOwnerRef(gw),
+				*controller.CreateGatewayOwnerRef(gw),
 			},
 		},
-		Spec: autoscalingv2beta1.HorizontalPodAutoscalerSpec{
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
 			MinReplicas:    gw.MinReplicas(),
 			MaxReplicas:    gw.MaxReplicas(),
 			ScaleTargetRef: makeTargetRef(gw),
-			Metrics:        gw.Metrics(),
+			Metrics:        controller.ConvertMetrics(gw.Metrics()),
+			Behavior:       gw.Behavior(),
 		},
 	}
 }
 
-func makeTargetRef(gw *v1alpha2.Gateway) autoscalingv2beta1.CrossVersionObjectReference {
+func makeTargetRef(gw *v1alpha2.Gateway) autoscalingv2.CrossVersionObjectReference {
 	kind := "Deployment"
 	name := DeploymentName(gw)
-	return autoscalingv2beta1.CrossVersionObjectReference{
+	return autoscalingv2.CrossVersionObjectReference{
 		Kind:       kind,
 		Name:       name,
 		APIVersion: appsv1.SchemeGroupVersion.String(),
 	}
 }
 
-func RequireHpaUpdate(gw *v1alpha2.Gateway, hpa *autoscalingv2beta1.HorizontalPodAutoscaler) bool {
-	return gw.Generation != gw.Status.ObservedGeneration ||
-		hpa.Generation != gw.Status.HpaGeneration
+// RequireHpaUpdate returns true if the HPA is outdated. HPAs created by earlier versions are controlled
+// through a Component owner reference which the garbage collector cannot resolve, hence they are
+// updated to be controlled through a Gateway owner reference.
+func RequireHpaUpdate(gw *v1alpha2.Gateway, hpa *autoscalingv2.HorizontalPodAutoscaler) bool {
+	ref := metav1.GetControllerOf(hpa)
+	return ref == nil || ref.Kind != "Gateway" ||
+		gw.Generation != gw.Status.ObservedGeneration ||
+		hpa.Generation != gw.Status.HpaGeneration ||
+		hpa.Annotations[meta.ActiveScheduleAnnotationKey] != gw.Status.ActiveSchedule ||
+		hpa.Annotations[meta.AutoscaleOverrideAnnotationKey] != makeHpaAnnotations(gw)[meta.AutoscaleOverrideAnnotationKey]
 }
 
-func CopyHpa(source, destination *autoscalingv2beta1.HorizontalPodAutoscaler) {
+func CopyHpa(source, destination *autoscalingv2.HorizontalPodAutoscaler) {
 	destination.Spec.ScaleTargetRef = source.Spec.ScaleTargetRef
 	destination.Spec.MinReplicas = source.Spec.MinReplicas
 	destination.Spec.MaxReplicas = source.Spec.MaxReplicas
 	destination.Spec.Metrics = source.Spec.Metrics
+	destination.Spec.Behavior = source.Spec.Behavior
 	destination.Labels = source.Labels
 	destination.Annotations = source.Annotations
+	destination.OwnerReferences = source.OwnerReferences
 }
 
-func StatusFromHpa(gw *v1alpha2.Gateway, hpa *autoscalingv2beta1.HorizontalPodAutoscaler) {
+func StatusFromHpa(gw *v1alpha2.Gateway, hpa *autoscalingv2.HorizontalPodAutoscaler) {
 	gw.Status.HpaGeneration = hpa.Generation
 }
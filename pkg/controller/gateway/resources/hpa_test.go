@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingV2Beta1 "k8s.io/api/autoscaling/v2beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/ptr"
+)
+
+func TestMakeHpa(t *testing.T) {
+	gw := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cell-1-gw",
+			Namespace: "default",
+		},
+		Spec: v1alpha2.GatewaySpec{
+			ScalingPolicy: v1alpha2.GwScalingPolicy{
+				Hpa: &v1alpha2.HorizontalPodAutoscaler{
+					Overridable: ptr.Bool(true),
+					ReplicaRange: v1alpha2.ReplicaRange{
+						MinReplicas: ptr.Int32(1),
+						MaxReplicas: 5,
+					},
+					Metrics: []autoscalingV2Beta1.MetricSpec{
+						{
+							Type: autoscalingV2Beta1.ResourceMetricSourceType,
+							Resource: &autoscalingV2Beta1.ResourceMetricSource{
+								Name:                     "cpu",
+								TargetAverageUtilization: ptr.Int32(50),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	hpa := MakeHpa(gw)
+
+	expected := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      HpaName(gw),
+			Namespace: gw.Namespace,
+			Labels:    makeLabels(gw),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gw),
+			},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       DeploymentName(gw),
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+			},
+			MinReplicas: ptr.Int32(1),
+			MaxReplicas: 5,
+			Metrics: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name: "cpu",
+						Target: autoscalingv2.MetricTarget{
+							Type:               autoscalingv2.UtilizationMetricType,
+							AverageUtilization: ptr.Int32(50),
+						},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, hpa); diff != "" {
+		t.Errorf("MakeHpa (-expected, +actual)\n%v", diff)
+	}
+}
+
+func TestMakeHpaWithoutHpaPolicy(t *testing.T) {
+	gw := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cell-1-gw",
+			Namespace: "default",
+		},
+		Spec: v1alpha2.GatewaySpec{},
+	}
+	hpa := MakeHpa(gw)
+
+	expected := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      HpaName(gw),
+			Namespace: gw.Namespace,
+			Labels:    makeLabels(gw),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gw),
+			},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       DeploymentName(gw),
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+			},
+			MaxReplicas: 1,
+		},
+	}
+	if diff := cmp.Diff(expected, hpa); diff != "" {
+		t.Errorf("MakeHpa (-expected, +actual)\n%v", diff)
+	}
+}
+
+func TestHpaName(t *testing.T) {
+	gw := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cell-1-gw",
+			Namespace: "default",
+		},
+	}
+	name := HpaName(gw)
+	expected := gw.Name + "-hpa"
+	if name != expected {
+		t.Errorf("HpaName incorrect, got: %v, expected: %v", name, expected)
+	}
+}
+
+func TestRequireHpaUpdateWithComponentOwnerRef(t *testing.T) {
+	gw := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cell-1-gw",
+			Namespace: "default",
+			UID:       "1",
+		},
+		Spec: v1alpha2.GatewaySpec{
+			ScalingPolicy: v1alpha2.GwScalingPolicy{
+				Hpa: &v1alpha2.HorizontalPodAutoscaler{
+					ReplicaRange: v1alpha2.ReplicaRange{
+						MinReplicas: ptr.Int32(1),
+						MaxReplicas: 5,
+					},
+				},
+			},
+		},
+	}
+	if RequireHpaUpdate(gw, MakeHpa(gw)) {
+		t.Errorf("RequireHpaUpdate() = true for an up to date HPA")
+	}
+
+	legacy := MakeHpa(gw)
+	legacy.OwnerReferences = []metav1.OwnerReference{*controller.CreateComponentOwnerRef(gw)}
+	if !RequireHpaUpdate(gw, legacy) {
+		t.Fatalf("RequireHpaUpdate() = false for an HPA controlled through a Component owner reference")
+	}
+	CopyHpa(MakeHpa(gw), legacy)
+	if diff := cmp.Diff([]metav1.OwnerReference{*controller.CreateGatewayOwnerRef(gw)}, legacy.OwnerReferences); diff != "" {
+		t.Errorf("CopyHpa owner references (-expected, +actual)\n%v", diff)
+	}
+	if RequireHpaUpdate(gw, legacy) {
+		t.Errorf("RequireHpaUpdate() = true after the owner reference is migrated")
+	}
+}
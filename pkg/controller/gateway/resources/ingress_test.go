@@ -1,5 +1,5 @@
 /*
- * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ * Copyright (c) 2018 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
  *
  * WSO2 Inc. licenses this file to you under the Apache License,
  * Version 2.0 (the "License"); you may not use this file except
@@ -26,191 +26,158 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
-	"cellery.io/cellery-controller/pkg/apis/mesh"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/controller"
 )
 
 func TestCreateClusterIngress(t *testing.T) {
+	makeGateway := func(extensions v1alpha2.IngressExtensions) *v1alpha2.Gateway {
+		return &v1alpha2.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo-namespace",
+				Name:      "foo",
+			},
+			Spec: v1alpha2.GatewaySpec{
+				Ingress: v1alpha2.Ingress{
+					IngressExtensions: extensions,
+				},
+			},
+		}
+	}
+	objectMeta := func(gateway *v1alpha2.Gateway) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-ingress",
+			Labels:    makeLabels(gateway),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		}
+	}
+	rules := func(paths ...v1beta1.HTTPIngressPath) []v1beta1.IngressRule {
+		return []v1beta1.IngressRule{
+			{
+				Host: "my-host.com",
+				IngressRuleValue: v1beta1.IngressRuleValue{
+					HTTP: &v1beta1.HTTPIngressRuleValue{
+						Paths: paths,
+					},
+				},
+			},
+		}
+	}
+	rootPath := v1beta1.HTTPIngressPath{
+		Path: "/",
+		Backend: v1beta1.IngressBackend{
+			ServiceName: "foo-service",
+			ServicePort: intstr.IntOrString{Type: intstr.Int, IntVal: 80},
+		},
+	}
+
+	withoutTls := makeGateway(v1alpha2.IngressExtensions{
+		ClusterIngress: &v1alpha2.ClusterIngressConfig{
+			Host: "my-host.com",
+		},
+	})
+	withOidc := makeGateway(v1alpha2.IngressExtensions{
+		ClusterIngress: &v1alpha2.ClusterIngressConfig{
+			Host: "my-host.com",
+		},
+		OidcConfig: &v1alpha2.OidcConfig{
+			ProviderUrl:    "http://provider.com",
+			ClientId:       "cid",
+			ClientSecret:   "secret",
+			BaseUrl:        "http://example.com",
+			NonSecurePaths: []string{"/foo1", "/foo2"},
+			SubjectClaim:   "claim",
+			RedirectUrl:    "http://example.com",
+			DcrUser:        "dcr-user",
+			DcrPassword:    "dcr-pass",
+			DcrUrl:         "http://dcr-url",
+			SecurePaths:    []string{"/bar1", "/bar2"},
+		},
+	})
+	withTlsSecret := makeGateway(v1alpha2.IngressExtensions{
+		ClusterIngress: &v1alpha2.ClusterIngressConfig{
+			Host: "my-host.com",
+			Tls: v1alpha2.TlsConfig{
+				Secret: "my-secret",
+			},
+		},
+	})
+	withTlsCertAndKey := makeGateway(v1alpha2.IngressExtensions{
+		ClusterIngress: &v1alpha2.ClusterIngressConfig{
+			Host: "my-host.com",
+			Tls: v1alpha2.TlsConfig{
+				Key:  "my-key",
+				Cert: "my-cert",
+			},
+		},
+	})
+
 	tests := []struct {
 		name    string
-		gateway *v1alpha1.Gateway
+		gateway *v1alpha2.Gateway
 		want    *v1beta1.Ingress
 	}{
 		{
-			name: "foo gateway without spec",
-			gateway: &v1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-				Spec: v1alpha1.GatewaySpec{
-					Host: "my-host.com",
+			name:    "foo gateway without tls",
+			gateway: withoutTls,
+			want: &v1beta1.Ingress{
+				ObjectMeta: objectMeta(withoutTls),
+				Spec: v1beta1.IngressSpec{
+					Rules: rules(rootPath),
 				},
 			},
+		},
+		{
+			name:    "foo gateway with oidc config",
+			gateway: withOidc,
 			want: &v1beta1.Ingress{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-ingress",
-					Labels: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "Gateway",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
-				},
+				ObjectMeta: objectMeta(withOidc),
 				Spec: v1beta1.IngressSpec{
-					Rules: []v1beta1.IngressRule{
-						{
-							Host: "my-host.com",
-							IngressRuleValue: v1beta1.IngressRuleValue{
-								HTTP: &v1beta1.HTTPIngressRuleValue{
-									Paths: []v1beta1.HTTPIngressPath{
-										{
-											Path: "/",
-											Backend: v1beta1.IngressBackend{
-												ServiceName: "foo-service",
-												ServicePort: intstr.IntOrString{Type: intstr.Int, IntVal: 80},
-											},
-										},
-									},
-								},
+					Rules: rules(
+						rootPath,
+						v1beta1.HTTPIngressPath{
+							Path: "/_auth",
+							Backend: v1beta1.IngressBackend{
+								ServiceName: "foo-service",
+								ServicePort: intstr.IntOrString{Type: intstr.Int, IntVal: 15810},
 							},
 						},
-					},
+					),
 				},
 			},
 		},
 		{
-			name: "foo gateway with oidc config",
-			gateway: &v1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-				Spec: v1alpha1.GatewaySpec{
-					Type: v1alpha1.GatewayTypeMicroGateway,
-					Host: "my-host.com",
-					OidcConfig: &v1alpha1.OidcConfig{
-						ProviderUrl:    "http://provider.com",
-						ClientId:       "cid",
-						ClientSecret:   "secret",
-						BaseUrl:        "http://example.com",
-						NonSecurePaths: []string{"/foo1", "/foo2"},
-						SubjectClaim:   "claim",
-						RedirectUrl:    "http://example.com",
-						DcrUser:        "dcr-user",
-						DcrPassword:    "dcr-pass",
-						DcrUrl:         "http://dcr-url",
-						SecurePaths:    []string{"/bar1", "/bar2"},
-					},
-				},
-			},
+			name:    "foo gateway with tls secret",
+			gateway: withTlsSecret,
 			want: &v1beta1.Ingress{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-ingress",
-					Labels: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "Gateway",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
-				},
+				ObjectMeta: objectMeta(withTlsSecret),
 				Spec: v1beta1.IngressSpec{
-					Rules: []v1beta1.IngressRule{
+					TLS: []v1beta1.IngressTLS{
 						{
-							Host: "my-host.com",
-							IngressRuleValue: v1beta1.IngressRuleValue{
-								HTTP: &v1beta1.HTTPIngressRuleValue{
-									Paths: []v1beta1.HTTPIngressPath{
-										{
-											Path: "/",
-											Backend: v1beta1.IngressBackend{
-												ServiceName: "foo-service",
-												ServicePort: intstr.IntOrString{Type: intstr.Int, IntVal: 80},
-											},
-										},
-										{
-											Path: "/_auth",
-											Backend: v1beta1.IngressBackend{
-												ServiceName: "foo-service",
-												ServicePort: intstr.IntOrString{Type: intstr.Int, IntVal: 15810},
-											},
-										},
-									},
-								},
-							},
+							Hosts:      []string{"my-host.com"},
+							SecretName: "my-secret",
 						},
 					},
+					Rules: rules(rootPath),
 				},
 			},
 		},
 		{
-			name: "foo gateway with tls secret",
-			gateway: &v1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-				Spec: v1alpha1.GatewaySpec{
-					Type: v1alpha1.GatewayTypeMicroGateway,
-					Host: "my-host.com",
-					Tls: v1alpha1.TlsConfig{
-						Secret: "my-secret",
-					},
-				},
-			},
+			name:    "foo gateway with tls cert and key",
+			gateway: withTlsCertAndKey,
 			want: &v1beta1.Ingress{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-ingress",
-					Labels: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "Gateway",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
-				},
+				ObjectMeta: objectMeta(withTlsCertAndKey),
 				Spec: v1beta1.IngressSpec{
 					TLS: []v1beta1.IngressTLS{
 						{
 							Hosts:      []string{"my-host.com"},
-							SecretName: "my-secret",
-						},
-					},
-					Rules: []v1beta1.IngressRule{
-						{
-							Host: "my-host.com",
-							IngressRuleValue: v1beta1.IngressRuleValue{
-								HTTP: &v1beta1.HTTPIngressRuleValue{
-									Paths: []v1beta1.HTTPIngressPath{
-										{
-											Path: "/",
-											Backend: v1beta1.IngressBackend{
-												ServiceName: "foo-service",
-												ServicePort: intstr.IntOrString{Type: intstr.Int, IntVal: 80},
-											},
-										},
-									},
-								},
-							},
+							SecretName: "foo-ingress-secret",
 						},
 					},
+					Rules: rules(rootPath),
 				},
 			},
 		},
@@ -218,9 +185,9 @@ func TestCreateClusterIngress(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := CreateClusterIngress(test.gateway)
+			got := MakeLegacyClusterIngress(test.gateway)
 			if diff := cmp.Diff(test.want, got); diff != "" {
-				t.Errorf("CreateGatewayK8sService (-want, +got)\n%v", diff)
+				t.Errorf("MakeLegacyClusterIngress (-want, +got)\n%v", diff)
 			}
 		})
 	}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	istiosecurityv1beta1 "cellery.io/cellery-controller/pkg/apis/istio/security/v1beta1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
+)
+
+// MakeAuthorizationPolicy denies the requests to the authenticated routes of the gateway which do not carry
+// a token validated by the RequestAuthentication.
+func MakeAuthorizationPolicy(gateway *v1alpha2.Gateway) *istiosecurityv1beta1.AuthorizationPolicy {
+	var paths []string
+	for _, r := range gateway.Spec.Ingress.HTTPRoutes {
+		if r.Authenticate {
+			path := "/" + strings.Trim(r.Context, "/")
+			paths = append(paths, path, path+"/*")
+		}
+	}
+	return &istiosecurityv1beta1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      AuthorizationPolicyName(gateway),
+			Namespace: gateway.Namespace,
+			Labels:    makeLabels(gateway),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		},
+		Spec: istiosecurityv1beta1.AuthorizationPolicySpec{
+			Selector: &istiosecurityv1beta1.WorkloadSelector{
+				MatchLabels: makeLabels(gateway),
+			},
+			Action: istiosecurityv1beta1.AuthorizationPolicyActionDeny,
+			Rules: []istiosecurityv1beta1.Rule{
+				{
+					From: []istiosecurityv1beta1.RuleFrom{
+						{
+							Source: &istiosecurityv1beta1.Source{
+								NotRequestPrincipals: []string{"*"},
+							},
+						},
+					},
+					To: []istiosecurityv1beta1.RuleTo{
+						{
+							Operation: &istiosecurityv1beta1.Operation{
+								Paths: paths,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func RequireAuthorizationPolicy(gateway *v1alpha2.Gateway, cfg config.Interface) bool {
+	return hasAuthenticatedRoutes(gateway) && controller.UseIstioSecurityPolicies(cfg)
+}
+
+func RequireAuthorizationPolicyUpdate(gateway *v1alpha2.Gateway, authorizationPolicy *istiosecurityv1beta1.AuthorizationPolicy) bool {
+	return gateway.Generation != gateway.Status.ObservedGeneration ||
+		authorizationPolicy.Generation != gateway.Status.AuthorizationPolicyGeneration
+}
+
+func CopyAuthorizationPolicy(source, destination *istiosecurityv1beta1.AuthorizationPolicy) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
+func StatusFromAuthorizationPolicy(gateway *v1alpha2.Gateway, authorizationPolicy *istiosecurityv1beta1.AuthorizationPolicy) {
+	gateway.Status.AuthorizationPolicyGeneration = authorizationPolicy.Generation
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	istiosecurityv1beta1 "cellery.io/cellery-controller/pkg/apis/istio/security/v1beta1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	"cellery.io/cellery-controller/pkg/controller"
+)
+
+func testAuthenticatedGateway() *v1alpha2.Gateway {
+	return &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway",
+		},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				HTTPRoutes: []v1alpha2.HTTPRoute{
+					{
+						Context:      "/orders",
+						Authenticate: true,
+					},
+					{
+						Context: "/health",
+					},
+					{
+						Context:      "payments",
+						Authenticate: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestMakeRequestAuthentication(t *testing.T) {
+	gateway := testAuthenticatedGateway()
+	cfg := fakeconfig.New(map[string]string{
+		config.ConfigMapKeyJwtIssuer: "https://idp.example.com",
+		config.ConfigMapKeyJwksUri:   "https://idp.example.com/jwks",
+	})
+
+	want := &istiosecurityv1beta1.RequestAuthentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway-authn",
+			Labels:    makeLabels(gateway),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		},
+		Spec: istiosecurityv1beta1.RequestAuthenticationSpec{
+			Selector: &istiosecurityv1beta1.WorkloadSelector{
+				MatchLabels: makeLabels(gateway),
+			},
+			JwtRules: []istiosecurityv1beta1.JWTRule{
+				{
+					Issuer:               "https://idp.example.com",
+					JwksUri:              "https://idp.example.com/jwks",
+					ForwardOriginalToken: true,
+				},
+			},
+		},
+	}
+	got := MakeRequestAuthentication(gateway, cfg)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MakeRequestAuthentication (-want, +got)\n%v", diff)
+	}
+}
+
+func TestMakeAuthorizationPolicy(t *testing.T) {
+	gateway := testAuthenticatedGateway()
+
+	want := &istiosecurityv1beta1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway-authz",
+			Labels:    makeLabels(gateway),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		},
+		Spec: istiosecurityv1beta1.AuthorizationPolicySpec{
+			Selector: &istiosecurityv1beta1.WorkloadSelector{
+				MatchLabels: makeLabels(gateway),
+			},
+			Action: istiosecurityv1beta1.AuthorizationPolicyActionDeny,
+			Rules: []istiosecurityv1beta1.Rule{
+				{
+					From: []istiosecurityv1beta1.RuleFrom{
+						{
+							Source: &istiosecurityv1beta1.Source{
+								NotRequestPrincipals: []string{"*"},
+							},
+						},
+					},
+					To: []istiosecurityv1beta1.RuleTo{
+						{
+							Operation: &istiosecurityv1beta1.Operation{
+								Paths: []string{"/orders", "/orders/*", "/payments", "/payments/*"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	got := MakeAuthorizationPolicy(gateway)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MakeAuthorizationPolicy (-want, +got)\n%v", diff)
+	}
+}
+
+func TestRequireAuthorizationPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		gateway *v1alpha2.Gateway
+		config  map[string]string
+		want    bool
+	}{
+		{
+			name:    "authenticated routes",
+			gateway: testAuthenticatedGateway(),
+			want:    true,
+		},
+		{
+			name:    "authenticated routes prior to istio 1.5",
+			gateway: testAuthenticatedGateway(),
+			config:  map[string]string{config.ConfigMapKeyIstioVersion: "1.2.2"},
+			want:    false,
+		},
+		{
+			name: "no authenticated routes",
+			gateway: &v1alpha2.Gateway{
+				Spec: v1alpha2.GatewaySpec{
+					Ingress: v1alpha2.Ingress{
+						HTTPRoutes: []v1alpha2.HTTPRoute{{Context: "/health"}},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := fakeconfig.New(test.config)
+			if got := RequireAuthorizationPolicy(test.gateway, cfg); got != test.want {
+				t.Errorf("RequireAuthorizationPolicy() = %v, want %v", got, test.want)
+			}
+			if got := RequireRequestAuthentication(test.gateway, cfg); got != test.want {
+				t.Errorf("RequireRequestAuthentication() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -18,43 +18,100 @@
 
 package resources
 
-// import (
-// 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-
-// 	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
-// 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
-// 	"cellery.io/cellery-controller/pkg/controller"
-// )
-
-// func CreateIstioDestinationRule(gateway *v1alpha1.Gateway) *v1alpha3.DestinationRule {
-
-// 	return &v1alpha3.DestinationRule{
-// 		ObjectMeta: metav1.ObjectMeta{
-// 			Name:      IstioDestinationRuleName(gateway),
-// 			Namespace: gateway.Namespace,
-// 			Labels:    createGatewayLabels(gateway),
-// 			OwnerReferences: []metav1.OwnerReference{
-// 				*controller.CreateServiceOwnerRef(gateway),
-// 			},
-// 		},
-
-// 		Spec: v1alpha3.DestinationRuleSpec{
-// 			Host: GatewayK8sServiceName(gateway),
-// 			TrafficPolicy: &v1alpha3.TrafficPolicy{
-// 				LoadBalancer: &v1alpha3.LoadBalancerSettings{
-// 					Simple: "ROUND_ROBIN",
-// 				},
-// 				PortLevelSettings: []*v1alpha3.TrafficPolicy_PortTrafficPolicy{
-// 					{
-// 						Port: &v1alpha3.PortSelector{
-// 							Number: 443,
-// 						},
-// 						Tls: &v1alpha3.TLSSettings{
-// 							Mode: "ISTIO_MUTUAL",
-// 						},
-// 					},
-// 				},
-// 			},
-// 		},
-// 	}
-// }
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+// MakeIstioDestinationRules creates a DestinationRule for each destination host of the routes which has a
+// traffic policy. The routes to the same host are validated to have the same policy, so the policy of the
+// first route is used.
+func MakeIstioDestinationRules(gateway *v1alpha2.Gateway, cfg config.Interface) ([]*v1alpha3.DestinationRule, error) {
+	var destinationRules []*v1alpha3.DestinationRule
+	seen := make(map[string]bool)
+	for _, destination := range routeDestinations(gateway) {
+		if destination.TrafficPolicy == nil || seen[destination.Host] {
+			continue
+		}
+		seen[destination.Host] = true
+		trafficPolicy, err := controller.MakeIstioTrafficPolicy(destination.TrafficPolicy, cfg)
+		if err != nil {
+			return nil, err
+		}
+		destinationRule := &v1alpha3.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      IstioDestinationRuleName(gateway, destination.Host),
+				Namespace: gateway.Namespace,
+				Labels:    makeLabels(gateway),
+				OwnerReferences: []metav1.OwnerReference{
+					*controller.CreateGatewayOwnerRef(gateway),
+				},
+			},
+			Spec: v1alpha3.DestinationRuleSpec{
+				Host:          destinationFqdn(gateway, destination.Host),
+				TrafficPolicy: trafficPolicy,
+			},
+		}
+		meta.AddObjectHash(destinationRule)
+		destinationRules = append(destinationRules, destinationRule)
+	}
+	return destinationRules, nil
+}
+
+func routeDestinations(gateway *v1alpha2.Gateway) []v1alpha2.Destination {
+	var destinations []v1alpha2.Destination
+	for _, r := range gateway.Spec.Ingress.HTTPRoutes {
+		destinations = append(destinations, r.Destination)
+	}
+	for _, r := range gateway.Spec.Ingress.GRPCRoutes {
+		destinations = append(destinations, r.Destination)
+	}
+	for _, r := range gateway.Spec.Ingress.TCPRoutes {
+		destinations = append(destinations, r.Destination)
+	}
+	return destinations
+}
+
+// destinationFqdn qualifies the host of a destination since Istio interprets the short names in the
+// namespace of the DestinationRule. A host qualified as name.namespace is completed with the cluster domain.
+func destinationFqdn(gateway *v1alpha2.Gateway, host string) string {
+	switch strings.Count(host, ".") {
+	case 0:
+		return host + "." + gateway.Namespace + ".svc.cluster.local"
+	case 1:
+		return host + ".svc.cluster.local"
+	default:
+		return host
+	}
+}
+
+func RequireIstioDestinationRules(gateway *v1alpha2.Gateway) bool {
+	for _, destination := range routeDestinations(gateway) {
+		if destination.TrafficPolicy != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireIstioDestinationRuleUpdate returns true if the DestinationRule differs from the desired one. Since there
+// can be many of them, their generations are not tracked in the status. Instead, the hash of the desired
+// DestinationRule detects the changes of the gateway and the config, while the spec is compared to undo the
+// changes made to the DestinationRule.
+func RequireIstioDestinationRuleUpdate(desired, destinationRule *v1alpha3.DestinationRule) bool {
+	return !meta.HashEqual(desired, destinationRule) || !equality.Semantic.DeepEqual(desired.Spec, destinationRule.Spec)
+}
+
+func CopyIstioDestinationRule(source, destination *v1alpha3.DestinationRule) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
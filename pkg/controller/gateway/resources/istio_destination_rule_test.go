@@ -20,69 +20,152 @@ package resources
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
-func TestCreateIstioDestinationRule(t *testing.T) {
-	gateway := &v1alpha1.Gateway{
-		Spec: v1alpha1.GatewaySpec{
-			Type: v1alpha1.GatewayTypeMicroGateway,
-			Host: "test.com",
-			HTTPRoutes: []v1alpha1.HTTPRoute{
-				{
-					Authenticate: true,
-					Global:       true,
-					Backend:      "mytestservice",
-					Context:      "hello",
-					Definitions: []v1alpha1.APIDefinition{
-						{
-							Path:   "sayHello",
-							Method: "GET",
+func TestMakeIstioDestinationRules(t *testing.T) {
+	policy := &v1alpha2.TrafficPolicy{
+		ConnectionPool: &v1alpha2.ConnectionPool{
+			MaxConnections: 10,
+		},
+	}
+	gateway := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway",
+		},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				HTTPRoutes: []v1alpha2.HTTPRoute{
+					{
+						Context:     "/foo",
+						Destination: v1alpha2.Destination{Host: "foo-service", Port: 8080, TrafficPolicy: policy},
+					},
+					{
+						Context:     "/foo-v2",
+						Destination: v1alpha2.Destination{Host: "foo-service", Port: 8080, TrafficPolicy: policy},
+					},
+					{
+						Context:     "/bar",
+						Destination: v1alpha2.Destination{Host: "bar-service", Port: 8080},
+					},
+				},
+				TCPRoutes: []v1alpha2.TCPRoute{
+					{
+						Port: 3306,
+						Destination: v1alpha2.Destination{
+							Host: "mysql.db",
+							Port: 3306,
+							TrafficPolicy: &v1alpha2.TrafficPolicy{
+								OutlierDetection: &v1alpha2.OutlierDetection{
+									BaseEjectionTime: &metav1.Duration{Duration: time.Minute},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 	}
+	cfg := fakeconfig.New(map[string]string{
+		config.ConfigMapKeyTrafficPolicy: `{"loadBalancer": "LEAST_CONN"}`,
+	})
 
-	destinationRule := CreateIstioDestinationRule(gateway)
-	expected := &v1alpha3.DestinationRule{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      IstioDestinationRuleName(gateway),
-			Namespace: gateway.Namespace,
-			Labels:    createGatewayLabels(gateway),
+	got, err := MakeIstioDestinationRules(gateway, cfg)
+	if err != nil {
+		t.Fatalf("MakeIstioDestinationRules() error = %v", err)
+	}
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "foo-namespace",
+			Labels:    makeLabels(gateway),
 			OwnerReferences: []metav1.OwnerReference{
-				*controller.CreateServiceOwnerRef(gateway),
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		}
+	}
+	want := []*v1alpha3.DestinationRule{
+		{
+			ObjectMeta: objectMeta("foo-gateway-foo-service-destination-rule"),
+			Spec: v1alpha3.DestinationRuleSpec{
+				Host: "foo-service.foo-namespace.svc.cluster.local",
+				TrafficPolicy: &v1alpha3.TrafficPolicy{
+					LoadBalancer: &v1alpha3.LoadBalancerSettings{Simple: "LEAST_CONN"},
+					ConnectionPool: &v1alpha3.ConnectionPoolSettings{
+						Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{MaxConnections: 10},
+					},
+				},
 			},
 		},
-
-		Spec: v1alpha3.DestinationRuleSpec{
-			Host: GatewayK8sServiceName(gateway),
-			TrafficPolicy: &v1alpha3.TrafficPolicy{
-				LoadBalancer: &v1alpha3.LoadBalancerSettings{
-					Simple: "ROUND_ROBIN",
+		{
+			ObjectMeta: objectMeta("foo-gateway-mysql-db-destination-rule"),
+			Spec: v1alpha3.DestinationRuleSpec{
+				Host: "mysql.db.svc.cluster.local",
+				TrafficPolicy: &v1alpha3.TrafficPolicy{
+					LoadBalancer:     &v1alpha3.LoadBalancerSettings{Simple: "LEAST_CONN"},
+					OutlierDetection: &v1alpha3.OutlierDetection{BaseEjectionTime: "60s"},
 				},
-				PortLevelSettings: []*v1alpha3.TrafficPolicy_PortTrafficPolicy{
-					{
-						Port: &v1alpha3.PortSelector{
-							Number: 443,
-						},
-						Tls: &v1alpha3.TLSSettings{
-							Mode: "ISTIO_MUTUAL",
-						},
-					},
+			},
+		},
+	}
+	for _, destinationRule := range want {
+		meta.AddObjectHash(destinationRule)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MakeIstioDestinationRules (-want, +got)\n%v", diff)
+	}
+}
+
+func TestRequireIstioDestinationRules(t *testing.T) {
+	gateway := &v1alpha2.Gateway{
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				GRPCRoutes: []v1alpha2.GRPCRoute{
+					{Port: 9090, Destination: v1alpha2.Destination{Host: "foo-service", Port: 9090}},
 				},
 			},
 		},
 	}
+	if RequireIstioDestinationRules(gateway) {
+		t.Errorf("RequireIstioDestinationRules() = true without traffic policies, want false")
+	}
+	gateway.Spec.Ingress.GRPCRoutes[0].Destination.TrafficPolicy = &v1alpha2.TrafficPolicy{
+		LoadBalancer: v1alpha2.LoadBalancerRandom,
+	}
+	if !RequireIstioDestinationRules(gateway) {
+		t.Errorf("RequireIstioDestinationRules() = false with a traffic policy, want true")
+	}
+}
+
+func TestRequireIstioDestinationRuleUpdate(t *testing.T) {
+	desired := &v1alpha3.DestinationRule{
+		Spec: v1alpha3.DestinationRuleSpec{
+			Host:          "foo-service.foo-namespace.svc.cluster.local",
+			TrafficPolicy: &v1alpha3.TrafficPolicy{LoadBalancer: &v1alpha3.LoadBalancerSettings{Simple: "LEAST_CONN"}},
+		},
+	}
+	meta.AddObjectHash(desired)
 
-	if diff := cmp.Diff(expected, destinationRule); diff != "" {
-		t.Errorf("CreateIstioDestinationRule (-expected, +actual)\n%v", diff)
+	if RequireIstioDestinationRuleUpdate(desired, desired.DeepCopy()) {
+		t.Errorf("RequireIstioDestinationRuleUpdate() = true for the desired DestinationRule, want false")
+	}
+	modified := desired.DeepCopy()
+	modified.Spec.TrafficPolicy.LoadBalancer.Simple = "RANDOM"
+	if !RequireIstioDestinationRuleUpdate(desired, modified) {
+		t.Errorf("RequireIstioDestinationRuleUpdate() = false for a modified DestinationRule, want true")
+	}
+	if !RequireIstioDestinationRuleUpdate(desired, &v1alpha3.DestinationRule{Spec: desired.Spec}) {
+		t.Errorf("RequireIstioDestinationRuleUpdate() = false for a DestinationRule without a hash, want true")
 	}
 }
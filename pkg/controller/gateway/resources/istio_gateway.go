@@ -102,7 +102,7 @@ func MakeIstioGateway(gateway *v1alpha2.Gateway) *v1alpha3.Gateway {
 }
 
 func RequireIstioGateway(gateway *v1alpha2.Gateway) bool {
-	return gateway.Spec.Ingress.HasRoutes()
+	return gateway.Spec.Ingress.HasRoutes() && !gateway.Spec.Ingress.UsesGatewayAPI()
 }
 
 func RequireIstioGatewayUpdate(gateway *v1alpha2.Gateway, istioGateway *v1alpha3.Gateway) bool {
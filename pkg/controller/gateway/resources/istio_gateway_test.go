@@ -19,81 +19,57 @@
 package resources
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/controller"
 )
 
-func TestCreateIstioGateway(t *testing.T) {
-	gateway := &v1alpha1.Gateway{
-		Spec: v1alpha1.GatewaySpec{
-			Type: v1alpha1.GatewayTypeEnvoy,
-			Host: "test.com",
-			HTTPRoutes: []v1alpha1.HTTPRoute{
-				{
-					Authenticate: true,
-					Global:       true,
-					Backend:      "mytestservice",
-					Context:      "hello",
-					Definitions: []v1alpha1.APIDefinition{
-						{
-							Path:   "sayHello",
-							Method: "GET",
-						},
-					},
+func TestMakeIstioGateway(t *testing.T) {
+	gateway := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway",
+		},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				HTTPRoutes: []v1alpha2.HTTPRoute{
+					{Context: "/foo", Port: 80, Destination: v1alpha2.Destination{Host: "foo-service", Port: 8080}},
+					{Context: "/bar", Port: 80, Destination: v1alpha2.Destination{Host: "bar-service", Port: 8080}},
+				},
+				GRPCRoutes: []v1alpha2.GRPCRoute{
+					{Port: 9090, Destination: v1alpha2.Destination{Host: "baz-service", Port: 9090}},
+				},
+				TCPRoutes: []v1alpha2.TCPRoute{
+					{Port: 3306, Destination: v1alpha2.Destination{Host: "mysql", Port: 3306}},
 				},
 			},
 		},
 	}
-
-	istiogw := CreateIstioGateway(gateway)
-	expected := &v1alpha3.Gateway{
+	want := &v1alpha3.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      IstioGatewayName(gateway),
-			Namespace: gateway.Namespace,
-			Labels:    createGatewayLabels(gateway),
+			Name:      "foo-gateway",
+			Namespace: "foo-namespace",
+			Labels:    makeLabels(gateway),
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateGatewayOwnerRef(gateway),
 			},
 		},
 		Spec: v1alpha3.GatewaySpec{
-			Servers:  getGatewayServers(gateway),
-			Selector: createGatewayLabels(gateway),
+			Servers: []*v1alpha3.Server{
+				{Hosts: []string{"*"}, Port: &v1alpha3.Port{Number: 80, Protocol: "HTTP", Name: "http-80"}},
+				{Hosts: []string{"*"}, Port: &v1alpha3.Port{Number: 9090, Protocol: "GRPC", Name: "grpc-9090"}},
+				{Hosts: []string{"*"}, Port: &v1alpha3.Port{Number: 3306, Protocol: "TCP", Name: "tcp-3306"}},
+			},
+			Selector: makeLabels(gateway),
 		},
 	}
 
-	if diff := cmp.Diff(expected, istiogw); diff != "" {
-		t.Errorf("CreateIstioGateway (-expected, +actual)\n%v", diff)
+	if diff := cmp.Diff(want, MakeIstioGateway(gateway)); diff != "" {
+		t.Errorf("MakeIstioGateway (-want, +got)\n%v", diff)
 	}
 }
-
-func getGatewayServers(gateway *v1alpha1.Gateway) []*v1alpha3.Server {
-	var gatewayServers []*v1alpha3.Server
-
-	for _, tcpRoute := range gateway.Spec.TCPRoutes {
-		gatewayServers = append(gatewayServers, &v1alpha3.Server{
-			Hosts: []string{"*"},
-			Port: &v1alpha3.Port{
-				Number:   tcpRoute.Port,
-				Protocol: "TCP",
-				Name:     fmt.Sprintf("tcp-%d", tcpRoute.Port),
-			},
-		})
-	}
-	gatewayServers = append(gatewayServers, &v1alpha3.Server{
-		Hosts: []string{"*"},
-		Port: &v1alpha3.Port{
-			Number:   80,
-			Protocol: "HTTP",
-			Name:     fmt.Sprintf("http-%d", 80),
-		},
-	})
-
-	return gatewayServers
-}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	istiosecurityv1beta1 "cellery.io/cellery-controller/pkg/apis/istio/security/v1beta1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/controller"
+)
+
+// MakeRequestAuthentication validates the JWTs issued by the configured issuer at the gateway. Requests
+// without a token are still accepted, hence the authenticated routes are enforced by the AuthorizationPolicy.
+func MakeRequestAuthentication(gateway *v1alpha2.Gateway, cfg config.Interface) *istiosecurityv1beta1.RequestAuthentication {
+	return &istiosecurityv1beta1.RequestAuthentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RequestAuthenticationName(gateway),
+			Namespace: gateway.Namespace,
+			Labels:    makeLabels(gateway),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
+			},
+		},
+		Spec: istiosecurityv1beta1.RequestAuthenticationSpec{
+			Selector: &istiosecurityv1beta1.WorkloadSelector{
+				MatchLabels: makeLabels(gateway),
+			},
+			JwtRules: []istiosecurityv1beta1.JWTRule{
+				{
+					Issuer:               cfg.StringValue(config.ConfigMapKeyJwtIssuer),
+					JwksUri:              cfg.StringValue(config.ConfigMapKeyJwksUri),
+					ForwardOriginalToken: true,
+				},
+			},
+		},
+	}
+}
+
+// RequireRequestAuthentication reports whether the gateway has authenticated routes which are secured using the
+// security.istio.io/v1beta1 API. Istio versions prior to 1.5 rely on the token service to authenticate the requests.
+func RequireRequestAuthentication(gateway *v1alpha2.Gateway, cfg config.Interface) bool {
+	return hasAuthenticatedRoutes(gateway) && controller.UseIstioSecurityPolicies(cfg)
+}
+
+func RequireRequestAuthenticationUpdate(gateway *v1alpha2.Gateway, requestAuthentication *istiosecurityv1beta1.RequestAuthentication) bool {
+	return gateway.Generation != gateway.Status.ObservedGeneration ||
+		requestAuthentication.Generation != gateway.Status.RequestAuthenticationGeneration
+}
+
+func CopyRequestAuthentication(source, destination *istiosecurityv1beta1.RequestAuthentication) {
+	destination.Spec = source.Spec
+	destination.Labels = source.Labels
+	destination.Annotations = source.Annotations
+}
+
+func StatusFromRequestAuthentication(gateway *v1alpha2.Gateway, requestAuthentication *istiosecurityv1beta1.RequestAuthentication) {
+	gateway.Status.RequestAuthenticationGeneration = requestAuthentication.Generation
+}
+
+func hasAuthenticatedRoutes(gateway *v1alpha2.Gateway) bool {
+	for _, r := range gateway.Spec.Ingress.HTTPRoutes {
+		if r.Authenticate {
+			return true
+		}
+	}
+	return false
+}
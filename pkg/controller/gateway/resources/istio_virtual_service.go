@@ -71,6 +71,7 @@ func MakeVirtualService(gateway *v1alpha2.Gateway) *v1alpha3.VirtualService {
 				return map[string]string{}
 			}(),
 		}
+		applyRoutePolicies(r, httpRoute.Timeout, httpRoute.Retries, httpRoute.Fault)
 		httpRoutes = append(httpRoutes, r)
 	}
 
@@ -101,6 +102,7 @@ func MakeVirtualService(gateway *v1alpha2.Gateway) *v1alpha3.VirtualService {
 				return map[string]string{}
 			}(),
 		}
+		applyRoutePolicies(r, grpcRoute.Timeout, grpcRoute.Retries, grpcRoute.Fault)
 		httpRoutes = append(httpRoutes, r)
 	}
 
@@ -144,8 +146,39 @@ func MakeVirtualService(gateway *v1alpha2.Gateway) *v1alpha3.VirtualService {
 	}
 }
 
+// applyRoutePolicies sets the timeout, the retry policy and the fault injection of a route.
+func applyRoutePolicies(r *v1alpha3.HTTPRoute, timeout *metav1.Duration, retries *v1alpha2.RetryPolicy, fault *v1alpha2.FaultInjection) {
+	if timeout != nil {
+		r.Timeout = controller.IstioDuration(timeout.Duration)
+	}
+	if retries != nil {
+		r.Retries = &v1alpha3.HTTPRetry{
+			Attempts: retries.Attempts,
+			RetryOn:  retries.RetryOn,
+		}
+		if retries.PerTryTimeout != nil {
+			r.Retries.PerTryTimeout = controller.IstioDuration(retries.PerTryTimeout.Duration)
+		}
+	}
+	if fault != nil {
+		r.Fault = &v1alpha3.HTTPFaultInjection{}
+		if fault.Delay != nil {
+			r.Fault.Delay = &v1alpha3.HTTPFaultInjection_Delay{
+				FixedDelay: controller.IstioDuration(fault.Delay.FixedDelay.Duration),
+				Percentage: &v1alpha3.Percent{Value: float64(fault.Delay.Percent)},
+			}
+		}
+		if fault.Abort != nil {
+			r.Fault.Abort = &v1alpha3.HTTPFaultInjection_Abort{
+				HttpStatus: fault.Abort.HttpStatus,
+				Percentage: &v1alpha3.Percent{Value: float64(fault.Abort.Percent)},
+			}
+		}
+	}
+}
+
 func RequireVirtualService(gateway *v1alpha2.Gateway) bool {
-	return gateway.Spec.Ingress.HasRoutes()
+	return gateway.Spec.Ingress.HasRoutes() && !gateway.Spec.Ingress.UsesGatewayAPI()
 }
 
 func RequireVirtualServiceUpdate(gateway *v1alpha2.Gateway, virtualService *v1alpha3.VirtualService) bool {
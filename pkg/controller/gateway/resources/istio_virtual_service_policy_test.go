@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+)
+
+func TestMakeVirtualServiceRoutePolicies(t *testing.T) {
+	gateway := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway",
+		},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				HTTPRoutes: []v1alpha2.HTTPRoute{
+					{
+						Context:     "/foo",
+						Destination: v1alpha2.Destination{Host: "foo-service", Port: 8080},
+						Timeout:     &metav1.Duration{Duration: 15 * time.Second},
+						Retries: &v1alpha2.RetryPolicy{
+							Attempts:      3,
+							PerTryTimeout: &metav1.Duration{Duration: 2500 * time.Millisecond},
+							RetryOn:       "5xx,connect-failure",
+						},
+					},
+					{
+						Context:     "/bar",
+						Destination: v1alpha2.Destination{Host: "bar-service", Port: 8080},
+					},
+				},
+				GRPCRoutes: []v1alpha2.GRPCRoute{
+					{
+						Port:        9090,
+						Destination: v1alpha2.Destination{Host: "baz-service", Port: 9090},
+						Fault: &v1alpha2.FaultInjection{
+							Delay: &v1alpha2.FaultDelay{
+								Percent:    10,
+								FixedDelay: metav1.Duration{Duration: 5 * time.Second},
+							},
+							Abort: &v1alpha2.FaultAbort{
+								Percent:    5,
+								HttpStatus: 503,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	routes := MakeVirtualService(gateway).Spec.Http
+	if len(routes) != 3 {
+		t.Fatalf("MakeVirtualService() created %d HTTP routes, want 3", len(routes))
+	}
+
+	if routes[0].Timeout != "15s" {
+		t.Errorf("MakeVirtualService() timeout = %q, want %q", routes[0].Timeout, "15s")
+	}
+	wantRetries := &v1alpha3.HTTPRetry{
+		Attempts:      3,
+		PerTryTimeout: "2.5s",
+		RetryOn:       "5xx,connect-failure",
+	}
+	if diff := cmp.Diff(wantRetries, routes[0].Retries); diff != "" {
+		t.Errorf("MakeVirtualService retries (-want, +got)\n%v", diff)
+	}
+
+	if routes[1].Timeout != "" || routes[1].Retries != nil || routes[1].Fault != nil {
+		t.Errorf("MakeVirtualService() set the policies of a route without them: %+v", routes[1])
+	}
+
+	wantFault := &v1alpha3.HTTPFaultInjection{
+		Delay: &v1alpha3.HTTPFaultInjection_Delay{
+			FixedDelay: "5s",
+			Percentage: &v1alpha3.Percent{Value: 10},
+		},
+		Abort: &v1alpha3.HTTPFaultInjection_Abort{
+			HttpStatus: 503,
+			Percentage: &v1alpha3.Percent{Value: 5},
+		},
+	}
+	if diff := cmp.Diff(wantFault, routes[2].Fault); diff != "" {
+		t.Errorf("MakeVirtualService fault (-want, +got)\n%v", diff)
+	}
+}
@@ -19,227 +19,95 @@
 package resources
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/controller"
 )
 
-func TestCreateIstioVirtualService(t *testing.T) {
-	gateway := &v1alpha1.Gateway{
-		Spec: v1alpha1.GatewaySpec{
-			Type: v1alpha1.GatewayTypeEnvoy,
-			Host: "test.com",
-			HTTPRoutes: []v1alpha1.HTTPRoute{
-				{
-					Authenticate: true,
-					Global:       true,
-					Backend:      "mytestservice",
-					Context:      "hello",
-					Definitions: []v1alpha1.APIDefinition{
-						{
-							Path:   "sayHello",
-							Method: "GET",
-						},
-					},
+func TestMakeVirtualService(t *testing.T) {
+	gateway := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway",
+		},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				HTTPRoutes: []v1alpha2.HTTPRoute{
+					{Context: "/foo", Port: 80, Destination: v1alpha2.Destination{Host: "foo-service", Port: 8080}},
+					{Context: "/zero", Port: 80, Destination: v1alpha2.Destination{Host: "zero-service", Port: 8080}, ZeroScale: true},
 				},
-				{
-					Authenticate: true,
-					Global:       true,
-					ZeroScale:    true,
-					Backend:      "myzeroservice",
-					Context:      "zero",
-					Definitions: []v1alpha1.APIDefinition{
-						{
-							Path:   "/",
-							Method: "GET",
-						},
-					},
+				GRPCRoutes: []v1alpha2.GRPCRoute{
+					{Port: 9090, Destination: v1alpha2.Destination{Host: "baz-service", Port: 9090}},
 				},
-			},
-			TCPRoutes: []v1alpha1.TCPRoute{
-				{
-					Port:        32400,
-					BackendPort: 8080,
-					BackendHost: "my-service",
+				TCPRoutes: []v1alpha2.TCPRoute{
+					{Port: 3306, Destination: v1alpha2.Destination{Host: "mysql", Port: 3306}},
 				},
 			},
 		},
 	}
-
-	virtualService := CreateIstioVirtualService(gateway)
-	expected := &v1alpha3.VirtualService{
+	want := &v1alpha3.VirtualService{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      IstioVSName(gateway),
-			Namespace: gateway.Namespace,
-			Labels:    createGatewayLabels(gateway),
+			Name:      "foo-gateway",
+			Namespace: "foo-namespace",
+			Labels:    makeLabels(gateway),
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateGatewayOwnerRef(gateway),
 			},
 		},
 		Spec: v1alpha3.VirtualServiceSpec{
 			Hosts:    []string{"*"},
-			Gateways: []string{IstioGatewayName(gateway)},
-			Http:     getHttpRoutes(gateway),
-			Tcp:      getTcpRoutes(gateway),
-		},
-	}
-
-	if diff := cmp.Diff(expected, virtualService); diff != "" {
-		t.Errorf("CreateIstioVirtualService (-expected, +actual)\n%v", diff)
-	}
-}
-
-func TestCreateIstioVirtualServiceForIngress(t *testing.T) {
-	gateway := &v1alpha1.Gateway{
-		Spec: v1alpha1.GatewaySpec{
-			Type: v1alpha1.GatewayTypeEnvoy,
-			Host: "test.com",
-			HTTPRoutes: []v1alpha1.HTTPRoute{
+			Gateways: []string{"foo-gateway"},
+			Http: []*v1alpha3.HTTPRoute{
 				{
-					Authenticate: true,
-					Global:       true,
-					Backend:      "mytestservice",
-					Context:      "hello",
-					Definitions: []v1alpha1.APIDefinition{
-						{
-							Path:   "sayHello",
-							Method: "GET",
-						},
+					Match: []*v1alpha3.HTTPMatchRequest{
+						{Uri: &v1alpha3.StringMatch{Prefix: "/foo/"}},
+						{Uri: &v1alpha3.StringMatch{Prefix: "/foo"}},
 					},
-				},
-			},
-		},
-	}
-
-	virtualService := CreateIstioVirtualServiceForIngress(gateway)
-	expected := &v1alpha3.VirtualService{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      IstioIngressVirtualServiceName(gateway),
-			Namespace: gateway.Namespace,
-			Labels:    createGatewayLabels(gateway),
-			OwnerReferences: []metav1.OwnerReference{
-				*controller.CreateGatewayOwnerRef(gateway),
-			},
-		},
-		Spec: v1alpha3.VirtualServiceSpec{
-			Hosts:    []string{"*"},
-			Gateways: []string{"ingress-gateway"},
-			Http:     getRoutes(gateway),
-		},
-	}
-	if diff := cmp.Diff(expected, virtualService); diff != "" {
-		t.Errorf("CreateIstioVirtualServiceForIngress (-expected, +actual)\n%v", diff)
-	}
-}
-
-func getRoutes(gateway *v1alpha1.Gateway) []*v1alpha3.HTTPRoute {
-	var routes []*v1alpha3.HTTPRoute
-
-	for _, apiRoute := range gateway.Spec.HTTPRoutes {
-		if apiRoute.Global == true {
-			routes = append(routes, &v1alpha3.HTTPRoute{
-				Match: []*v1alpha3.HTTPMatchRequest{
-					{
-						Uri: &v1alpha3.StringMatch{
-							Prefix: fmt.Sprintf("/%s/", apiRoute.Context),
-						},
+					Route: []*v1alpha3.DestinationWeight{
+						{Destination: &v1alpha3.Destination{Host: "foo-service", Port: &v1alpha3.PortSelector{Number: 8080}}},
 					},
+					Rewrite:       &v1alpha3.HTTPRewrite{Uri: "/"},
+					AppendHeaders: map[string]string{},
 				},
-				Route: []*v1alpha3.DestinationWeight{
-					{
-						Destination: &v1alpha3.Destination{
-							Host: gateway.Status.HostName,
-						},
+				{
+					Match: []*v1alpha3.HTTPMatchRequest{
+						{Uri: &v1alpha3.StringMatch{Prefix: "/zero/"}},
+						{Uri: &v1alpha3.StringMatch{Prefix: "/zero"}},
+					},
+					Route: []*v1alpha3.DestinationWeight{
+						{Destination: &v1alpha3.Destination{Host: "zero-service", Port: &v1alpha3.PortSelector{Number: 8080}}},
+					},
+					Rewrite: &v1alpha3.HTTPRewrite{Uri: "/"},
+					AppendHeaders: map[string]string{
+						controller.KnativeServingNamespaceHeader: "foo-namespace",
+						controller.KnativeServingRevisionHeader:  "zero-service",
 					},
 				},
-			})
-		}
-	}
-	return routes
-}
-
-func getHttpRoutes(gateway *v1alpha1.Gateway) []*v1alpha3.HTTPRoute {
-
-	var httpRoutes []*v1alpha3.HTTPRoute
-
-	httpRoutes = append(httpRoutes, &v1alpha3.HTTPRoute{
-		Match: []*v1alpha3.HTTPMatchRequest{
-			{
-				Uri: &v1alpha3.StringMatch{
-					//Regex: fmt.Sprintf("\\/%s(\\?.*|\\/.*|\\#.*|\\s*)", apiRoute.Context),
-					Prefix: gateway.Spec.HTTPRoutes[0].Context,
-				},
-			},
-		},
-		Route: []*v1alpha3.DestinationWeight{
-			{
-				Destination: &v1alpha3.Destination{
-					Host: gateway.Spec.HTTPRoutes[0].Backend,
-				},
-			},
-		},
-		Rewrite: &v1alpha3.HTTPRewrite{
-			Uri: "/",
-		},
-		AppendHeaders: map[string]string{},
-	})
-
-	httpRoutes = append(httpRoutes, &v1alpha3.HTTPRoute{
-		Match: []*v1alpha3.HTTPMatchRequest{
-			{
-				Uri: &v1alpha3.StringMatch{
-					//Regex: fmt.Sprintf("\\/%s(\\?.*|\\/.*|\\#.*|\\s*)", apiRoute.Context),
-					Prefix: gateway.Spec.HTTPRoutes[1].Context,
-				},
-			},
-		},
-		Route: []*v1alpha3.DestinationWeight{
-			{
-				Destination: &v1alpha3.Destination{
-					Host: gateway.Spec.HTTPRoutes[1].Backend + "-rev",
-				},
-			},
-		},
-		Rewrite: &v1alpha3.HTTPRewrite{
-			Uri: "/",
-		},
-		AppendHeaders: map[string]string{
-			"knative-serving-namespace": "default",
-			"knative-serving-revision":  gateway.Spec.HTTPRoutes[1].Backend + "-rev",
-		},
-	})
-
-	return httpRoutes
-}
-
-func getTcpRoutes(gateway *v1alpha1.Gateway) []*v1alpha3.TCPRoute {
-
-	var tcpRoutes []*v1alpha3.TCPRoute
-
-	for _, tcpRoute := range gateway.Spec.TCPRoutes {
-		tcpRoutes = append(tcpRoutes, &v1alpha3.TCPRoute{
-			Match: []*v1alpha3.L4MatchAttributes{
 				{
-					Port: tcpRoute.Port,
+					Match: []*v1alpha3.HTTPMatchRequest{{Port: 9090}},
+					Route: []*v1alpha3.DestinationWeight{
+						{Destination: &v1alpha3.Destination{Host: "baz-service", Port: &v1alpha3.PortSelector{Number: 9090}}},
+					},
+					AppendHeaders: map[string]string{},
 				},
 			},
-			Route: []*v1alpha3.DestinationWeight{
+			Tcp: []*v1alpha3.TCPRoute{
 				{
-					Destination: &v1alpha3.Destination{
-						Host: tcpRoute.BackendHost,
-						Port: &v1alpha3.PortSelector{
-							Number: tcpRoute.BackendPort,
-						},
+					Match: []*v1alpha3.L4MatchAttributes{{Port: 3306}},
+					Route: []*v1alpha3.DestinationWeight{
+						{Destination: &v1alpha3.Destination{Host: "mysql", Port: &v1alpha3.PortSelector{Number: 3306}}},
 					},
 				},
 			},
-		})
+		},
+	}
+
+	if diff := cmp.Diff(want, MakeVirtualService(gateway)); diff != "" {
+		t.Errorf("MakeVirtualService (-want, +got)\n%v", diff)
 	}
-	return tcpRoutes
 }
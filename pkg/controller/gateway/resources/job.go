@@ -26,6 +26,7 @@ import (
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
 func RequireApiPublisherJob(gateway *v1alpha2.Gateway) bool {
@@ -56,6 +57,9 @@ func MakeApiPublisherJob(gateway *v1alpha2.Gateway, cfg config.Interface) *batch
 			Name:      JobName(gateway),
 			Namespace: gateway.Namespace,
 			Labels:    makeLabels(gateway),
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: cfg.Hash(),
+			},
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateGatewayOwnerRef(gateway),
 			},
@@ -19,6 +19,9 @@
 package resources
 
 import (
+	"fmt"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
@@ -63,6 +66,29 @@ func makePodAnnotations(gateway *v1alpha2.Gateway) map[string]string {
 	)
 }
 
+// makeScheduleAnnotations records the active scaling schedule in the deployment and the HPA so that
+// they are updated when the schedule changes.
+func makeScheduleAnnotations(gateway *v1alpha2.Gateway) map[string]string {
+	if len(gateway.Status.ActiveSchedule) == 0 {
+		return nil
+	}
+	return map[string]string{
+		ActiveScheduleAnnotationKey: gateway.Status.ActiveSchedule,
+	}
+}
+
+// makeHpaAnnotations records the active scaling schedule and the AutoscaleOverride merged into the HPA
+// so that it is updated when either of them changes.
+func makeHpaAnnotations(gateway *v1alpha2.Gateway) map[string]string {
+	annotations := makeScheduleAnnotations(gateway)
+	if len(gateway.Status.AutoscaleOverride) == 0 {
+		return annotations
+	}
+	return UnionMaps(annotations, map[string]string{
+		AutoscaleOverrideAnnotationKey: fmt.Sprintf("%s/%d", gateway.Status.AutoscaleOverride, gateway.Status.AutoscaleOverrideGeneration),
+	})
+}
+
 func ServiceName(gateway *v1alpha2.Gateway) string {
 	return gateway.Name + "-service"
 }
@@ -99,10 +125,18 @@ func GatewayFullK8sServiceName(gateway *v1alpha2.Gateway) string {
 	return ServiceName(gateway) + "." + gateway.Namespace
 }
 
-func IstioDestinationRuleName(gateway *v1alpha2.Gateway) string {
-	return gateway.Name + "-destination-rule"
+func IstioDestinationRuleName(gateway *v1alpha2.Gateway, host string) string {
+	return gateway.Name + "-" + strings.Replace(host, ".", "-", -1) + "-destination-rule"
 }
 
 func HpaName(gw *v1alpha2.Gateway) string {
 	return gw.Name + "-hpa"
 }
+
+func RequestAuthenticationName(gateway *v1alpha2.Gateway) string {
+	return gateway.Name + "-authn"
+}
+
+func AuthorizationPolicyName(gateway *v1alpha2.Gateway) string {
+	return gateway.Name + "-authz"
+}
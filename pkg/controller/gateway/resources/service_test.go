@@ -26,287 +26,66 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
-	"cellery.io/cellery-controller/pkg/apis/mesh"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/controller"
 )
 
-var boolTrue = true
-
-func TestCreateGatewayK8sService(t *testing.T) {
-	tests := []struct {
-		name    string
-		gateway *v1alpha1.Gateway
-		want    *corev1.Service
-	}{
-		{
-			name: "foo gateway without spec",
-			gateway: &v1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-			},
-			want: &corev1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-service",
-					Labels: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "Gateway",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
-				},
-				Spec: corev1.ServiceSpec{
-					Ports: []corev1.ServicePort{
-						{
-							Name:       "http2",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       80,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 80},
-						},
-						{
-							Name:       "https",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       443,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 443},
-						},
-					},
-					Selector: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
-				},
-			},
-		},
-		{
-			name: "foo gateway with micro-gateway type",
-			gateway: &v1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-				Spec: v1alpha1.GatewaySpec{
-					Type: v1alpha1.GatewayTypeMicroGateway,
-				},
-			},
-			want: &corev1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-service",
-					Labels: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "Gateway",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
-				},
-				Spec: corev1.ServiceSpec{
-					Ports: []corev1.ServicePort{
-						{
-							Name:       "http",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       80,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 8080},
-						},
-					},
-					Selector: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
-				},
-			},
-		},
-		{
-			name: "foo gateway with envoy-gateway type and oidc config",
-			gateway: &v1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-				Spec: v1alpha1.GatewaySpec{
-					Type: v1alpha1.GatewayTypeEnvoy,
-					OidcConfig: &v1alpha1.OidcConfig{
-						ProviderUrl:  "http://provider.com",
-						ClientId:     "cid",
-						ClientSecret: "secret",
-						BaseUrl:      "http://example.com",
-					},
-				},
-			},
-			want: &corev1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-service",
-					Labels: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "Gateway",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
-				},
-				Spec: corev1.ServiceSpec{
-					Ports: []corev1.ServicePort{
-						{
-							Name:       "http2",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       80,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 80},
-						},
-						{
-							Name:       "https",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       443,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 443},
-						},
-						{
-							Name:       "http-oidc-callback",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       15810,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 15810},
-						},
-					},
-					Selector: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
-				},
-			},
+func TestMakeService(t *testing.T) {
+	gateway := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-gateway",
 		},
-		{
-			name: "foo gateway with tcp mode envoy-gateway type",
-			gateway: &v1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-				Spec: v1alpha1.GatewaySpec{
-					Type: v1alpha1.GatewayTypeEnvoy,
-					TCPRoutes: []v1alpha1.TCPRoute{
-						{
-							Port:        31220,
-							BackendHost: "tcp-host",
-							BackendPort: 8080,
-						},
-					},
+		Spec: v1alpha2.GatewaySpec{
+			Ingress: v1alpha2.Ingress{
+				HTTPRoutes: []v1alpha2.HTTPRoute{
+					{Context: "/foo", Port: 80, Destination: v1alpha2.Destination{Host: "foo-service", Port: 8080}},
+					{Context: "/bar", Port: 80, Destination: v1alpha2.Destination{Host: "bar-service", Port: 8080}},
 				},
-			},
-			want: &corev1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-service",
-					Labels: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "Gateway",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
+				GRPCRoutes: []v1alpha2.GRPCRoute{
+					{Port: 9090, Destination: v1alpha2.Destination{Host: "baz-service", Port: 9090}},
 				},
-				Spec: corev1.ServiceSpec{
-					Ports: []corev1.ServicePort{
-						{
-							Name:       "http2",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       80,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 80},
-						},
-						{
-							Name:       "https",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       443,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 443},
-						},
-						{
-							Name:       "tcp-31220",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       31220,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 31220},
-						},
-					},
-					Selector: map[string]string{
-						mesh.CellGatewayLabelKey: "foo",
-						appLabelKey:              "foo",
-					},
+				TCPRoutes: []v1alpha2.TCPRoute{
+					{Port: 3306, Destination: v1alpha2.Destination{Host: "mysql", Port: 3306}},
 				},
 			},
 		},
 	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			got := CreateGatewayK8sService(test.gateway)
-			if diff := cmp.Diff(test.want, got); diff != "" {
-				t.Errorf("CreateGatewayK8sService (-want, +got)\n%v", diff)
-			}
-		})
-	}
-}
-
-func TestCreateOriginalGatewayK8sService(t *testing.T) {
-	gateway := &v1alpha1.Gateway{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "foo-namespace",
-			Name:      "foo",
-		},
-		Spec: v1alpha1.GatewaySpec{
-			Type: v1alpha1.GatewayTypeMicroGateway,
-		},
-	}
-
-	expected := &corev1.Service{
+	want := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo-gateway-service",
 			Namespace: "foo-namespace",
-			Name:      "original-foo-gw-svc",
-			Labels: map[string]string{
-				mesh.CellGatewayLabelKey: "foo",
-				appLabelKey:              "foo",
+			Labels:    makeLabels(gateway),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateGatewayOwnerRef(gateway),
 			},
-			OwnerReferences: []metav1.OwnerReference{{
-				APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-				Kind:               "Gateway",
-				Name:               "foo",
-				Controller:         &boolTrue,
-				BlockOwnerDeletion: &boolTrue,
-			}},
 		},
 		Spec: corev1.ServiceSpec{
 			Ports: []corev1.ServicePort{
 				{
-					Name:       "http",
+					Name:       "http2-80",
 					Protocol:   corev1.ProtocolTCP,
 					Port:       80,
-					TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 8080},
+					TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 80},
+				},
+				{
+					Name:       "grpc-9090",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       9090,
+					TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 9090},
+				},
+				{
+					Name:       "tcp-3306",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       3306,
+					TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 3306},
 				},
 			},
-			Selector: map[string]string{
-				mesh.CellGatewayLabelKey: "foo",
-				appLabelKey:              "foo",
-			},
+			Selector: makeLabels(gateway),
 		},
 	}
-	actual := MakeOriginalGatewayK8sService(gateway, "original-foo-gw-svc")
 
-	if diff := cmp.Diff(expected, actual); diff != "" {
-		t.Errorf("CreateGatewayK8sService (-expected, +actual)\n%v", diff)
+	if diff := cmp.Diff(want, MakeService(gateway)); diff != "" {
+		t.Errorf("MakeService (-want, +got)\n%v", diff)
 	}
 }
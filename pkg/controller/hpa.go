@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
+)
+
+// ConvertMetrics converts the autoscaling/v2beta1 metrics used in the cellery specs to the
+// autoscaling/v2 metrics of the generated HPAs.
+func ConvertMetrics(metrics []autoscalingv2beta1.MetricSpec) []autoscalingv2.MetricSpec {
+	if metrics == nil {
+		return nil
+	}
+	converted := make([]autoscalingv2.MetricSpec, 0, len(metrics))
+	for _, m := range metrics {
+		converted = append(converted, convertMetric(m))
+	}
+	return converted
+}
+
+func convertMetric(m autoscalingv2beta1.MetricSpec) autoscalingv2.MetricSpec {
+	spec := autoscalingv2.MetricSpec{Type: autoscalingv2.MetricSourceType(m.Type)}
+	if m.Object != nil {
+		target := autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: copyQuantity(m.Object.TargetValue)}
+		if m.Object.AverageValue != nil {
+			target = autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: copyQuantity(*m.Object.AverageValue)}
+		}
+		spec.Object = &autoscalingv2.ObjectMetricSource{
+			DescribedObject: autoscalingv2.CrossVersionObjectReference{
+				Kind:       m.Object.Target.Kind,
+				Name:       m.Object.Target.Name,
+				APIVersion: m.Object.Target.APIVersion,
+			},
+			Metric: autoscalingv2.MetricIdentifier{Name: m.Object.MetricName, Selector: m.Object.Selector.DeepCopy()},
+			Target: target,
+		}
+	}
+	if m.Pods != nil {
+		spec.Pods = &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: m.Pods.MetricName, Selector: m.Pods.Selector.DeepCopy()},
+			Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: copyQuantity(m.Pods.TargetAverageValue)},
+		}
+	}
+	if m.Resource != nil {
+		target := autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType}
+		if m.Resource.TargetAverageUtilization != nil {
+			utilization := *m.Resource.TargetAverageUtilization
+			target.AverageUtilization = &utilization
+		} else if m.Resource.TargetAverageValue != nil {
+			target = autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: copyQuantity(*m.Resource.TargetAverageValue)}
+		}
+		spec.Resource = &autoscalingv2.ResourceMetricSource{Name: m.Resource.Name, Target: target}
+	}
+	if m.External != nil {
+		target := autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType}
+		if m.External.TargetValue != nil {
+			target.Value = copyQuantity(*m.External.TargetValue)
+		} else if m.External.TargetAverageValue != nil {
+			target = autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: copyQuantity(*m.External.TargetAverageValue)}
+		}
+		spec.External = &autoscalingv2.ExternalMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: m.External.MetricName, Selector: m.External.MetricSelector.DeepCopy()},
+			Target: target,
+		}
+	}
+	return spec
+}
+
+func copyQuantity(q resource.Quantity) *resource.Quantity {
+	c := q.DeepCopy()
+	return &c
+}
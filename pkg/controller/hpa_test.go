@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
+	"cellery.io/cellery-controller/pkg/ptr"
+)
+
+func TestConvertMetrics(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"queue": "orders"}}
+	quantity := func(s string) *resource.Quantity {
+		q := resource.MustParse(s)
+		return &q
+	}
+
+	tests := []struct {
+		name    string
+		metrics []autoscalingv2beta1.MetricSpec
+		want    []autoscalingv2.MetricSpec
+	}{
+		{
+			name: "nil metrics",
+		},
+		{
+			name: "resource metrics",
+			metrics: []autoscalingv2beta1.MetricSpec{
+				{
+					Type: autoscalingv2beta1.ResourceMetricSourceType,
+					Resource: &autoscalingv2beta1.ResourceMetricSource{
+						Name:                     corev1.ResourceCPU,
+						TargetAverageUtilization: ptr.Int32(70),
+					},
+				},
+				{
+					Type: autoscalingv2beta1.ResourceMetricSourceType,
+					Resource: &autoscalingv2beta1.ResourceMetricSource{
+						Name:               corev1.ResourceMemory,
+						TargetAverageValue: quantity("512Mi"),
+					},
+				},
+			},
+			want: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:   corev1.ResourceCPU,
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: ptr.Int32(70)},
+					},
+				},
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:   corev1.ResourceMemory,
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: quantity("512Mi")},
+					},
+				},
+			},
+		},
+		{
+			name: "pods metric",
+			metrics: []autoscalingv2beta1.MetricSpec{
+				{
+					Type: autoscalingv2beta1.PodsMetricSourceType,
+					Pods: &autoscalingv2beta1.PodsMetricSource{
+						MetricName:         "requests_per_second",
+						TargetAverageValue: *quantity("100"),
+					},
+				},
+			},
+			want: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: quantity("100")},
+					},
+				},
+			},
+		},
+		{
+			name: "object metrics",
+			metrics: []autoscalingv2beta1.MetricSpec{
+				{
+					Type: autoscalingv2beta1.ObjectMetricSourceType,
+					Object: &autoscalingv2beta1.ObjectMetricSource{
+						Target:      autoscalingv2beta1.CrossVersionObjectReference{Kind: "Service", Name: "orders", APIVersion: "v1"},
+						MetricName:  "requests_per_second",
+						TargetValue: *quantity("2k"),
+					},
+				},
+				{
+					Type: autoscalingv2beta1.ObjectMetricSourceType,
+					Object: &autoscalingv2beta1.ObjectMetricSource{
+						Target:       autoscalingv2beta1.CrossVersionObjectReference{Kind: "Service", Name: "orders", APIVersion: "v1"},
+						MetricName:   "requests_per_second",
+						AverageValue: quantity("200"),
+					},
+				},
+			},
+			want: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ObjectMetricSourceType,
+					Object: &autoscalingv2.ObjectMetricSource{
+						DescribedObject: autoscalingv2.CrossVersionObjectReference{Kind: "Service", Name: "orders", APIVersion: "v1"},
+						Metric:          autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+						Target:          autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: quantity("2k")},
+					},
+				},
+				{
+					Type: autoscalingv2.ObjectMetricSourceType,
+					Object: &autoscalingv2.ObjectMetricSource{
+						DescribedObject: autoscalingv2.CrossVersionObjectReference{Kind: "Service", Name: "orders", APIVersion: "v1"},
+						Metric:          autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+						Target:          autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: quantity("200")},
+					},
+				},
+			},
+		},
+		{
+			name: "external metrics",
+			metrics: []autoscalingv2beta1.MetricSpec{
+				{
+					Type: autoscalingv2beta1.ExternalMetricSourceType,
+					External: &autoscalingv2beta1.ExternalMetricSource{
+						MetricName:     "queue_messages_ready",
+						MetricSelector: selector,
+						TargetValue:    quantity("30"),
+					},
+				},
+				{
+					Type: autoscalingv2beta1.ExternalMetricSourceType,
+					External: &autoscalingv2beta1.ExternalMetricSource{
+						MetricName:         "queue_messages_ready",
+						MetricSelector:     selector,
+						TargetAverageValue: quantity("5"),
+					},
+				},
+			},
+			want: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ExternalMetricSourceType,
+					External: &autoscalingv2.ExternalMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "queue_messages_ready", Selector: selector},
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: quantity("30")},
+					},
+				},
+				{
+					Type: autoscalingv2.ExternalMetricSourceType,
+					External: &autoscalingv2.ExternalMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "queue_messages_ready", Selector: selector},
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: quantity("5")},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := ConvertMetrics(test.metrics)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ConvertMetrics (-want, +got)\n%v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"strconv"
+	"strings"
+
+	"cellery.io/cellery-controller/pkg/config"
+)
+
+// UseEnvoyConfigPatches reports whether the EnvoyFilters should be generated with the workloadSelector
+// and configPatches fields instead of the filters schema which was removed in istio 1.5. The legacy
+// schema is only used when the configured istio version is older than 1.5.
+func UseEnvoyConfigPatches(cfg config.Interface) bool {
+	return istioVersionAtLeast(cfg, 1, 5)
+}
+
+// UseIstioSecurityPolicies reports whether the security.istio.io/v1beta1 PeerAuthentications,
+// RequestAuthentications and AuthorizationPolicies should be generated instead of the
+// authentication.istio.io/v1alpha1 Policies which were replaced by them in istio 1.5.
+func UseIstioSecurityPolicies(cfg config.Interface) bool {
+	return istioVersionAtLeast(cfg, 1, 5)
+}
+
+// istioVersionAtLeast compares the configured istio version with the given major and minor versions.
+// A missing or an unparsable version is considered to be the latest version.
+func istioVersionAtLeast(cfg config.Interface, major, minor int) bool {
+	v, ok := cfg.Value(config.ConfigMapKeyIstioVersion)
+	if !ok {
+		return true
+	}
+	parts := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(v), "v"), ".", 3)
+	if len(parts) < 2 {
+		return true
+	}
+	vMajor, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return true
+	}
+	vMinor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return true
+	}
+	return vMajor > major || (vMajor == major && vMinor >= minor)
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/config/fake"
+)
+
+func TestUseEnvoyConfigPatches(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]string
+		want bool
+	}{
+		{
+			name: "istio version is not set",
+			want: true,
+		},
+		{
+			name: "istio 1.0",
+			data: map[string]string{config.ConfigMapKeyIstioVersion: "1.0.2"},
+			want: false,
+		},
+		{
+			name: "istio 1.4",
+			data: map[string]string{config.ConfigMapKeyIstioVersion: "1.4.10"},
+			want: false,
+		},
+		{
+			name: "istio 1.5",
+			data: map[string]string{config.ConfigMapKeyIstioVersion: "1.5.0"},
+			want: true,
+		},
+		{
+			name: "istio 1.10 with a v prefix",
+			data: map[string]string{config.ConfigMapKeyIstioVersion: "v1.10.3"},
+			want: true,
+		},
+		{
+			name: "invalid istio version",
+			data: map[string]string{config.ConfigMapKeyIstioVersion: "latest"},
+			want: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := UseEnvoyConfigPatches(fake.New(test.data)); got != test.want {
+				t.Errorf("UseEnvoyConfigPatches() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestUseIstioSecurityPolicies(t *testing.T) {
+	if !UseIstioSecurityPolicies(fake.New(map[string]string{config.ConfigMapKeyIstioVersion: "1.5.1"})) {
+		t.Errorf("UseIstioSecurityPolicies() = false for istio 1.5.1, want true")
+	}
+	if UseIstioSecurityPolicies(fake.New(map[string]string{config.ConfigMapKeyIstioVersion: "1.2.2"})) {
+		t.Errorf("UseIstioSecurityPolicies() = true for istio 1.2.2, want false")
+	}
+}
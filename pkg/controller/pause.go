@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	v1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+// IsPaused returns true if the reconciliation of the object is paused using the reconcile annotation.
+func IsPaused(obj metav1.Object) bool {
+	return obj.GetAnnotations()[meta.ReconcileAnnotationKey] == meta.ReconcilePausedValue
+}
+
+// IsOwnerPaused returns true if the Cell or the Composite which controls the object is paused.
+func IsOwnerPaused(obj metav1.Object, cellLister v1alpha2listers.CellLister, compositeLister v1alpha2listers.CompositeLister) bool {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.APIVersion != v1alpha2.SchemeGroupVersion.String() {
+		return false
+	}
+	switch owner.Kind {
+	case "Cell":
+		cell, err := cellLister.Cells(obj.GetNamespace()).Get(owner.Name)
+		return err == nil && cell.UID == owner.UID && IsPaused(cell)
+	case "Composite":
+		composite, err := compositeLister.Composites(obj.GetNamespace()).Get(owner.Name)
+		return err == nil && composite.UID == owner.UID && IsPaused(composite)
+	}
+	return false
+}
+
+// HandlePauseChange returns an event handler which calls the given handler with the updated object
+// only when its reconcile annotation is switched between paused and resumed.
+func HandlePauseChange(h func(interface{})) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(first, second interface{}) {
+			oldObj, ok := first.(metav1.Object)
+			if !ok {
+				return
+			}
+			newObj, ok := second.(metav1.Object)
+			if !ok {
+				return
+			}
+			if IsPaused(oldObj) != IsPaused(newObj) {
+				h(second)
+			}
+		},
+	}
+}
+
+// EnqueueControlledBy returns a handler which enqueues all the objects in the given indexer
+// that are controlled by the object passed to the handler.
+func (c *Controller) EnqueueControlledBy(indexer cache.Indexer) func(interface{}) {
+	return func(obj interface{}) {
+		owner, ok := obj.(metav1.Object)
+		if !ok {
+			c.logger.Errorf("error decoding object, invalid type")
+			return
+		}
+		objs, err := indexer.ByIndex(cache.NamespaceIndex, owner.GetNamespace())
+		if err != nil {
+			c.logger.Errorf("Failed to list objects controlled by %q: %v", owner.GetName(), err)
+			return
+		}
+		for _, o := range objs {
+			if object, ok := o.(metav1.Object); ok && metav1.IsControlledBy(object, owner) {
+				c.Enqueue(object)
+			}
+		}
+	}
+}
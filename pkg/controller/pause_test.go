@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	v1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+func pausedAnnotations(paused bool) map[string]string {
+	if !paused {
+		return nil
+	}
+	return map[string]string{meta.ReconcileAnnotationKey: meta.ReconcilePausedValue}
+}
+
+func TestIsOwnerPaused(t *testing.T) {
+	cellIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	compositeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+
+	pausedCell := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{Name: "paused", Namespace: "foo", UID: types.UID("1"), Annotations: pausedAnnotations(true)}}
+	runningCell := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "foo", UID: types.UID("2")}}
+	pausedComposite := &v1alpha2.Composite{ObjectMeta: metav1.ObjectMeta{Name: "paused", Namespace: "foo", UID: types.UID("3"), Annotations: pausedAnnotations(true)}}
+	cellIndexer.Add(pausedCell)
+	cellIndexer.Add(runningCell)
+	compositeIndexer.Add(pausedComposite)
+
+	cellLister := v1alpha2listers.NewCellLister(cellIndexer)
+	compositeLister := v1alpha2listers.NewCompositeLister(compositeIndexer)
+
+	tests := []struct {
+		name  string
+		owner *metav1.OwnerReference
+		want  bool
+	}{
+		{
+			name: "no owner",
+		},
+		{
+			name:  "paused cell",
+			owner: CreateCellOwnerRef(pausedCell),
+			want:  true,
+		},
+		{
+			name:  "running cell",
+			owner: CreateCellOwnerRef(runningCell),
+		},
+		{
+			name:  "paused composite",
+			owner: CreateCompositeOwnerRef(pausedComposite),
+			want:  true,
+		},
+		{
+			name: "recreated cell",
+			owner: CreateCellOwnerRef(&v1alpha2.Cell{
+				ObjectMeta: metav1.ObjectMeta{Name: "paused", Namespace: "foo", UID: types.UID("4")},
+			}),
+		},
+		{
+			name: "missing cell",
+			owner: CreateCellOwnerRef(&v1alpha2.Cell{
+				ObjectMeta: metav1.ObjectMeta{Name: "missing", Namespace: "foo"},
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			component := &v1alpha2.Component{ObjectMeta: metav1.ObjectMeta{Name: "component", Namespace: "foo"}}
+			if test.owner != nil {
+				component.OwnerReferences = []metav1.OwnerReference{*test.owner}
+			}
+			if got := IsOwnerPaused(component, cellLister, compositeLister); got != test.want {
+				t.Errorf("IsOwnerPaused() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestHandlePauseChange(t *testing.T) {
+	var called int
+	h := HandlePauseChange(func(interface{}) { called++ })
+
+	running := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{Name: "cell", Namespace: "foo"}}
+	paused := running.DeepCopy()
+	paused.Annotations = pausedAnnotations(true)
+
+	h.OnUpdate(running, running)
+	if called != 0 {
+		t.Errorf("handler called %d times for an update without a pause change", called)
+	}
+	h.OnUpdate(running, paused)
+	h.OnUpdate(paused, running)
+	if called != 2 {
+		t.Errorf("handler called %d times, want 2", called)
+	}
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
+
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+// DefaultRevisionHistoryLimit is the number of previous revisions kept when the limit is not configured.
+const DefaultRevisionHistoryLimit = 10
+
+// RevisionHistoryLimit returns the number of previous revisions kept for each Cell and Composite.
+func RevisionHistoryLimit(cfg config.Interface) int {
+	v, ok := cfg.Value(config.ConfigMapKeyRevisionHistoryLimit)
+	if !ok {
+		return DefaultRevisionHistoryLimit
+	}
+	limit, err := strconv.Atoi(v)
+	if err != nil || limit < 0 {
+		return DefaultRevisionHistoryLimit
+	}
+	return limit
+}
+
+// revisionData is the content of a ControllerRevision.
+type revisionData struct {
+	Spec interface{} `json:"spec"`
+}
+
+// RevisionName returns the name of the ControllerRevision which holds the given spec of the owner.
+func RevisionName(owner metav1.Object, spec interface{}) string {
+	return fmt.Sprintf("%s-%s", owner.GetName(), meta.Hash(spec)[:10])
+}
+
+// ListRevisions returns the ControllerRevisions controlled by the owner in the ascending order of
+// their revision numbers. The revisions are labeled with the name of the owner using the label key.
+func ListRevisions(lister appsv1listers.ControllerRevisionLister, owner metav1.Object, labelKey string) ([]*appsv1.ControllerRevision, error) {
+	list, err := lister.ControllerRevisions(owner.GetNamespace()).List(labels.SelectorFromSet(map[string]string{
+		labelKey: owner.GetName(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	var revisions []*appsv1.ControllerRevision
+	for _, revision := range list {
+		if metav1.IsControlledBy(revision, owner) {
+			revisions = append(revisions, revision)
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	return revisions, nil
+}
+
+// SnapshotRevision records the spec as the latest revision of the owner. An existing revision with
+// the same spec is renumbered as the latest instead of creating a new one. The oldest revisions
+// exceeding the history limit are deleted afterwards.
+func SnapshotRevision(kubeClient kubernetes.Interface, revisions []*appsv1.ControllerRevision, owner metav1.Object,
+	ownerRef *metav1.OwnerReference, labelKey string, spec interface{}, limit int) (*appsv1.ControllerRevision, bool, error) {
+	name := RevisionName(owner, spec)
+	var next int64 = 1
+	if len(revisions) > 0 {
+		next = revisions[len(revisions)-1].Revision + 1
+	}
+
+	var current *appsv1.ControllerRevision
+	var history []*appsv1.ControllerRevision
+	for _, revision := range revisions {
+		if revision.Name == name {
+			current = revision
+		} else {
+			history = append(history, revision)
+		}
+	}
+
+	created := false
+	if current == nil {
+		raw, err := json.Marshal(revisionData{Spec: spec})
+		if err != nil {
+			return nil, false, err
+		}
+		current, err = kubeClient.AppsV1().ControllerRevisions(owner.GetNamespace()).Create(&appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       owner.GetNamespace(),
+				Labels:          map[string]string{labelKey: owner.GetName()},
+				OwnerReferences: []metav1.OwnerReference{*ownerRef},
+			},
+			Data:     runtime.RawExtension{Raw: raw},
+			Revision: next,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		created = true
+	} else if current.Revision != next-1 {
+		// The spec is changed back to a previous revision
+		latest := current.DeepCopy()
+		latest.Revision = next
+		var err error
+		if current, err = kubeClient.AppsV1().ControllerRevisions(owner.GetNamespace()).Update(latest); err != nil {
+			return nil, false, err
+		}
+	}
+
+	for len(history) > limit {
+		if err := kubeClient.AppsV1().ControllerRevisions(owner.GetNamespace()).Delete(history[0].Name, &metav1.DeleteOptions{}); err != nil {
+			return nil, false, err
+		}
+		history = history[1:]
+	}
+	return current, created, nil
+}
+
+// RollbackRevision returns the revision requested by the rollback annotation of the owner. The
+// revision before the current one is returned if the requested revision number is 0.
+func RollbackRevision(revisions []*appsv1.ControllerRevision, owner metav1.Object, current string) (*appsv1.ControllerRevision, error) {
+	value := owner.GetAnnotations()[meta.RollbackToAnnotationKey]
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number < 0 {
+		return nil, fmt.Errorf("invalid revision %q", value)
+	}
+	if number == 0 {
+		for i := len(revisions) - 1; i > 0; i-- {
+			if revisions[i].Name == current {
+				return revisions[i-1], nil
+			}
+		}
+		return nil, fmt.Errorf("no revision found before the current revision %q", current)
+	}
+	for _, revision := range revisions {
+		if revision.Revision == number {
+			return revision, nil
+		}
+	}
+	return nil, fmt.Errorf("revision %d is not found", number)
+}
+
+// DecodeRevision reads the spec stored in the revision into the given spec.
+func DecodeRevision(revision *appsv1.ControllerRevision, spec interface{}) error {
+	return json.Unmarshal(revision.Data.Raw, &revisionData{Spec: spec})
+}
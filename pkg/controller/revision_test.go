@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/meta"
+)
+
+func TestRollbackRevision(t *testing.T) {
+	revisions := []*appsv1.ControllerRevision{
+		{ObjectMeta: metav1.ObjectMeta{Name: "foo-1"}, Revision: 1},
+		{ObjectMeta: metav1.ObjectMeta{Name: "foo-2"}, Revision: 2},
+		{ObjectMeta: metav1.ObjectMeta{Name: "foo-3"}, Revision: 3},
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		current string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "revision number",
+			value: "1",
+			want:  "foo-1",
+		},
+		{
+			name:    "previous revision",
+			value:   "0",
+			current: "foo-3",
+			want:    "foo-2",
+		},
+		{
+			name:    "no revision before the current one",
+			value:   "0",
+			current: "foo-1",
+			wantErr: true,
+		},
+		{
+			name:    "missing revision",
+			value:   "4",
+			wantErr: true,
+		},
+		{
+			name:    "invalid revision",
+			value:   "latest",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cell := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{
+				Name:        "foo",
+				Annotations: map[string]string{meta.RollbackToAnnotationKey: test.value},
+			}}
+			got, err := RollbackRevision(revisions, cell, test.current)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("RollbackRevision() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if err == nil && got.Name != test.want {
+				t.Errorf("RollbackRevision() = %s, want %s", got.Name, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeRevision(t *testing.T) {
+	want := v1alpha2.CompositeSpec{Components: []v1alpha2.Component{{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}}}
+	revision := &appsv1.ControllerRevision{}
+	revision.Data.Raw = []byte(`{"spec":{"components":[{"metadata":{"name":"foo"}}]}}`)
+
+	var got v1alpha2.CompositeSpec
+	if err := DecodeRevision(revision, &got); err != nil {
+		t.Fatalf("DecodeRevision() error = %v", err)
+	}
+	if got.Components[0].Name != want.Components[0].Name {
+		t.Errorf("DecodeRevision() = %+v, want %+v", got, want)
+	}
+}
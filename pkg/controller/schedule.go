@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+)
+
+// ActiveSchedule returns the first of the given schedules which is active at the given time and the
+// duration until the next time any of the schedules starts or ends. The duration is zero if none of
+// the schedules will start again.
+func ActiveSchedule(schedules []v1alpha2.ScalingSchedule, now time.Time) (*v1alpha2.ScalingSchedule, time.Duration, error) {
+	var active *v1alpha2.ScalingSchedule
+	var next time.Time
+	for i := range schedules {
+		s := &schedules[i]
+		start, end, err := nextWindow(s, now)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid schedule %q: %v", s.Name, err)
+		}
+		if start.IsZero() {
+			continue
+		}
+		boundary := start
+		if !start.After(now) {
+			boundary = end
+			if active == nil {
+				active = s
+			}
+		}
+		if next.IsZero() || boundary.Before(next) {
+			next = boundary
+		}
+	}
+	if next.IsZero() {
+		return active, 0, nil
+	}
+	return active, next.Sub(now), nil
+}
+
+// nextWindow returns the start and the end of the first window of the schedule which ends after the
+// given time. The start is zero if the schedule does not start again.
+func nextWindow(s *v1alpha2.ScalingSchedule, now time.Time) (time.Time, time.Time, error) {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	schedule, err := cron.ParseStandard(s.Schedule)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	start := schedule.Next(now.Add(-s.Duration.Duration).In(loc))
+	if start.IsZero() {
+		return start, start, nil
+	}
+	return start, start.Add(s.Duration.Duration), nil
+}
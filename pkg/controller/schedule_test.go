@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/ptr"
+)
+
+func TestActiveSchedule(t *testing.T) {
+	schedules := []v1alpha2.ScalingSchedule{
+		{
+			Name:     "business-hours",
+			Schedule: "0 8 * * 1-5",
+			TimeZone: "Asia/Colombo",
+			Duration: metav1.Duration{Duration: 10 * time.Hour},
+			Replicas: ptr.Int32(5),
+		},
+		{
+			Name:     "nightly-batch",
+			Schedule: "0 22 * * *",
+			Duration: metav1.Duration{Duration: 2 * time.Hour},
+			Replicas: ptr.Int32(3),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		wantActive string
+		wantNext   time.Duration
+	}{
+		{
+			// Monday 02:30 UTC is 08:00 in Colombo
+			name:       "start of the business hours",
+			now:        time.Date(2019, 9, 2, 2, 30, 0, 0, time.UTC),
+			wantActive: "business-hours",
+			wantNext:   10 * time.Hour,
+		},
+		{
+			name:       "during the business hours",
+			now:        time.Date(2019, 9, 2, 10, 30, 0, 0, time.UTC),
+			wantActive: "business-hours",
+			wantNext:   2 * time.Hour,
+		},
+		{
+			name:     "after the business hours",
+			now:      time.Date(2019, 9, 2, 12, 30, 0, 0, time.UTC),
+			wantNext: 9*time.Hour + 30*time.Minute,
+		},
+		{
+			name:       "nightly batch",
+			now:        time.Date(2019, 9, 2, 23, 0, 0, 0, time.UTC),
+			wantActive: "nightly-batch",
+			wantNext:   time.Hour,
+		},
+		{
+			name:     "weekend",
+			now:      time.Date(2019, 9, 7, 12, 0, 0, 0, time.UTC),
+			wantNext: 10 * time.Hour,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			active, next, err := ActiveSchedule(schedules, test.now)
+			if err != nil {
+				t.Fatalf("ActiveSchedule() returned an error: %v", err)
+			}
+			var gotActive string
+			if active != nil {
+				gotActive = active.Name
+			}
+			if gotActive != test.wantActive {
+				t.Errorf("ActiveSchedule() active = %q, want %q", gotActive, test.wantActive)
+			}
+			if next != test.wantNext {
+				t.Errorf("ActiveSchedule() next = %v, want %v", next, test.wantNext)
+			}
+		})
+	}
+}
+
+func TestActiveScheduleInvalid(t *testing.T) {
+	schedules := []v1alpha2.ScalingSchedule{{Name: "invalid", Schedule: "0 8 * *"}}
+	if _, _, err := ActiveSchedule(schedules, time.Now()); err == nil {
+		t.Errorf("ActiveSchedule() did not return an error for an invalid cron expression")
+	}
+	if active, next, err := ActiveSchedule(nil, time.Now()); active != nil || next != 0 || err != nil {
+		t.Errorf("ActiveSchedule() = %v, %v, %v for no schedules", active, next, err)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package sts
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/controller/sts/resources"
+)
+
+// Inspect returns the desired child resources of the token service along with the ones held by the listers.
+func (r *reconciler) Inspect(key string) ([]controller.ResourceState, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, err
+	}
+	original, err := r.tokenServiceLister.TokenServices(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	tokenService := original.DeepCopy()
+	tokenService.Default()
+	cfg := r.cfg.ForNamespace(tokenService.Namespace)
+
+	var states []controller.ResourceState
+
+	serviceName := resources.ServiceName(tokenService)
+	service, err := r.serviceLister.Services(tokenService.Namespace).Get(serviceName)
+	states = append(states, controller.NewResourceState("Service", serviceName,
+		resources.MakeService(tokenService), nil, service, err))
+
+	configMapName := resources.ConfigMapName(tokenService)
+	configMap, err := r.configMapLister.ConfigMaps(tokenService.Namespace).Get(configMapName)
+	states = append(states, controller.NewResourceState("ConfigMap", configMapName,
+		resources.MakeConfigMap(tokenService, cfg), nil, configMap, err))
+
+	opaConfigMapName := resources.OpaPolicyConfigMapName(tokenService)
+	opaConfigMap, err := r.configMapLister.ConfigMaps(tokenService.Namespace).Get(opaConfigMapName)
+	states = append(states, controller.NewResourceState("ConfigMap", opaConfigMapName,
+		resources.MakeOpaConfigMap(tokenService, cfg), nil, opaConfigMap, err))
+
+	deploymentName := resources.DeploymentName(tokenService)
+	deployment, err := r.deploymentLister.Deployments(tokenService.Namespace).Get(deploymentName)
+	states = append(states, controller.NewResourceState("Deployment", deploymentName,
+		resources.MakeDeployment(tokenService, cfg), nil, deployment, err))
+
+	envoyFilterName := resources.EnvoyFilterName(tokenService)
+	var desiredEnvoyFilter interface{}
+	if resources.RequireEnvoyFilter(tokenService) {
+		desiredEnvoyFilter = resources.MakeEnvoyFilter(tokenService, cfg)
+	}
+	envoyFilter, err := r.istioEnvoyFilterLister.EnvoyFilters(tokenService.Namespace).Get(envoyFilterName)
+	states = append(states, controller.NewResourceState("EnvoyFilter", envoyFilterName,
+		desiredEnvoyFilter, nil, envoyFilter, err))
+
+	return states, nil
+}
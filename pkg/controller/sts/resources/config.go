@@ -28,6 +28,7 @@ import (
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
 func MakeConfigMap(tokenService *v1alpha2.TokenService, cfg config.Interface) *corev1.ConfigMap {
@@ -43,6 +44,9 @@ func MakeConfigMap(tokenService *v1alpha2.TokenService, cfg config.Interface) *c
 			Name:      ConfigMapName(tokenService),
 			Namespace: tokenService.Namespace,
 			Labels:    makeLabels(tokenService),
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: cfg.Hash(),
+			},
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateTokenServiceOwnerRef(tokenService),
 			},
@@ -83,6 +87,9 @@ func MakeOpaConfigMap(tokenService *v1alpha2.TokenService, cfg config.Interface)
 			Name:      OpaPolicyConfigMapName(tokenService),
 			Namespace: tokenService.Namespace,
 			Labels:    makeLabels(tokenService),
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: cfg.Hash(),
+			},
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateTokenServiceOwnerRef(tokenService),
 			},
@@ -25,43 +25,54 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"cellery.io/cellery-controller/pkg/apis/mesh"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
-	"cellery.io/cellery-controller/pkg/controller/sts/config"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
-func TestCreateTokenServiceConfigMap(t *testing.T) {
+func TestMakeConfigMap(t *testing.T) {
+	withoutSpec := &v1alpha2.TokenService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo",
+		},
+	}
+	withUnsecuredPaths := &v1alpha2.TokenService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo",
+		},
+		Spec: v1alpha2.TokenServiceSpec{
+			UnsecuredPaths: []string{"/path1", "/path2"},
+		},
+	}
+	cfg := fakeconfig.New(map[string]string{
+		config.ConfigMapKeyTokenServiceConfig: "{my-key:my-value}",
+	})
+
 	tests := []struct {
 		name         string
-		tokenService *v1alpha1.TokenService
-		config       config.TokenService
+		tokenService *v1alpha2.TokenService
 		want         *corev1.ConfigMap
 	}{
 		{
-			name: "foo token service without spec",
-			tokenService: &v1alpha1.TokenService{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-			},
-			config: config.TokenService{
-				Config: "{my-key:my-value}",
-			},
+			name:         "foo token service without spec",
+			tokenService: withoutSpec,
 			want: &corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "foo-namespace",
 					Name:      "foo-config",
 					Labels: map[string]string{
-						mesh.CellTokenServiceLabelKey: "foo",
+						meta.TokenServiceLabelKey: "foo",
+					},
+					Annotations: map[string]string{
+						meta.ConfigHashAnnotationKey: cfg.Hash(),
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						*controller.CreateTokenServiceOwnerRef(withoutSpec),
 					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "TokenService",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
 				},
 				Data: map[string]string{
 					"sts-config":      "{my-key:my-value}",
@@ -70,33 +81,21 @@ func TestCreateTokenServiceConfigMap(t *testing.T) {
 			},
 		},
 		{
-			name: "foo token service with unsecured path",
-			tokenService: &v1alpha1.TokenService{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-				Spec: v1alpha1.TokenServiceSpec{
-					UnsecuredPaths: []string{"/path1", "/path2"},
-				},
-			},
-			config: config.TokenService{
-				Config: "{my-key:my-value}",
-			},
+			name:         "foo token service with unsecured path",
+			tokenService: withUnsecuredPaths,
 			want: &corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "foo-namespace",
 					Name:      "foo-config",
 					Labels: map[string]string{
-						mesh.CellTokenServiceLabelKey: "foo",
+						meta.TokenServiceLabelKey: "foo",
+					},
+					Annotations: map[string]string{
+						meta.ConfigHashAnnotationKey: cfg.Hash(),
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						*controller.CreateTokenServiceOwnerRef(withUnsecuredPaths),
 					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "TokenService",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
 				},
 				Data: map[string]string{
 					"sts-config":      "{my-key:my-value}",
@@ -108,54 +107,54 @@ func TestCreateTokenServiceConfigMap(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := CreateTokenServiceConfigMap(test.tokenService, test.config)
+			got := MakeConfigMap(test.tokenService, cfg)
 			if diff := cmp.Diff(test.want, got); diff != "" {
-				t.Errorf("CreateTokenServiceConfigMap (-want, +got)\n%v", diff)
+				t.Errorf("MakeConfigMap (-want, +got)\n%v", diff)
 			}
 		})
 	}
 }
 
-func TestCreateTokenServiceOPAConfigMap(t *testing.T) {
+func TestMakeOpaConfigMap(t *testing.T) {
+	withPolicy := &v1alpha2.TokenService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo",
+		},
+		Spec: v1alpha2.TokenServiceSpec{
+			OpaPolicies: []v1alpha2.OpaPolicy{
+				{
+					Key:    "policy-key",
+					Policy: "policy rego",
+				},
+			},
+		},
+	}
+	cfg := fakeconfig.New(map[string]string{
+		config.ConfigMapKeyTokenServiceDefaultOpaPolicy: "default policy",
+	})
+
 	tests := []struct {
 		name         string
-		tokenService *v1alpha1.TokenService
-		config       config.TokenService
+		tokenService *v1alpha2.TokenService
 		want         *corev1.ConfigMap
 	}{
 		{
-			name: "foo token service with a policy spec",
-			tokenService: &v1alpha1.TokenService{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
-				Spec: v1alpha1.TokenServiceSpec{
-					OpaPolicies: []v1alpha1.OpaPolicy{
-						{
-							Key:    "policy-key",
-							Policy: "policy rego",
-						},
-					},
-				},
-			},
-			config: config.TokenService{
-				Policy: "default policy",
-			},
+			name:         "foo token service with a policy spec",
+			tokenService: withPolicy,
 			want: &corev1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "foo-namespace",
 					Name:      "foo-policy",
 					Labels: map[string]string{
-						mesh.CellTokenServiceLabelKey: "foo",
+						meta.TokenServiceLabelKey: "foo",
+					},
+					Annotations: map[string]string{
+						meta.ConfigHashAnnotationKey: cfg.Hash(),
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						*controller.CreateTokenServiceOwnerRef(withPolicy),
 					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "TokenService",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
 				},
 				Data: map[string]string{
 					"default.rego":    "default policy",
@@ -167,9 +166,9 @@ func TestCreateTokenServiceOPAConfigMap(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := CreateTokenServiceOPAConfigMap(test.tokenService, test.config)
+			got := MakeOpaConfigMap(test.tokenService, cfg)
 			if diff := cmp.Diff(test.want, got); diff != "" {
-				t.Errorf("TestCreateTokenServiceOPAConfigMap (-want, +got)\n%v", diff)
+				t.Errorf("MakeOpaConfigMap (-want, +got)\n%v", diff)
 			}
 		})
 	}
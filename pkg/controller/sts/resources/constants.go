@@ -73,4 +73,13 @@ const (
 	baseFilterName = "envoy.ext_authz"
 	statPrefix     = "ext_authz"
 	filterTimeout  = "10s"
+
+	// Envoy filter config patches (istio 1.5+)
+	filterApplyToHTTPFilter         = "HTTP_FILTER"
+	filterOperationInsertBefore     = "INSERT_BEFORE"
+	httpConnectionManagerFilterName = "envoy.filters.network.http_connection_manager"
+	routerFilterName                = "envoy.filters.http.router"
+	extAuthzFilterName              = "envoy.filters.http.ext_authz"
+	extAuthzConfigType              = "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz"
+	extAuthzTransportAPIVersion     = "V3"
 )
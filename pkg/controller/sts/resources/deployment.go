@@ -29,6 +29,7 @@ import (
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 	"cellery.io/cellery-controller/pkg/ptr"
 )
 
@@ -42,6 +43,9 @@ func MakeDeployment(tokenService *v1alpha2.TokenService, cfg config.Interface) *
 			Name:      DeploymentName(tokenService),
 			Namespace: tokenService.Namespace,
 			Labels:    makeLabels(tokenService),
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: cfg.Hash(),
+			},
 			OwnerReferences: []metav1.OwnerReference{
 				*controller.CreateTokenServiceOwnerRef(tokenService),
 			},
@@ -25,259 +25,255 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
-	"cellery.io/cellery-controller/pkg/apis/mesh"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
 	"cellery.io/cellery-controller/pkg/controller"
-	"cellery.io/cellery-controller/pkg/controller/sts/config"
+	"cellery.io/cellery-controller/pkg/meta"
+	"cellery.io/cellery-controller/pkg/ptr"
 )
 
-var intOne int32 = 1
+func TestMakeDeployment(t *testing.T) {
+	tokenService := &v1alpha2.TokenService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo",
+			Labels: map[string]string{
+				meta.CellLabelKey: "my-cell",
+			},
+			Annotations: map[string]string{
+				"mesh.cellery.io/cell-image-org":     "my-org",
+				"mesh.cellery.io/cell-image-name":    "my-cell-image",
+				"mesh.cellery.io/cell-image-version": "1.2.3",
+			},
+		},
+		Spec: v1alpha2.TokenServiceSpec{
+			SecretName:   "my-cell--secret",
+			InstanceName: "my-cell",
+		},
+	}
+	cfg := fakeconfig.New(map[string]string{
+		config.ConfigMapKeyTokenServiceImage:     "vick/cell-sts",
+		config.ConfigMapKeyTokenServiceOpaImage:  "openpolicyagent/opa",
+		config.ConfigMapKeyTokenServiceJwksImage: "wso2cellery/jwks-server",
+	})
+	labels := map[string]string{
+		meta.TokenServiceLabelKey: "foo",
+		meta.CellLabelKey:         "my-cell",
+	}
 
-func TestCreateTokenServiceDeployment(t *testing.T) {
-	tests := []struct {
-		name         string
-		tokenService *v1alpha1.TokenService
-		config       config.TokenService
-		want         *appsv1.Deployment
-	}{
-		{
-			name: "foo token service without spec",
-			tokenService: &v1alpha1.TokenService{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-					Labels: map[string]string{
-						mesh.CellLabelKey: "my-cell",
-					},
-					Annotations: map[string]string{
-						"mesh.cellery.io/cell-image-org":     "my-org",
-						"mesh.cellery.io/cell-image-name":    "my-cell-image",
-						"mesh.cellery.io/cell-image-version": "1.2.3",
-					},
-				},
+	want := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-deployment",
+			Labels:    labels,
+			Annotations: map[string]string{
+				meta.ConfigHashAnnotationKey: cfg.Hash(),
 			},
-			config: config.TokenService{
-				Image:     "vick/cell-sts",
-				JwksImage: "wso2cellery/jwks-server",
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateTokenServiceOwnerRef(tokenService),
 			},
-			want: &appsv1.Deployment{
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.Int32(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-deployment",
-					Labels: map[string]string{
-						mesh.CellTokenServiceLabelKey: "foo",
-						mesh.CellLabelKey:             "my-cell",
+					Labels: labels,
+					Annotations: map[string]string{
+						meta.IstioSidecarInjectAnnotationKey: "false",
+						meta.CellLabelKey:                    "my-cell",
 					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "TokenService",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
 				},
-				Spec: appsv1.DeploymentSpec{
-					Replicas: &intOne,
-					Selector: &metav1.LabelSelector{
-						MatchLabels: map[string]string{
-							mesh.CellTokenServiceLabelKey: "foo",
-							mesh.CellLabelKey:             "my-cell",
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "sts",
+							Image: "vick/cell-sts",
+							Env: []corev1.EnvVar{
+								{
+									Name:  envCellNameKey,
+									Value: "my-cell",
+								},
+								{
+									Name:  "CELL_NAMESPACE",
+									Value: "foo-namespace",
+								},
+								{
+									Name:  "VALIDATE_SERVER_CERT",
+									Value: "true",
+								},
+								{
+									Name:  "ENABLE_HOSTNAME_VERIFICATION",
+									Value: "true",
+								},
+								{
+									Name:  "CELL_IMAGE_NAME",
+									Value: "my-cell-image",
+								},
+								{
+									Name:  "CELL_IMAGE_VERSION",
+									Value: "1.2.3",
+								},
+								{
+									Name:  "CELL_INSTANCE_NAME",
+									Value: "my-cell",
+								},
+								{
+									Name:  "CELL_ORG_NAME",
+									Value: "my-org",
+								},
+							},
+							ReadinessProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									TCPSocket: &corev1.TCPSocketAction{
+										Port: intstr.IntOrString{Type: intstr.Int, IntVal: 8082},
+									},
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      configVolumeName,
+									MountPath: configMountPath,
+									ReadOnly:  true,
+								},
+								{
+									Name:      policyVolumeName,
+									MountPath: pocliyConfigMountPath,
+									ReadOnly:  true,
+								},
+								{
+									Name:      keyPairVolumeName,
+									MountPath: keyPairMountPath,
+									ReadOnly:  true,
+								},
+								{
+									Name:      caCertsVolumeName,
+									MountPath: caCertsMountPath,
+									ReadOnly:  true,
+								},
+							},
 						},
-					},
-					Template: corev1.PodTemplateSpec{
-						ObjectMeta: metav1.ObjectMeta{
-							Labels: map[string]string{
-								mesh.CellTokenServiceLabelKey: "foo",
-								mesh.CellLabelKey:             "my-cell",
+						{
+							Name:  "opa",
+							Image: "openpolicyagent/opa",
+							Ports: []corev1.ContainerPort{
+								{
+									ContainerPort: opaServicePort,
+									Name:          "http",
+								},
 							},
-							Annotations: map[string]string{
-								controller.IstioSidecarInjectAnnotation: "false",
+							Args: []string{
+								"run",
+								"--ignore=.*",
+								"--server",
+								"--watch",
+								"/policies",
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      policyVolumeName,
+									MountPath: pocliyConfigMountPath,
+									ReadOnly:  true,
+								},
 							},
 						},
-						Spec: corev1.PodSpec{
-							Containers: []corev1.Container{
+						{
+							Name:  "jwks-server",
+							Image: "wso2cellery/jwks-server",
+							Env: []corev1.EnvVar{
 								{
-									Name:  "cell-sts",
-									Image: "vick/cell-sts",
-									Ports: []corev1.ContainerPort{
-										{
-											ContainerPort: 8080,
-										},
-										{
-											ContainerPort: 8081,
-										},
-									},
-									Env: []corev1.EnvVar{
-										{
-											Name:  envCellNameKey,
-											Value: "my-cell",
-										},
-										{
-											Name:  "VALIDATE_SERVER_CERT",
-											Value: "true",
-										},
-										{
-											Name:  "ENABLE_HOSTNAME_VERIFICATION",
-											Value: "true",
-										},
-										{
-											Name:  "CELL_IMAGE_NAME",
-											Value: "my-cell-image",
-										},
-										{
-											Name:  "CELL_IMAGE_VERSION",
-											Value: "1.2.3",
-										},
-										{
-											Name:  "CELL_INSTANCE_NAME",
-											Value: "my-cell",
-										},
-										{
-											Name:  "CELL_ORG_NAME",
-											Value: "my-org",
-										},
-									},
-									VolumeMounts: []corev1.VolumeMount{
-										{
-											Name:      configVolumeName,
-											MountPath: configMountPath,
-											ReadOnly:  true,
-										},
-										{
-											Name:      policyVolumeName,
-											MountPath: pocliyConfigMountPath,
-											ReadOnly:  true,
-										},
-										{
-											Name:      keyPairVolumeName,
-											MountPath: keyPairMountPath,
-											ReadOnly:  true,
-										},
-										{
-											Name:      caCertsVolumeName,
-											MountPath: caCertsMountPath,
-											ReadOnly:  true,
-										},
+									Name:  "jwksPort",
+									Value: "8090",
+								},
+							},
+							ReadinessProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									TCPSocket: &corev1.TCPSocketAction{
+										Port: intstr.IntOrString{Type: intstr.Int, IntVal: 8090},
 									},
 								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
 								{
-									Name:  "opa",
-									Image: "",
-									Ports: []corev1.ContainerPort{
-										{
-											ContainerPort: opaServicePort,
-											Name:          "http",
-										},
-									},
-									Args: []string{
-										"run",
-										"--ignore=.*",
-										"--server",
-										"--watch",
-										"/policies",
-									},
-
-									VolumeMounts: []corev1.VolumeMount{
-										{
-											Name:      policyVolumeName,
-											MountPath: pocliyConfigMountPath,
-											ReadOnly:  true,
-										},
-									},
+									Name:      keyPairVolumeName,
+									MountPath: keyPairMountPath,
+									ReadOnly:  true,
 								},
 								{
-									Name:  "jwks-server",
-									Image: "wso2cellery/jwks-server",
-									Env: []corev1.EnvVar{
-										{
-											Name:  "jwksPort",
-											Value: "8090",
-										},
-									},
-									Ports: []corev1.ContainerPort{
-										{
-											ContainerPort: 8090,
-										},
+									Name:      caCertsVolumeName,
+									MountPath: caCertsMountPath,
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: configVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "foo-config",
 									},
-									VolumeMounts: []corev1.VolumeMount{
+									Items: []corev1.KeyToPath{
 										{
-											Name:      keyPairVolumeName,
-											MountPath: keyPairMountPath,
-											ReadOnly:  true,
+											Key:  tokenServiceConfigKey,
+											Path: tokenServiceConfigFile,
 										},
 										{
-											Name:      caCertsVolumeName,
-											MountPath: caCertsMountPath,
-											ReadOnly:  true,
+											Key:  unsecuredPathsConfigKey,
+											Path: unsecuredPathsConfigFile,
 										},
 									},
 								},
 							},
-							Volumes: []corev1.Volume{
-								{
-									Name: configVolumeName,
-									VolumeSource: corev1.VolumeSource{
-										ConfigMap: &corev1.ConfigMapVolumeSource{
-											LocalObjectReference: corev1.LocalObjectReference{
-												Name: "foo-config",
-											},
-											Items: []corev1.KeyToPath{
-												{
-													Key:  tokenServiceConfigKey,
-													Path: tokenServiceConfigFile,
-												},
-												{
-													Key:  unsecuredPathsConfigKey,
-													Path: unsecuredPathsConfigFile,
-												},
-											},
-										},
+						},
+						{
+							Name: policyVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "foo-policy",
 									},
 								},
-								{
-									Name: policyVolumeName,
-									VolumeSource: corev1.VolumeSource{
-										ConfigMap: &corev1.ConfigMapVolumeSource{
-											LocalObjectReference: corev1.LocalObjectReference{
-												Name: "foo-policy",
-											},
+							},
+						},
+						{
+							Name: keyPairVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: "my-cell--secret",
+									Items: []corev1.KeyToPath{
+										{
+											Key:  "key.pem",
+											Path: "key.pem",
 										},
-									},
-								},
-								{
-									Name: keyPairVolumeName,
-									VolumeSource: corev1.VolumeSource{
-										Secret: &corev1.SecretVolumeSource{
-											SecretName: "my-cell--secret",
-											Items: []corev1.KeyToPath{
-												{
-													Key:  "key.pem",
-													Path: "key.pem",
-												},
-												{
-													Key:  "cert.pem",
-													Path: "cert.pem",
-												},
-											},
+										{
+											Key:  "cert.pem",
+											Path: "cert.pem",
 										},
 									},
 								},
-								{
-									Name: caCertsVolumeName,
-									VolumeSource: corev1.VolumeSource{
-										Secret: &corev1.SecretVolumeSource{
-											SecretName: "my-cell--secret",
-											Items: []corev1.KeyToPath{
-												{
-													Key:  "cellery-cert.pem",
-													Path: "cellery-cert.pem",
-												},
-												{
-													Key:  "cert-bundle.pem",
-													Path: "cert-bundle.pem",
-												},
-											},
+							},
+						},
+						{
+							Name: caCertsVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: "my-cell--secret",
+									Items: []corev1.KeyToPath{
+										{
+											Key:  "cellery-cert.pem",
+											Path: "cellery-cert.pem",
+										},
+										{
+											Key:  "cert-bundle.pem",
+											Path: "cert-bundle.pem",
 										},
 									},
 								},
@@ -289,12 +285,8 @@ func TestCreateTokenServiceDeployment(t *testing.T) {
 		},
 	}
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			got := CreateTokenServiceDeployment(test.tokenService, test.config)
-			if diff := cmp.Diff(test.want, got); diff != "" {
-				t.Errorf("CreateTokenServiceDeployment (-want, +got)\n%v", diff)
-			}
-		})
+	got := MakeDeployment(tokenService, cfg)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MakeDeployment (-want, +got)\n%v", diff)
 	}
 }
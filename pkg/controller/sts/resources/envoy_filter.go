@@ -25,17 +25,91 @@ import (
 
 	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
 	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
-func MakeEnvoyFilter(tokenService *v1alpha2.TokenService) *v1alpha3.EnvoyFilter {
+func MakeEnvoyFilter(tokenService *v1alpha2.TokenService, cfg config.Interface) *v1alpha3.EnvoyFilter {
+	envoyFilter := &v1alpha3.EnvoyFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      EnvoyFilterName(tokenService),
+			Namespace: tokenService.Namespace,
+			Labels:    makeLabels(tokenService),
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateTokenServiceOwnerRef(tokenService),
+			},
+		},
+	}
+	if controller.UseEnvoyConfigPatches(cfg) {
+		envoyFilter.Spec = makeEnvoyFilterSpec(tokenService)
+	} else {
+		envoyFilter.Spec = makeLegacyEnvoyFilterSpec(tokenService)
+	}
+	meta.AddObjectHash(envoyFilter)
+	return envoyFilter
+}
+
+// makeEnvoyFilterSpec inserts the ext_authz filter before the router of the http connection manager
+// using the configPatches schema of istio 1.5+.
+func makeEnvoyFilterSpec(tokenService *v1alpha2.TokenService) v1alpha3.EnvoyFilterSpec {
+	var patches []v1alpha3.EnvoyConfigObjectPatch
+	switch tokenService.Spec.InterceptMode {
+	case v1alpha2.InterceptModeInbound:
+		patches = append(patches, makeConfigPatch(tokenService, filterListenerTypeInbound, tokenServiceServiceInboundPort))
+	case v1alpha2.InterceptModeOutbound:
+		patches = append(patches, makeConfigPatch(tokenService, filterListenerTypeOutbound, tokenServiceServiceOutboundPort))
+	case v1alpha2.InterceptModeAny:
+		patches = append(patches, makeConfigPatch(tokenService, filterListenerTypeInbound, tokenServiceServiceInboundPort))
+		patches = append(patches, makeConfigPatch(tokenService, filterListenerTypeOutbound, tokenServiceServiceOutboundPort))
+		patches = append(patches, makeConfigPatch(tokenService, filterListenerTypeGateway, tokenServiceServiceGatewayPort))
+	}
+	return v1alpha3.EnvoyFilterSpec{
+		WorkloadSelector: &v1alpha3.WorkloadSelector{
+			Labels: tokenService.Spec.Selector,
+		},
+		ConfigPatches: patches,
+	}
+}
 
-	// var cellName string
-	// cellName, ok := tokenService.Labels[mesh.CellLabelKey]
-	// if !ok {
-	// 	cellName = tokenService.Name
-	// }
+func makeConfigPatch(tokenService *v1alpha2.TokenService, context string, port int) v1alpha3.EnvoyConfigObjectPatch {
+	return v1alpha3.EnvoyConfigObjectPatch{
+		ApplyTo: filterApplyToHTTPFilter,
+		Match: &v1alpha3.EnvoyConfigObjectMatch{
+			Context: context,
+			Listener: &v1alpha3.ListenerPatchMatch{
+				FilterChain: &v1alpha3.FilterChainMatch{
+					Filter: &v1alpha3.FilterMatch{
+						Name: httpConnectionManagerFilterName,
+						SubFilter: &v1alpha3.SubFilterMatch{
+							Name: routerFilterName,
+						},
+					},
+				},
+			},
+		},
+		Patch: &v1alpha3.Patch{
+			Operation: filterOperationInsertBefore,
+			Value: &v1alpha3.HTTPFilter{
+				Name: extAuthzFilterName,
+				TypedConfig: &v1alpha3.ExtAuthzConfig{
+					Type:                extAuthzConfigType,
+					TransportAPIVersion: extAuthzTransportAPIVersion,
+					GRPCService: v1alpha3.GRPCService{
+						GoogleGRPC: v1alpha3.GoogleGRPC{
+							TargetUri:  fmt.Sprintf("%s.%s:%d", ServiceName(tokenService), tokenService.Namespace, port),
+							StatPrefix: statPrefix,
+						},
+						Timeout: filterTimeout,
+					},
+				},
+			},
+		},
+	}
+}
 
+// makeLegacyEnvoyFilterSpec generates the filters schema which is understood by istio versions prior to 1.5.
+func makeLegacyEnvoyFilterSpec(tokenService *v1alpha2.TokenService) v1alpha3.EnvoyFilterSpec {
 	var filters []v1alpha3.Filter
 	switch tokenService.Spec.InterceptMode {
 	case v1alpha2.InterceptModeInbound:
@@ -47,30 +121,9 @@ func MakeEnvoyFilter(tokenService *v1alpha2.TokenService) *v1alpha3.EnvoyFilter
 		filters = append(filters, makeOutboundFilter(tokenService))
 		filters = append(filters, makeGatewayFilter(tokenService))
 	}
-
-	return &v1alpha3.EnvoyFilter{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      EnvoyFilterName(tokenService),
-			Namespace: tokenService.Namespace,
-			Labels:    makeLabels(tokenService),
-			OwnerReferences: []metav1.OwnerReference{
-				*controller.CreateTokenServiceOwnerRef(tokenService),
-			},
-		},
-		Spec: v1alpha3.EnvoyFilterSpec{
-			WorkloadLabels: tokenService.Spec.Selector,
-			// WorkloadLabels: func() map[string]string {
-			// 	if tokenService.Spec.Composite {
-			// 		return map[string]string{
-			// 			mesh.CompositeTokenServiceLabelKey: "true",
-			// 		}
-			// 	}
-			// 	return map[string]string{
-			// 		mesh.CellLabelKey: cellName,
-			// 	}
-			// }(),
-			Filters: filters,
-		},
+	return v1alpha3.EnvoyFilterSpec{
+		WorkloadLabels: tokenService.Spec.Selector,
+		Filters:        filters,
 	}
 }
 
@@ -150,9 +203,12 @@ func RequireEnvoyFilter(tokenService *v1alpha2.TokenService) bool {
 	return tokenService.Spec.InterceptMode != v1alpha2.InterceptModeNone
 }
 
-func RequireEnvoyFilterUpdate(tokenService *v1alpha2.TokenService, envoyFilter *v1alpha3.EnvoyFilter) bool {
+// RequireEnvoyFilterUpdate returns true if the token service or the EnvoyFilter is changed, or if the desired
+// EnvoyFilter is changed by the istio version of the config.
+func RequireEnvoyFilterUpdate(tokenService *v1alpha2.TokenService, desired, envoyFilter *v1alpha3.EnvoyFilter) bool {
 	return tokenService.Generation != tokenService.Status.ObservedGeneration ||
-		envoyFilter.Generation != tokenService.Status.EnvoyFilterGeneration
+		envoyFilter.Generation != tokenService.Status.EnvoyFilterGeneration ||
+		!meta.HashEqual(desired, envoyFilter)
 }
 
 func CopyEnvoyFilter(source, destination *v1alpha3.EnvoyFilter) {
@@ -25,168 +25,146 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
-	"cellery.io/cellery-controller/pkg/apis/mesh"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
-func TestCreateEnvoyFilter(t *testing.T) {
-	tests := []struct {
-		name         string
-		tokenService *v1alpha1.TokenService
-		want         *v1alpha3.EnvoyFilter
-	}{
-		{
-			name: "foo token service with any intercept mode",
-			tokenService: &v1alpha1.TokenService{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-					Labels: map[string]string{
-						"mesh.cellery.io/cell": "foo-cell",
+func testEnvoyFilterTokenService(interceptMode v1alpha2.InterceptMode) *v1alpha2.TokenService {
+	return &v1alpha2.TokenService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo",
+			Labels: map[string]string{
+				meta.CellLabelKey: "foo-cell",
+			},
+		},
+		Spec: v1alpha2.TokenServiceSpec{
+			Selector: map[string]string{
+				meta.CellLabelKey: "foo-cell",
+			},
+			InterceptMode: interceptMode,
+		},
+	}
+}
+
+func testConfigPatch(context string, targetUri string) v1alpha3.EnvoyConfigObjectPatch {
+	return v1alpha3.EnvoyConfigObjectPatch{
+		ApplyTo: "HTTP_FILTER",
+		Match: &v1alpha3.EnvoyConfigObjectMatch{
+			Context: context,
+			Listener: &v1alpha3.ListenerPatchMatch{
+				FilterChain: &v1alpha3.FilterChainMatch{
+					Filter: &v1alpha3.FilterMatch{
+						Name: "envoy.filters.network.http_connection_manager",
+						SubFilter: &v1alpha3.SubFilterMatch{
+							Name: "envoy.filters.http.router",
+						},
 					},
 				},
-				Spec: v1alpha1.TokenServiceSpec{
-					InterceptMode:  v1alpha1.InterceptModeAny,
-					UnsecuredPaths: []string{"/path1", "/path2"},
-					OpaPolicies: []v1alpha1.OpaPolicy{
-						{
-							Key:    "p1",
-							Policy: "policy1",
+			},
+		},
+		Patch: &v1alpha3.Patch{
+			Operation: "INSERT_BEFORE",
+			Value: &v1alpha3.HTTPFilter{
+				Name: "envoy.filters.http.ext_authz",
+				TypedConfig: &v1alpha3.ExtAuthzConfig{
+					Type:                "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz",
+					TransportAPIVersion: "V3",
+					GRPCService: v1alpha3.GRPCService{
+						GoogleGRPC: v1alpha3.GoogleGRPC{
+							TargetUri:  targetUri,
+							StatPrefix: "ext_authz",
 						},
+						Timeout: "10s",
 					},
 				},
 			},
-			want: &v1alpha3.EnvoyFilter{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-envoyfilter",
-					Labels: map[string]string{
-						"mesh.cellery.io/cell": "foo-cell",
-						"mesh.cellery.io/sts":  "foo",
-					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "TokenService",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
+		},
+	}
+}
+
+func testLegacyFilter(index string, listenerType string, targetUri string) v1alpha3.Filter {
+	return v1alpha3.Filter{
+		InsertPosition: v1alpha3.InsertPosition{
+			Index:      index,
+			RelativeTo: "mixer",
+		},
+		ListenerMatch: v1alpha3.ListenerMatch{
+			ListenerType:     listenerType,
+			ListenerProtocol: "HTTP",
+		},
+		FilterType: "HTTP",
+		FilterName: "envoy.ext_authz",
+		FilterConfig: v1alpha3.FilterConfig{
+			GRPCService: v1alpha3.GRPCService{
+				GoogleGRPC: v1alpha3.GoogleGRPC{
+					TargetUri:  targetUri,
+					StatPrefix: "ext_authz",
 				},
-				Spec: v1alpha3.EnvoyFilterSpec{
-					WorkloadLabels: map[string]string{
-						mesh.CellLabelKey: "foo-cell",
-					},
-					Filters: []v1alpha3.Filter{
-						{
-							InsertPosition: v1alpha3.InsertPosition{
-								Index:      "BEFORE",
-								RelativeTo: "mixer",
-							},
-							ListenerMatch: v1alpha3.ListenerMatch{
-								ListenerType:     "SIDECAR_INBOUND",
-								ListenerProtocol: "HTTP",
-							},
-							FilterType: "HTTP",
-							FilterName: "envoy.ext_authz",
-							FilterConfig: v1alpha3.FilterConfig{
-								GRPCService: v1alpha3.GRPCService{
-									GoogleGRPC: v1alpha3.GoogleGRPC{
-										TargetUri:  "foo-service:8080",
-										StatPrefix: "ext_authz",
-									},
-									Timeout: "10s",
-								},
-							},
-						},
-						{
-							InsertPosition: v1alpha3.InsertPosition{
-								Index:      "AFTER",
-								RelativeTo: "mixer",
-							},
-							ListenerMatch: v1alpha3.ListenerMatch{
-								ListenerType:     "SIDECAR_OUTBOUND",
-								ListenerProtocol: "HTTP",
-							},
-							FilterType: "HTTP",
-							FilterName: "envoy.ext_authz",
-							FilterConfig: v1alpha3.FilterConfig{
-								GRPCService: v1alpha3.GRPCService{
-									GoogleGRPC: v1alpha3.GoogleGRPC{
-										TargetUri:  "foo-service:8081",
-										StatPrefix: "ext_authz",
-									},
-									Timeout: "10s",
-								},
-							},
-						},
-					},
+				Timeout: "10s",
+			},
+		},
+	}
+}
+
+func TestMakeEnvoyFilter(t *testing.T) {
+	tests := []struct {
+		name          string
+		interceptMode v1alpha2.InterceptMode
+		config        map[string]string
+		want          v1alpha3.EnvoyFilterSpec
+	}{
+		{
+			name:          "inbound intercept mode without an istio version",
+			interceptMode: v1alpha2.InterceptModeInbound,
+			want: v1alpha3.EnvoyFilterSpec{
+				WorkloadSelector: &v1alpha3.WorkloadSelector{
+					Labels: map[string]string{meta.CellLabelKey: "foo-cell"},
+				},
+				ConfigPatches: []v1alpha3.EnvoyConfigObjectPatch{
+					testConfigPatch("SIDECAR_INBOUND", "foo-service.foo-namespace:8080"),
 				},
 			},
 		},
 		{
-			name: "foo token service with outbound intercept mode",
-			tokenService: &v1alpha1.TokenService{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-					Labels: map[string]string{
-						"mesh.cellery.io/cell": "foo-cell",
-					},
+			name:          "any intercept mode with istio 1.5",
+			interceptMode: v1alpha2.InterceptModeAny,
+			config:        map[string]string{config.ConfigMapKeyIstioVersion: "1.5.0"},
+			want: v1alpha3.EnvoyFilterSpec{
+				WorkloadSelector: &v1alpha3.WorkloadSelector{
+					Labels: map[string]string{meta.CellLabelKey: "foo-cell"},
 				},
-				Spec: v1alpha1.TokenServiceSpec{
-					InterceptMode:  v1alpha1.InterceptModeOutbound,
-					UnsecuredPaths: []string{"/path1", "/path2"},
-					OpaPolicies: []v1alpha1.OpaPolicy{
-						{
-							Key:    "p1",
-							Policy: "policy1",
-						},
-					},
+				ConfigPatches: []v1alpha3.EnvoyConfigObjectPatch{
+					testConfigPatch("SIDECAR_INBOUND", "foo-service.foo-namespace:8080"),
+					testConfigPatch("SIDECAR_OUTBOUND", "foo-service.foo-namespace:8081"),
+					testConfigPatch("GATEWAY", "foo-service.foo-namespace:8082"),
 				},
 			},
-			want: &v1alpha3.EnvoyFilter{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-envoyfilter",
-					Labels: map[string]string{
-						"mesh.cellery.io/cell": "foo-cell",
-						"mesh.cellery.io/sts":  "foo",
-					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "TokenService",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
+		},
+		{
+			name:          "inbound intercept mode with istio 1.0",
+			interceptMode: v1alpha2.InterceptModeInbound,
+			config:        map[string]string{config.ConfigMapKeyIstioVersion: "1.0.2"},
+			want: v1alpha3.EnvoyFilterSpec{
+				WorkloadLabels: map[string]string{meta.CellLabelKey: "foo-cell"},
+				Filters: []v1alpha3.Filter{
+					testLegacyFilter("BEFORE", "SIDECAR_INBOUND", "foo-service.foo-namespace:8080"),
 				},
-				Spec: v1alpha3.EnvoyFilterSpec{
-					WorkloadLabels: map[string]string{
-						mesh.CellLabelKey: "foo-cell",
-					},
-					Filters: []v1alpha3.Filter{
-						{
-							InsertPosition: v1alpha3.InsertPosition{
-								Index:      "AFTER",
-								RelativeTo: "mixer",
-							},
-							ListenerMatch: v1alpha3.ListenerMatch{
-								ListenerType:     "SIDECAR_OUTBOUND",
-								ListenerProtocol: "HTTP",
-							},
-							FilterType: "HTTP",
-							FilterName: "envoy.ext_authz",
-							FilterConfig: v1alpha3.FilterConfig{
-								GRPCService: v1alpha3.GRPCService{
-									GoogleGRPC: v1alpha3.GoogleGRPC{
-										TargetUri:  "foo-service:8081",
-										StatPrefix: "ext_authz",
-									},
-									Timeout: "10s",
-								},
-							},
-						},
-					},
+			},
+		},
+		{
+			name:          "any intercept mode with istio 1.0",
+			interceptMode: v1alpha2.InterceptModeAny,
+			config:        map[string]string{config.ConfigMapKeyIstioVersion: "1.0.2"},
+			want: v1alpha3.EnvoyFilterSpec{
+				WorkloadLabels: map[string]string{meta.CellLabelKey: "foo-cell"},
+				Filters: []v1alpha3.Filter{
+					testLegacyFilter("BEFORE", "SIDECAR_INBOUND", "foo-service.foo-namespace:8080"),
+					testLegacyFilter("AFTER", "SIDECAR_OUTBOUND", "foo-service.foo-namespace:8081"),
+					testLegacyFilter("BEFORE", "GATEWAY", "foo-service.foo-namespace:8082"),
 				},
 			},
 		},
@@ -194,10 +172,44 @@ func TestCreateEnvoyFilter(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := CreateEnvoyFilter(test.tokenService)
-			if diff := cmp.Diff(test.want, got); diff != "" {
-				t.Errorf("CreateEnvoyFilter (-want, +got)\n%v", diff)
+			tokenService := testEnvoyFilterTokenService(test.interceptMode)
+			want := &v1alpha3.EnvoyFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "foo-namespace",
+					Name:      "foo-envoyfilter",
+					Labels: map[string]string{
+						meta.CellLabelKey:         "foo-cell",
+						meta.TokenServiceLabelKey: "foo",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						*controller.CreateTokenServiceOwnerRef(tokenService),
+					},
+				},
+				Spec: test.want,
+			}
+			meta.AddObjectHash(want)
+			got := MakeEnvoyFilter(tokenService, fakeconfig.New(test.config))
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("MakeEnvoyFilter (-want, +got)\n%v", diff)
 			}
 		})
 	}
 }
+
+func TestRequireEnvoyFilterUpdate(t *testing.T) {
+	tokenService := testEnvoyFilterTokenService(v1alpha2.InterceptModeInbound)
+	tokenService.Generation = 2
+	tokenService.Status.ObservedGeneration = 2
+	tokenService.Status.EnvoyFilterGeneration = 1
+
+	legacy := MakeEnvoyFilter(tokenService, fakeconfig.New(map[string]string{config.ConfigMapKeyIstioVersion: "1.0.2"}))
+	legacy.Generation = 1
+
+	if RequireEnvoyFilterUpdate(tokenService, legacy, legacy) {
+		t.Errorf("RequireEnvoyFilterUpdate() = true without any change, want false")
+	}
+	desired := MakeEnvoyFilter(tokenService, fakeconfig.New(map[string]string{config.ConfigMapKeyIstioVersion: "1.5.0"}))
+	if !RequireEnvoyFilterUpdate(tokenService, desired, legacy) {
+		t.Errorf("RequireEnvoyFilterUpdate() = false after the istio version is changed, want true")
+	}
+}
@@ -26,76 +26,63 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
-	"cellery.io/cellery-controller/pkg/apis/mesh"
-	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha1"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/meta"
 )
 
-var boolTrue = true
-
-func TestCreateTokenServiceK8sService(t *testing.T) {
-	tests := []struct {
-		name         string
-		tokenService *v1alpha1.TokenService
-		want         *corev1.Service
-	}{
-		{
-			name: "foo token service without spec",
-			tokenService: &v1alpha1.TokenService{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo",
-				},
+func TestMakeService(t *testing.T) {
+	tokenService := &v1alpha2.TokenService{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo",
+		},
+	}
+	want := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "foo-namespace",
+			Name:      "foo-service",
+			Labels: map[string]string{
+				meta.TokenServiceLabelKey: "foo",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*controller.CreateTokenServiceOwnerRef(tokenService),
 			},
-			want: &corev1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "foo-namespace",
-					Name:      "foo-service",
-					Labels: map[string]string{
-						mesh.CellTokenServiceLabelKey: "foo",
-					},
-					OwnerReferences: []metav1.OwnerReference{{
-						APIVersion:         v1alpha1.SchemeGroupVersion.String(),
-						Kind:               "TokenService",
-						Name:               "foo",
-						Controller:         &boolTrue,
-						BlockOwnerDeletion: &boolTrue,
-					}},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "grpc-gateway",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       8082,
+					TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 8082},
+				},
+				{
+					Name:       "grpc-inbound",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       8080,
+					TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 8080},
 				},
-				Spec: corev1.ServiceSpec{
-					Ports: []corev1.ServicePort{
-						{
-							Name:       "grpc-inbound",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       8080,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 8080},
-						},
-						{
-							Name:       "grpc-outbound",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       8081,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 8081},
-						},
-						{
-							Name:       "http-jwks",
-							Protocol:   corev1.ProtocolTCP,
-							Port:       8090,
-							TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 8090},
-						},
-					},
-					Selector: map[string]string{
-						mesh.CellTokenServiceLabelKey: "foo",
-					},
+				{
+					Name:       "grpc-outbound",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       8081,
+					TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 8081},
 				},
+				{
+					Name:       "http-jwks",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       8090,
+					TargetPort: intstr.IntOrString{Type: intstr.Int, IntVal: 8090},
+				},
+			},
+			Selector: map[string]string{
+				meta.TokenServiceLabelKey: "foo",
 			},
 		},
 	}
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			got := CreateTokenServiceK8sService(test.tokenService)
-			if diff := cmp.Diff(test.want, got); diff != "" {
-				t.Errorf("CreateTokenServiceK8sService (-want, +got)\n%v", diff)
-			}
-		})
+	if diff := cmp.Diff(want, MakeService(tokenService)); diff != "" {
+		t.Errorf("MakeService (-want, +got)\n%v", diff)
 	}
 }
@@ -19,6 +19,7 @@
 package sts
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
@@ -35,6 +36,7 @@ import (
 	"cellery.io/cellery-controller/pkg/controller/sts/resources"
 	meshclientset "cellery.io/cellery-controller/pkg/generated/clientset/versioned"
 	"cellery.io/cellery-controller/pkg/informers"
+	"cellery.io/cellery-controller/pkg/logging"
 
 	//appsv1informers "k8s.io/client-go/informers/apps/v1"
 	//corev1informers "k8s.io/client-go/informers/core/v1"
@@ -46,6 +48,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	appsv1listers "k8s.io/client-go/listers/apps/v1"
@@ -64,6 +67,8 @@ type reconciler struct {
 	istioEnvoyFilterLister istionetwork1alpha3listers.EnvoyFilterLister
 	configMapLister        corev1listers.ConfigMapLister
 	tokenServiceLister     mesh1alpha2listers.TokenServiceLister
+	cellLister             mesh1alpha2listers.CellLister
+	compositeLister        mesh1alpha2listers.CompositeLister
 	cfg                    config.Interface
 	logger                 *zap.SugaredLogger
 	recorder               record.EventRecorder
@@ -75,18 +80,7 @@ func NewController(
 	cfg config.Interface,
 	logger *zap.SugaredLogger,
 ) *controller.Controller {
-
-	r := &reconciler{
-		kubeClient:             clientset.Kubernetes(),
-		meshClient:             clientset.Mesh(),
-		deploymentLister:       informerset.Deployments().Lister(),
-		serviceLister:          informerset.Services().Lister(),
-		istioEnvoyFilterLister: informerset.IstioEnvoyFilters().Lister(),
-		configMapLister:        informerset.ConfigMaps().Lister(),
-		tokenServiceLister:     informerset.TokenServices().Lister(),
-		cfg:                    cfg,
-		logger:                 logger.Named("tokenservice-controller"),
-	}
+	r := newReconciler(clientset, informerset, cfg, logger)
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartLogging(r.logger.Named("events").Infof)
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: r.kubeClient.CoreV1().Events("")})
@@ -97,6 +91,12 @@ func NewController(
 	r.logger.Info("Setting up event handlers")
 	informerset.TokenServices().Informer().AddEventHandler(informers.HandleAll(c.Enqueue))
 
+	informerset.Cells().Informer().AddEventHandler(controller.HandlePauseChange(c.EnqueueControlledBy(informerset.TokenServices().Informer().GetIndexer())))
+	informerset.Cells().Informer().AddEventHandler(controller.HandleSuspendChange(c.EnqueueControlledBy(informerset.TokenServices().Informer().GetIndexer())))
+
+	informerset.Composites().Informer().AddEventHandler(controller.HandlePauseChange(c.EnqueueControlledBy(informerset.TokenServices().Informer().GetIndexer())))
+	informerset.Composites().Informer().AddEventHandler(controller.HandleSuspendChange(c.EnqueueControlledBy(informerset.TokenServices().Informer().GetIndexer())))
+
 	informerset.Services().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: informers.FilterWithOwnerGroupVersionKind(v1alpha2.SchemeGroupVersion.WithKind("TokenService")),
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
@@ -117,52 +117,126 @@ func NewController(
 		Handler:    informers.HandleAll(c.EnqueueControllerOf),
 	})
 
+	// The configuration of a token service depends on the config overrides of its namespace
+	enqueueNamespace := func(namespace string) {
+		tokenServices, err := r.tokenServiceLister.TokenServices(namespace).List(labels.Everything())
+		if err != nil {
+			r.logger.Errorf("Failed to list the token services of namespace %q: %v", namespace, err)
+			return
+		}
+		for _, tokenService := range tokenServices {
+			c.Enqueue(tokenService)
+		}
+	}
+	informerset.ConfigMaps().Informer().AddEventHandler(controller.HandleConfigOverride(enqueueNamespace))
+	informerset.Secrets().Informer().AddEventHandler(controller.HandleConfigOverride(enqueueNamespace))
+
 	return c
 }
 
-func (r *reconciler) Reconcile(key string) error {
-	r.logger.Infof("Reconcile called with %s", key)
+func newReconciler(
+	clientset clients.Interface,
+	informerset informers.Interface,
+	cfg config.Interface,
+	logger *zap.SugaredLogger,
+) *reconciler {
+	return &reconciler{
+		kubeClient:             clientset.Kubernetes(),
+		meshClient:             clientset.Mesh(),
+		deploymentLister:       informerset.Deployments().Lister(),
+		serviceLister:          informerset.Services().Lister(),
+		istioEnvoyFilterLister: informerset.IstioEnvoyFilters().Lister(),
+		configMapLister:        informerset.ConfigMaps().Lister(),
+		tokenServiceLister:     informerset.TokenServices().Lister(),
+		cellLister:             informerset.Cells().Lister(),
+		compositeLister:        informerset.Composites().Lister(),
+		cfg:                    cfg,
+		logger:                 logger.Named("tokenservice-controller"),
+	}
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, key string) (_ controller.Result, err error) {
+	logger := logging.FromContext(ctx)
+	logger.Infof("Reconcile called with %s", key)
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
-		r.logger.Errorf("invalid resource key: %s", key)
-		return nil
+		logger.Errorf("invalid resource key: %s", key)
+		return controller.Result{}, nil
 	}
 	original, err := r.tokenServiceLister.TokenServices(namespace).Get(name)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			r.logger.Errorf("tokenService '%s' in work queue no longer exists", key)
-			return nil
+			logger.Errorf("tokenService '%s' in work queue no longer exists", key)
+			return controller.Result{}, nil
 		}
-		return err
+		return controller.Result{}, err
 	}
 
 	tokenService := original.DeepCopy()
 
-	if err = r.reconcile(tokenService); err != nil {
-		r.recorder.Eventf(tokenService, corev1.EventTypeWarning, "InternalError", "Failed to update cluster: %v", err)
-		return err
+	ctx, span := controller.StartReconcileSpan(ctx, "TokenService", tokenService, tokenService.Generation != tokenService.Status.ObservedGeneration)
+	defer func() { span.Finish(err) }()
+
+	if controller.IsPaused(tokenService) || controller.IsOwnerPaused(tokenService, r.cellLister, r.compositeLister) {
+		if tokenService.Status.SetCondition(v1alpha2.TokenServiceReconcilePaused, corev1.ConditionTrue) {
+			r.recorder.Eventf(tokenService, corev1.EventTypeNormal, "ReconcilePaused", "Reconciliation of TokenService %q is paused", tokenService.Name)
+		}
+	} else {
+		if tokenService.Status.RemoveCondition(v1alpha2.TokenServiceReconcilePaused) {
+			r.recorder.Eventf(tokenService, corev1.EventTypeNormal, "ReconcileResumed", "Reconciliation of TokenService %q is resumed", tokenService.Name)
+		}
+		if err = r.reconcile(ctx, tokenService); err != nil {
+			r.recorder.Eventf(tokenService, corev1.EventTypeWarning, "InternalError", "Failed to update cluster: %v", err)
+			return controller.Result{}, err
+		}
+		tokenService.Status.RemoveCondition(v1alpha2.TokenServiceReconcileFailed)
+	}
+
+	// Do not update the status from a cancelled or timed out reconcile
+	if err = ctx.Err(); err != nil {
+		return controller.Result{}, err
 	}
 
 	if equality.Semantic.DeepEqual(original.Status, tokenService.Status) {
-		return nil
+		return controller.Result{}, nil
 	}
 
 	if _, err = r.updateStatus(tokenService); err != nil {
 		r.recorder.Eventf(tokenService, corev1.EventTypeWarning, "UpdateFailed", "Failed to update status: %v", err)
-		return err
+		return controller.Result{}, err
 	}
 	r.recorder.Eventf(tokenService, corev1.EventTypeNormal, "Updated", "Updated TokenService status %q", tokenService.GetName())
-	return nil
+	return controller.Result{}, nil
 }
 
-func (r *reconciler) reconcile(tokenService *v1alpha2.TokenService) error {
+// RecordFailure marks the TokenService as failed once its key has exhausted the retries.
+func (r *reconciler) RecordFailure(ctx context.Context, key string, reconcileErr error) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	original, err := r.tokenServiceLister.TokenServices(namespace).Get(name)
+	if err != nil {
+		return err
+	}
+
+	tokenService := original.DeepCopy()
+	if !tokenService.Status.SetCondition(v1alpha2.TokenServiceReconcileFailed, corev1.ConditionTrue) {
+		return nil
+	}
+	r.recorder.Eventf(tokenService, corev1.EventTypeWarning, "ReconcileFailed", "Reconciliation of TokenService %q failed after retries: %v", name, reconcileErr)
+	_, err = r.updateStatus(tokenService)
+	return err
+}
+
+func (r *reconciler) reconcile(ctx context.Context, tokenService *v1alpha2.TokenService) error {
 	tokenService.Default()
 	rErrs := &controller.ReconcileErrors{}
-	rErrs.Add(r.reconcileService(tokenService))
-	rErrs.Add(r.reconcileConfigMap(tokenService))
-	rErrs.Add(r.reconcileOpaConfigMap(tokenService))
-	rErrs.Add(r.reconcileDeployment(tokenService))
-	rErrs.Add(r.reconcileEnvoyFilter(tokenService))
+	rErrs.Add(r.reconcileService(ctx, tokenService))
+	rErrs.Add(r.reconcileConfigMap(ctx, tokenService))
+	rErrs.Add(r.reconcileOpaConfigMap(ctx, tokenService))
+	rErrs.Add(r.reconcileDeployment(ctx, tokenService))
+	rErrs.Add(r.reconcileEnvoyFilter(ctx, tokenService))
 
 	if !rErrs.Empty() {
 		return rErrs
@@ -172,10 +246,18 @@ func (r *reconciler) reconcile(tokenService *v1alpha2.TokenService) error {
 	return nil
 }
 
-func (r *reconciler) reconcileService(tokenService *v1alpha2.TokenService) error {
+// isSuspended returns true if the token service or the Cell or the Composite which controls it is suspended.
+func (r *reconciler) isSuspended(tokenService *v1alpha2.TokenService) bool {
+	return controller.IsSuspended(tokenService) || controller.IsOwnerSuspended(tokenService, r.cellLister, r.compositeLister)
+}
+
+func (r *reconciler) reconcileService(ctx context.Context, tokenService *v1alpha2.TokenService) (err error) {
 	serviceName := resources.ServiceName(tokenService)
+	_, span := controller.StartStepSpan(ctx, "Service", serviceName)
+	defer func() { span.Finish(err) }()
 	service, err := r.serviceLister.Services(tokenService.Namespace).Get(serviceName)
 	if errors.IsNotFound(err) {
+		controller.SetAction(span, controller.ActionCreate)
 		service, err = r.kubeClient.CoreV1().Services(tokenService.Namespace).Create(resources.MakeService(tokenService))
 		if err != nil {
 			r.logger.Errorf("Failed to create Service %q: %v", serviceName, err)
@@ -186,32 +268,44 @@ func (r *reconciler) reconcileService(tokenService *v1alpha2.TokenService) error
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Service %q: %v", serviceName, err)
 		return err
-	} else if !metav1.IsControlledBy(service, tokenService) {
-		return fmt.Errorf("tokenService: %q does not own the Service: %q", tokenService.Name, serviceName)
+	} else if !metav1.IsControlledBy(service, tokenService) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(tokenService, r.cellLister, r.compositeLister), service) {
+		return controller.NewPermanentError(fmt.Errorf("tokenService: %q does not own the Service: %q", tokenService.Name, serviceName))
 	} else {
+		adopt := !metav1.IsControlledBy(service, tokenService)
 		service, err = func(tokenService *v1alpha2.TokenService, service *corev1.Service) (*corev1.Service, error) {
-			if !resources.RequireServiceUpdate(tokenService, service) {
+			if !adopt && !resources.RequireServiceUpdate(tokenService, service) {
+				controller.SetAction(span, controller.ActionSkip)
 				return service, nil
 			}
+			controller.SetAction(span, controller.ActionUpdate)
 			desiredService := resources.MakeService(tokenService)
 			existingService := service.DeepCopy()
 			resources.CopyService(desiredService, existingService)
+			if adopt {
+				controller.Adopt(existingService, desiredService)
+			}
 			return r.kubeClient.CoreV1().Services(tokenService.Namespace).Update(existingService)
 		}(tokenService, service)
 		if err != nil {
 			r.logger.Errorf("Failed to update Service %q: %v", serviceName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(tokenService, "Service", serviceName)
+		}
 	}
 	resources.StatusFromService(tokenService, service)
 	return nil
 }
 
-func (r *reconciler) reconcileConfigMap(tokenService *v1alpha2.TokenService) error {
+func (r *reconciler) reconcileConfigMap(ctx context.Context, tokenService *v1alpha2.TokenService) (err error) {
 	configMapName := resources.ConfigMapName(tokenService)
+	_, span := controller.StartStepSpan(ctx, "ConfigMap", configMapName)
+	defer func() { span.Finish(err) }()
 	configMap, err := r.configMapLister.ConfigMaps(tokenService.Namespace).Get(configMapName)
 	if errors.IsNotFound(err) {
-		configMap, err = r.kubeClient.CoreV1().ConfigMaps(tokenService.Namespace).Create(resources.MakeConfigMap(tokenService, r.cfg))
+		controller.SetAction(span, controller.ActionCreate)
+		configMap, err = r.kubeClient.CoreV1().ConfigMaps(tokenService.Namespace).Create(resources.MakeConfigMap(tokenService, r.cfg.ForNamespace(tokenService.Namespace)))
 		if err != nil {
 			r.logger.Errorf("Failed to create ConfigMap %q: %v", configMapName, err)
 			r.recorder.Eventf(tokenService, corev1.EventTypeWarning, "CreationFailed", "Failed to create ConfigMap %q: %v", configMapName, err)
@@ -221,32 +315,45 @@ func (r *reconciler) reconcileConfigMap(tokenService *v1alpha2.TokenService) err
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve ConfigMap %q: %v", configMapName, err)
 		return err
-	} else if !metav1.IsControlledBy(configMap, tokenService) {
-		return fmt.Errorf("tokenService: %q does not own the ConfigMap: %q", tokenService.Name, configMapName)
+	} else if !metav1.IsControlledBy(configMap, tokenService) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(tokenService, r.cellLister, r.compositeLister), configMap) {
+		return controller.NewPermanentError(fmt.Errorf("tokenService: %q does not own the ConfigMap: %q", tokenService.Name, configMapName))
 	} else {
+		adopt := !metav1.IsControlledBy(configMap, tokenService)
 		configMap, err = func(tokenService *v1alpha2.TokenService, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
-			if !resources.RequireConfigMapUpdate(tokenService, configMap) {
+			if !adopt && !resources.RequireConfigMapUpdate(tokenService, configMap) &&
+				controller.ConfigHashEqual(configMap, r.cfg.ForNamespace(tokenService.Namespace).Hash()) {
+				controller.SetAction(span, controller.ActionSkip)
 				return configMap, nil
 			}
-			desiredConfigMap := resources.MakeConfigMap(tokenService, r.cfg)
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredConfigMap := resources.MakeConfigMap(tokenService, r.cfg.ForNamespace(tokenService.Namespace))
 			existingConfigMap := configMap.DeepCopy()
 			resources.CopyConfigMap(desiredConfigMap, existingConfigMap)
+			if adopt {
+				controller.Adopt(existingConfigMap, desiredConfigMap)
+			}
 			return r.kubeClient.CoreV1().ConfigMaps(tokenService.Namespace).Update(existingConfigMap)
 		}(tokenService, configMap)
 		if err != nil {
 			r.logger.Errorf("Failed to update ConfigMap %q: %v", configMapName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(tokenService, "ConfigMap", configMapName)
+		}
 	}
 	resources.StatusFromConfigMap(tokenService, configMap)
 	return nil
 }
 
-func (r *reconciler) reconcileOpaConfigMap(tokenService *v1alpha2.TokenService) error {
+func (r *reconciler) reconcileOpaConfigMap(ctx context.Context, tokenService *v1alpha2.TokenService) (err error) {
 	configMapName := resources.OpaPolicyConfigMapName(tokenService)
+	_, span := controller.StartStepSpan(ctx, "OpaConfigMap", configMapName)
+	defer func() { span.Finish(err) }()
 	configMap, err := r.configMapLister.ConfigMaps(tokenService.Namespace).Get(configMapName)
 	if errors.IsNotFound(err) {
-		configMap, err = r.kubeClient.CoreV1().ConfigMaps(tokenService.Namespace).Create(resources.MakeOpaConfigMap(tokenService, r.cfg))
+		controller.SetAction(span, controller.ActionCreate)
+		configMap, err = r.kubeClient.CoreV1().ConfigMaps(tokenService.Namespace).Create(resources.MakeOpaConfigMap(tokenService, r.cfg.ForNamespace(tokenService.Namespace)))
 		if err != nil {
 			r.logger.Errorf("Failed to create OPA ConfigMap %q: %v", configMapName, err)
 			r.recorder.Eventf(tokenService, corev1.EventTypeWarning, "CreationFailed", "Failed to create OPA ConfigMap %q: %v", configMapName, err)
@@ -256,32 +363,50 @@ func (r *reconciler) reconcileOpaConfigMap(tokenService *v1alpha2.TokenService)
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve OPA ConfigMap %q: %v", configMapName, err)
 		return err
-	} else if !metav1.IsControlledBy(configMap, tokenService) {
-		return fmt.Errorf("tokenService: %q does not own the OPA ConfigMap: %q", tokenService.Name, configMapName)
+	} else if !metav1.IsControlledBy(configMap, tokenService) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(tokenService, r.cellLister, r.compositeLister), configMap) {
+		return controller.NewPermanentError(fmt.Errorf("tokenService: %q does not own the OPA ConfigMap: %q", tokenService.Name, configMapName))
 	} else {
+		adopt := !metav1.IsControlledBy(configMap, tokenService)
 		configMap, err = func(tokenService *v1alpha2.TokenService, configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
-			if !resources.RequireOpaConfigMapUpdate(tokenService, configMap) {
+			if !adopt && !resources.RequireOpaConfigMapUpdate(tokenService, configMap) &&
+				controller.ConfigHashEqual(configMap, r.cfg.ForNamespace(tokenService.Namespace).Hash()) {
+				controller.SetAction(span, controller.ActionSkip)
 				return configMap, nil
 			}
-			desiredConfigMap := resources.MakeOpaConfigMap(tokenService, r.cfg)
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredConfigMap := resources.MakeOpaConfigMap(tokenService, r.cfg.ForNamespace(tokenService.Namespace))
 			existingConfigMap := configMap.DeepCopy()
 			resources.CopyOpaConfigMap(desiredConfigMap, existingConfigMap)
+			if adopt {
+				controller.Adopt(existingConfigMap, desiredConfigMap)
+			}
 			return r.kubeClient.CoreV1().ConfigMaps(tokenService.Namespace).Update(existingConfigMap)
 		}(tokenService, configMap)
 		if err != nil {
 			r.logger.Errorf("Failed to update OPA ConfigMap %q: %v", configMapName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(tokenService, "ConfigMap", configMapName)
+		}
 	}
 	resources.StatusFromOpaConfigMap(tokenService, configMap)
 	return nil
 }
 
-func (r *reconciler) reconcileDeployment(tokenService *v1alpha2.TokenService) error {
+func (r *reconciler) reconcileDeployment(ctx context.Context, tokenService *v1alpha2.TokenService) (err error) {
 	deploymentName := resources.DeploymentName(tokenService)
+	_, span := controller.StartStepSpan(ctx, "Deployment", deploymentName)
+	defer func() { span.Finish(err) }()
 	deployment, err := r.deploymentLister.Deployments(tokenService.Namespace).Get(deploymentName)
+	suspended := r.isSuspended(tokenService)
 	if errors.IsNotFound(err) {
-		deployment, err = r.kubeClient.AppsV1().Deployments(tokenService.Namespace).Create(resources.MakeDeployment(tokenService, r.cfg))
+		controller.SetAction(span, controller.ActionCreate)
+		desiredDeployment := resources.MakeDeployment(tokenService, r.cfg.ForNamespace(tokenService.Namespace))
+		if suspended {
+			desiredDeployment.Spec.Replicas = controller.SuspendReplicas(desiredDeployment, desiredDeployment.Spec.Replicas, desiredDeployment)
+		}
+		deployment, err = r.kubeClient.AppsV1().Deployments(tokenService.Namespace).Create(desiredDeployment)
 		if err != nil {
 			r.logger.Errorf("Failed to create Deployment %q: %v", deploymentName, err)
 			r.recorder.Eventf(tokenService, corev1.EventTypeWarning, "CreationFailed", "Failed to create Deployment %q: %v", deploymentName, err)
@@ -291,32 +416,48 @@ func (r *reconciler) reconcileDeployment(tokenService *v1alpha2.TokenService) er
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve Deployment %q: %v", deploymentName, err)
 		return err
-	} else if !metav1.IsControlledBy(deployment, tokenService) {
-		return fmt.Errorf("tokenService: %q does not own the Deployment: %q", tokenService.Name, deploymentName)
+	} else if !metav1.IsControlledBy(deployment, tokenService) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(tokenService, r.cellLister, r.compositeLister), deployment) {
+		return controller.NewPermanentError(fmt.Errorf("tokenService: %q does not own the Deployment: %q", tokenService.Name, deploymentName))
 	} else {
+		adopt := !metav1.IsControlledBy(deployment, tokenService)
 		deployment, err = func(tokenService *v1alpha2.TokenService, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
-			if !resources.RequireDeploymentUpdate(tokenService, deployment) {
+			if !adopt && !resources.RequireDeploymentUpdate(tokenService, deployment) && suspended == controller.IsWorkloadSuspended(deployment) &&
+				controller.ConfigHashEqual(deployment, r.cfg.ForNamespace(tokenService.Namespace).Hash()) {
+				controller.SetAction(span, controller.ActionSkip)
 				return deployment, nil
 			}
-			desiredDeployment := resources.MakeDeployment(tokenService, r.cfg)
+			controller.SetAction(span, controller.ActionUpdate)
+			desiredDeployment := resources.MakeDeployment(tokenService, r.cfg.ForNamespace(tokenService.Namespace))
 			existingDeployment := deployment.DeepCopy()
 			resources.CopyDeployment(desiredDeployment, existingDeployment)
+			if suspended {
+				existingDeployment.Spec.Replicas = controller.SuspendReplicas(deployment, deployment.Spec.Replicas, existingDeployment)
+			}
+			if adopt {
+				controller.Adopt(existingDeployment, desiredDeployment)
+			}
 			return r.kubeClient.AppsV1().Deployments(tokenService.Namespace).Update(existingDeployment)
 		}(tokenService, deployment)
 		if err != nil {
 			r.logger.Errorf("Failed to update Deployment %q: %v", deploymentName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(tokenService, "Deployment", deploymentName)
+		}
 	}
 	resources.StatusFromDeployment(tokenService, deployment)
 	return nil
 }
 
-func (r *reconciler) reconcileEnvoyFilter(tokenService *v1alpha2.TokenService) error {
+func (r *reconciler) reconcileEnvoyFilter(ctx context.Context, tokenService *v1alpha2.TokenService) (err error) {
 	envoyFilterName := resources.EnvoyFilterName(tokenService)
+	_, span := controller.StartStepSpan(ctx, "EnvoyFilter", envoyFilterName)
+	defer func() { span.Finish(err) }()
 	envoyFilter, err := r.istioEnvoyFilterLister.EnvoyFilters(tokenService.Namespace).Get(envoyFilterName)
 	if !resources.RequireEnvoyFilter(tokenService) {
 		if err == nil && metav1.IsControlledBy(envoyFilter, tokenService) {
+			controller.SetAction(span, controller.ActionDelete)
 			err = r.meshClient.NetworkingV1alpha3().EnvoyFilters(tokenService.Namespace).Delete(envoyFilterName, &metav1.DeleteOptions{})
 			if err != nil {
 				r.logger.Errorf("Failed to delete EnvoyFilter %q: %v", envoyFilterName, err)
@@ -326,8 +467,10 @@ func (r *reconciler) reconcileEnvoyFilter(tokenService *v1alpha2.TokenService) e
 		return nil
 	}
 
+	desiredEnvoyFilter := resources.MakeEnvoyFilter(tokenService, r.cfg.ForNamespace(tokenService.Namespace))
 	if errors.IsNotFound(err) {
-		envoyFilter, err = r.meshClient.NetworkingV1alpha3().EnvoyFilters(tokenService.Namespace).Create(resources.MakeEnvoyFilter(tokenService))
+		controller.SetAction(span, controller.ActionCreate)
+		envoyFilter, err = r.meshClient.NetworkingV1alpha3().EnvoyFilters(tokenService.Namespace).Create(desiredEnvoyFilter)
 		if err != nil {
 			r.logger.Errorf("Failed to create EnvoyFilter %q: %v", envoyFilterName, err)
 			r.recorder.Eventf(tokenService, corev1.EventTypeWarning, "CreationFailed", "Failed to create EnvoyFilter %q: %v", envoyFilterName, err)
@@ -337,30 +480,41 @@ func (r *reconciler) reconcileEnvoyFilter(tokenService *v1alpha2.TokenService) e
 	} else if err != nil {
 		r.logger.Errorf("Failed to retrieve EnvoyFilter %q: %v", envoyFilterName, err)
 		return err
-	} else if !metav1.IsControlledBy(envoyFilter, tokenService) {
-		return fmt.Errorf("tokenService: %q does not own the EnvoyFilter: %q", tokenService.Name, envoyFilterName)
+	} else if !metav1.IsControlledBy(envoyFilter, tokenService) && !controller.CanAdopt(controller.GetOwnerAdoptionPolicy(tokenService, r.cellLister, r.compositeLister), envoyFilter) {
+		return controller.NewPermanentError(fmt.Errorf("tokenService: %q does not own the EnvoyFilter: %q", tokenService.Name, envoyFilterName))
 	} else {
+		adopt := !metav1.IsControlledBy(envoyFilter, tokenService)
 		envoyFilter, err = func(tokenService *v1alpha2.TokenService, envoyFilter *istionetworkingv1alpha3.EnvoyFilter) (*istionetworkingv1alpha3.EnvoyFilter, error) {
-			if !resources.RequireEnvoyFilterUpdate(tokenService, envoyFilter) {
+			if !adopt && !resources.RequireEnvoyFilterUpdate(tokenService, desiredEnvoyFilter, envoyFilter) {
+				controller.SetAction(span, controller.ActionSkip)
 				return envoyFilter, nil
 			}
-			desiredEnvoyFilter := resources.MakeEnvoyFilter(tokenService)
-			if err != nil {
-				return nil, err
-			}
+			controller.SetAction(span, controller.ActionUpdate)
 			existingEnvoyFilter := envoyFilter.DeepCopy()
 			resources.CopyEnvoyFilter(desiredEnvoyFilter, existingEnvoyFilter)
+			if adopt {
+				controller.Adopt(existingEnvoyFilter, desiredEnvoyFilter)
+			}
 			return r.meshClient.NetworkingV1alpha3().EnvoyFilters(tokenService.Namespace).Update(existingEnvoyFilter)
 		}(tokenService, envoyFilter)
 		if err != nil {
 			r.logger.Errorf("Failed to update EnvoyFilter %q: %v", envoyFilterName, err)
 			return err
 		}
+		if adopt {
+			r.recordAdoption(tokenService, "EnvoyFilter", envoyFilterName)
+		}
 	}
 	resources.StatusFromEnvoyFilter(tokenService, envoyFilter)
 	return nil
 }
 
+// recordAdoption records the adoption of an existing resource in the events and the status of the token service.
+func (r *reconciler) recordAdoption(tokenService *v1alpha2.TokenService, kind string, name string) {
+	r.recorder.Eventf(tokenService, corev1.EventTypeNormal, "Adopted", "Adopted %s %q", kind, name)
+	tokenService.Status.AdoptedResources = controller.AddAdoptedResource(tokenService.Status.AdoptedResources, kind, name)
+}
+
 func (r *reconciler) updateStatus(desired *v1alpha2.TokenService) (*v1alpha2.TokenService, error) {
 	gateway, err := r.tokenServiceLister.TokenServices(desired.Namespace).Get(desired.Name)
 	if err != nil {
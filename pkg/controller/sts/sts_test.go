@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sts
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	fakeclients "cellery.io/cellery-controller/pkg/clients/fake"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	"cellery.io/cellery-controller/pkg/controller"
+	"cellery.io/cellery-controller/pkg/controller/sts/resources"
+	fakeinformers "cellery.io/cellery-controller/pkg/informers/fake"
+	"cellery.io/cellery-controller/pkg/meta"
+	. "cellery.io/cellery-controller/pkg/testing/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/testing/table"
+)
+
+func newTestReconciler(clients *fakeclients.Clients, informers *fakeinformers.Informers, cfg config.Interface,
+	recorder record.EventRecorder) controller.Reconciler {
+	r := newReconciler(clients, informers, cfg, zap.NewNop().Sugar())
+	r.recorder = recorder
+	return r
+}
+
+func testTokenService(opt ...TokenServiceOption) *v1alpha2.TokenService {
+	return TokenService("foo", "bar", append([]TokenServiceOption{
+		WithTokenServiceSelector(map[string]string{"app": "foo"}),
+		WithTokenServiceInterceptMode(v1alpha2.InterceptModeInbound),
+	}, opt...)...)
+}
+
+func TestReconcile(t *testing.T) {
+	previousConfig := fakeconfig.New(map[string]string{
+		config.ConfigMapKeyTokenServiceImage:            "cellery/sts:v1",
+		config.ConfigMapKeyTokenServiceDefaultOpaPolicy: "package cellery.io\nallow = true",
+	})
+	overriddenConfig := map[string]string{
+		config.ConfigMapKeyTokenServiceImage:            "cellery/sts:v2",
+		config.ConfigMapKeyTokenServiceDefaultOpaPolicy: "package cellery.io\nallow = false",
+	}
+
+	table.Table{
+		{
+			Name: "invalid key",
+			Key:  "foo/bar/baz",
+		},
+		{
+			Name: "non existing key",
+			Key:  "bar/foo",
+		},
+		{
+			Name:    "create tokenservice resources",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testTokenService()},
+			WantStatusUpdates: []runtime.Object{
+				testTokenService(WithTokenServiceStatus(v1alpha2.TokenServiceStatus{
+					Status: v1alpha2.TokenServiceCurrentStatusNotReady,
+				})),
+			},
+			WantEvents: []string{
+				`Normal Created Created Service "foo-service"`,
+				`Normal Created Created ConfigMap "foo-config"`,
+				`Normal Created Created OPA ConfigMap "foo-policy"`,
+				`Normal Created Created Deployment "foo-deployment"`,
+				`Normal Created Created EnvoyFilter "foo-envoyfilter"`,
+				`Normal Updated Updated TokenService status "foo"`,
+			},
+			Golden: "create-tokenservice-resources",
+		},
+		{
+			Name:    "create a legacy envoy filter for istio versions prior to 1.5",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testTokenService()},
+			Config:  map[string]string{config.ConfigMapKeyIstioVersion: "1.0.2"},
+			WantStatusUpdates: []runtime.Object{
+				testTokenService(WithTokenServiceStatus(v1alpha2.TokenServiceStatus{
+					Status: v1alpha2.TokenServiceCurrentStatusNotReady,
+				})),
+			},
+			WantEvents: []string{
+				`Normal Created Created Service "foo-service"`,
+				`Normal Created Created ConfigMap "foo-config"`,
+				`Normal Created Created OPA ConfigMap "foo-policy"`,
+				`Normal Created Created Deployment "foo-deployment"`,
+				`Normal Created Created EnvoyFilter "foo-envoyfilter"`,
+				`Normal Updated Updated TokenService status "foo"`,
+			},
+			Golden: "create-tokenservice-resources-legacy-envoyfilter",
+		},
+		{
+			Name: "update the config maps and the deployment when the config override changes",
+			Key:  "bar/foo",
+			Objects: []runtime.Object{
+				testTokenService(),
+				resources.MakeService(testTokenService()),
+				resources.MakeConfigMap(testTokenService(), previousConfig),
+				resources.MakeOpaConfigMap(testTokenService(), previousConfig),
+				resources.MakeDeployment(testTokenService(), previousConfig),
+				resources.MakeEnvoyFilter(testTokenService(), fakeconfig.New(overriddenConfig)),
+			},
+			Config: overriddenConfig,
+			WantUpdates: []runtime.Object{
+				resources.MakeConfigMap(testTokenService(), fakeconfig.New(overriddenConfig)),
+				resources.MakeOpaConfigMap(testTokenService(), fakeconfig.New(overriddenConfig)),
+				resources.MakeDeployment(testTokenService(), fakeconfig.New(overriddenConfig)),
+			},
+			WantStatusUpdates: []runtime.Object{
+				testTokenService(WithTokenServiceStatus(v1alpha2.TokenServiceStatus{
+					Status: v1alpha2.TokenServiceCurrentStatusNotReady,
+				})),
+			},
+			WantEvents: []string{
+				`Normal Updated Updated TokenService status "foo"`,
+			},
+		},
+		{
+			Name:    "pause reconciliation",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testTokenService(WithTokenServiceAnnotation(meta.ReconcileAnnotationKey, meta.ReconcilePausedValue))},
+			WantStatusUpdates: []runtime.Object{
+				testTokenService(
+					WithTokenServiceAnnotation(meta.ReconcileAnnotationKey, meta.ReconcilePausedValue),
+					WithTokenServiceStatus(v1alpha2.TokenServiceStatus{
+						Conditions: []v1alpha2.TokenServiceCondition{
+							{Type: v1alpha2.TokenServiceReconcilePaused, Status: corev1.ConditionTrue},
+						},
+					}),
+				),
+			},
+			WantEvents: []string{
+				`Normal ReconcilePaused Reconciliation of TokenService "foo" is paused`,
+				`Normal Updated Updated TokenService status "foo"`,
+			},
+		},
+		{
+			Name:    "deployment creation failure",
+			Key:     "bar/foo",
+			Objects: []runtime.Object{testTokenService()},
+			WithReactors: []table.Reactor{{
+				Verb:     "create",
+				Resource: "deployments",
+				Reaction: func(action clienttesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("inducing failure for create deployments")
+				},
+			}},
+			WantErr: true,
+			WantEvents: []string{
+				`Normal Created Created Service "foo-service"`,
+				`Normal Created Created ConfigMap "foo-config"`,
+				`Normal Created Created OPA ConfigMap "foo-policy"`,
+				`Warning CreationFailed Failed to create Deployment "foo-deployment": inducing failure for create deployments`,
+				`Normal Created Created EnvoyFilter "foo-envoyfilter"`,
+				"Warning InternalError Failed to update cluster: inducing failure for create deployments",
+			},
+			// The failed create is recorded as well
+			Golden: "create-tokenservice-resources",
+		},
+	}.Test(t, newTestReconciler)
+}
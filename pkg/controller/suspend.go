@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	v1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/meta"
+	"cellery.io/cellery-controller/pkg/ptr"
+)
+
+// IsSuspended returns true if the object is suspended using the suspended annotation.
+func IsSuspended(obj metav1.Object) bool {
+	return obj.GetAnnotations()[meta.SuspendedAnnotationKey] == meta.SuspendedValue
+}
+
+// IsOwnerSuspended returns true if the Cell or the Composite which controls the object is suspended.
+func IsOwnerSuspended(obj metav1.Object, cellLister v1alpha2listers.CellLister, compositeLister v1alpha2listers.CompositeLister) bool {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.APIVersion != v1alpha2.SchemeGroupVersion.String() {
+		return false
+	}
+	switch owner.Kind {
+	case "Cell":
+		cell, err := cellLister.Cells(obj.GetNamespace()).Get(owner.Name)
+		return err == nil && cell.UID == owner.UID && IsSuspended(cell)
+	case "Composite":
+		composite, err := compositeLister.Composites(obj.GetNamespace()).Get(owner.Name)
+		return err == nil && composite.UID == owner.UID && IsSuspended(composite)
+	}
+	return false
+}
+
+// HandleSuspendChange returns an event handler which calls the given handler with the updated object
+// only when it is suspended or resumed.
+func HandleSuspendChange(h func(interface{})) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(first, second interface{}) {
+			oldObj, ok := first.(metav1.Object)
+			if !ok {
+				return
+			}
+			newObj, ok := second.(metav1.Object)
+			if !ok {
+				return
+			}
+			if IsSuspended(oldObj) != IsSuspended(newObj) {
+				h(second)
+			}
+		},
+	}
+}
+
+// IsWorkloadSuspended returns true if the workload was scaled to zero by SuspendReplicas.
+func IsWorkloadSuspended(workload metav1.Object) bool {
+	_, ok := workload.GetAnnotations()[meta.SuspendedReplicasAnnotationKey]
+	return ok
+}
+
+// SuspendReplicas returns zero replicas for the desired workload and records the replicas of the
+// current workload in it to be restored on resume. The recorded replicas are carried over if the
+// current workload is already suspended.
+func SuspendReplicas(current metav1.Object, currentReplicas *int32, desired metav1.Object) *int32 {
+	replicas, ok := current.GetAnnotations()[meta.SuspendedReplicasAnnotationKey]
+	if !ok {
+		replicas = "1"
+		if currentReplicas != nil {
+			replicas = strconv.Itoa(int(*currentReplicas))
+		}
+	}
+	annotations := make(map[string]string, len(desired.GetAnnotations())+1)
+	for k, v := range desired.GetAnnotations() {
+		annotations[k] = v
+	}
+	annotations[meta.SuspendedReplicasAnnotationKey] = replicas
+	desired.SetAnnotations(annotations)
+	return ptr.Int32(0)
+}
+
+// ResumeReplicas returns the replicas recorded when the current workload was suspended, or the given
+// replicas if it was not suspended.
+func ResumeReplicas(current metav1.Object, replicas *int32) *int32 {
+	v, ok := current.GetAnnotations()[meta.SuspendedReplicasAnnotationKey]
+	if !ok {
+		return replicas
+	}
+	recorded, err := strconv.Atoi(v)
+	if err != nil || recorded < 1 {
+		return replicas
+	}
+	return ptr.Int32(int32(recorded))
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	v1alpha2listers "cellery.io/cellery-controller/pkg/generated/listers/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/meta"
+	"cellery.io/cellery-controller/pkg/ptr"
+)
+
+var suspendedAnnotations = map[string]string{meta.SuspendedAnnotationKey: meta.SuspendedValue}
+
+func TestIsOwnerSuspended(t *testing.T) {
+	cellIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	compositeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+
+	suspendedCell := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{Name: "suspended", Namespace: "foo", UID: types.UID("1"), Annotations: suspendedAnnotations}}
+	runningCell := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "foo", UID: types.UID("2")}}
+	suspendedComposite := &v1alpha2.Composite{ObjectMeta: metav1.ObjectMeta{Name: "suspended", Namespace: "foo", UID: types.UID("3"), Annotations: suspendedAnnotations}}
+	cellIndexer.Add(suspendedCell)
+	cellIndexer.Add(runningCell)
+	compositeIndexer.Add(suspendedComposite)
+
+	cellLister := v1alpha2listers.NewCellLister(cellIndexer)
+	compositeLister := v1alpha2listers.NewCompositeLister(compositeIndexer)
+
+	tests := []struct {
+		name  string
+		owner *metav1.OwnerReference
+		want  bool
+	}{
+		{
+			name: "no owner",
+		},
+		{
+			name:  "suspended cell",
+			owner: CreateCellOwnerRef(suspendedCell),
+			want:  true,
+		},
+		{
+			name:  "running cell",
+			owner: CreateCellOwnerRef(runningCell),
+		},
+		{
+			name:  "suspended composite",
+			owner: CreateCompositeOwnerRef(suspendedComposite),
+			want:  true,
+		},
+		{
+			name: "recreated cell",
+			owner: CreateCellOwnerRef(&v1alpha2.Cell{
+				ObjectMeta: metav1.ObjectMeta{Name: "suspended", Namespace: "foo", UID: types.UID("4")},
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gateway := &v1alpha2.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "foo"}}
+			if test.owner != nil {
+				gateway.OwnerReferences = []metav1.OwnerReference{*test.owner}
+			}
+			if got := IsOwnerSuspended(gateway, cellLister, compositeLister); got != test.want {
+				t.Errorf("IsOwnerSuspended() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSuspendAndResumeReplicas(t *testing.T) {
+	current := &metav1.ObjectMeta{Name: "deployment", Namespace: "foo"}
+	desired := &metav1.ObjectMeta{Name: "deployment", Namespace: "foo", Annotations: map[string]string{"foo": "bar"}}
+
+	replicas := SuspendReplicas(current, ptr.Int32(3), desired)
+	if *replicas != 0 {
+		t.Errorf("got %d replicas for a suspended workload, want 0", *replicas)
+	}
+	if !IsWorkloadSuspended(desired) || desired.Annotations["foo"] != "bar" {
+		t.Errorf("unexpected annotations of the suspended workload: %v", desired.Annotations)
+	}
+
+	// The replicas before the suspension are kept while the workload is updated during the suspension
+	updated := &metav1.ObjectMeta{Name: "deployment", Namespace: "foo"}
+	SuspendReplicas(desired, replicas, updated)
+	if got := updated.Annotations[meta.SuspendedReplicasAnnotationKey]; got != "3" {
+		t.Errorf("got recorded replicas %q, want %q", got, "3")
+	}
+
+	if got := ResumeReplicas(updated, ptr.Int32(1)); *got != 3 {
+		t.Errorf("got %d replicas on resume, want 3", *got)
+	}
+	if got := ResumeReplicas(current, ptr.Int32(1)); *got != 1 {
+		t.Errorf("got %d replicas for a workload which is not suspended, want 1", *got)
+	}
+}
+
+func TestHandleSuspendChange(t *testing.T) {
+	var called int
+	h := HandleSuspendChange(func(interface{}) { called++ })
+
+	running := &v1alpha2.Cell{ObjectMeta: metav1.ObjectMeta{Name: "cell", Namespace: "foo"}}
+	suspended := running.DeepCopy()
+	suspended.Annotations = suspendedAnnotations
+
+	h.OnUpdate(running, running)
+	if called != 0 {
+		t.Errorf("handler called %d times for an update without a suspension change", called)
+	}
+	h.OnUpdate(running, suspended)
+	h.OnUpdate(suspended, running)
+	if called != 2 {
+		t.Errorf("handler called %d times, want 2", called)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/meta"
+	"cellery.io/cellery-controller/pkg/tracing"
+)
+
+// Actions taken on the child resources which are recorded in the step spans
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+	ActionSkip   = "skip"
+)
+
+// StartReconcileSpan starts the span of reconciling the given object. If the object has a pending
+// spec change, the trace of the parent reconcile recorded in the trace parent annotation is continued.
+func StartReconcileSpan(ctx context.Context, kind string, obj metav1.Object, pending bool) (context.Context, *tracing.Span) {
+	attributes := []attribute.KeyValue{
+		attribute.String("kind", kind),
+		attribute.String("namespace", obj.GetNamespace()),
+		attribute.String("name", obj.GetName()),
+	}
+	name := "Reconcile " + kind
+	if traceParent, ok := obj.GetAnnotations()[meta.TraceParentAnnotationKey]; ok && pending {
+		return tracing.StartFromRemote(ctx, traceParent, name, attributes...)
+	}
+	return tracing.Start(ctx, name, attributes...)
+}
+
+// StartStepSpan starts the span of reconciling a child resource of the object being reconciled.
+func StartStepSpan(ctx context.Context, kind string, name string) (context.Context, *tracing.Span) {
+	return tracing.Start(ctx, "Reconcile "+kind,
+		attribute.String("resource.kind", kind),
+		attribute.String("resource.name", name),
+	)
+}
+
+// SetAction records the action taken on the child resource of a step span.
+func SetAction(span *tracing.Span, action string) {
+	span.SetAttributes(attribute.String("action", action))
+}
+
+// InjectTraceParent records the trace of the current reconcile in the given child object so that
+// the reconcile of the child continues the same trace.
+func InjectTraceParent(ctx context.Context, obj metav1.Object) {
+	traceParent := tracing.TraceParent(ctx)
+	if len(traceParent) == 0 {
+		return
+	}
+	annotations := make(map[string]string, len(obj.GetAnnotations())+1)
+	for k, v := range obj.GetAnnotations() {
+		annotations[k] = v
+	}
+	annotations[meta.TraceParentAnnotationKey] = traceParent
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	istionetworkingv1alpha3 "cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	istionetworkingv1alpha3listers "cellery.io/cellery-controller/pkg/generated/listers/networking/v1alpha3"
+)
+
+// DefaultTrafficPolicy returns the traffic policy configured in the config, or nil if there is none.
+func DefaultTrafficPolicy(cfg config.Interface) (*v1alpha2.TrafficPolicy, error) {
+	v := cfg.StringValue(config.ConfigMapKeyTrafficPolicy)
+	if len(v) == 0 {
+		return nil, nil
+	}
+	policy := &v1alpha2.TrafficPolicy{}
+	if err := json.Unmarshal([]byte(v), policy); err != nil {
+		return nil, fmt.Errorf("cannot parse the %q config: %v", config.ConfigMapKeyTrafficPolicy, err)
+	}
+	return policy, nil
+}
+
+// MakeIstioTrafficPolicy translates the traffic policy into the one of an Istio DestinationRule. The fields
+// which are not set in the policy are taken from the default traffic policy of the config.
+func MakeIstioTrafficPolicy(policy *v1alpha2.TrafficPolicy, cfg config.Interface) (*istionetworkingv1alpha3.TrafficPolicy, error) {
+	defaults, err := DefaultTrafficPolicy(cfg)
+	if err != nil {
+		return nil, err
+	}
+	policy = mergeTrafficPolicy(policy, defaults)
+
+	trafficPolicy := &istionetworkingv1alpha3.TrafficPolicy{}
+	if len(policy.LoadBalancer) > 0 {
+		trafficPolicy.LoadBalancer = &istionetworkingv1alpha3.LoadBalancerSettings{
+			Simple: string(policy.LoadBalancer),
+		}
+	}
+	if cp := policy.ConnectionPool; cp != nil {
+		trafficPolicy.ConnectionPool = &istionetworkingv1alpha3.ConnectionPoolSettings{}
+		if cp.MaxConnections > 0 || cp.ConnectTimeout != nil {
+			trafficPolicy.ConnectionPool.Tcp = &istionetworkingv1alpha3.ConnectionPoolSettings_TCPSettings{
+				MaxConnections: cp.MaxConnections,
+			}
+			if cp.ConnectTimeout != nil {
+				trafficPolicy.ConnectionPool.Tcp.ConnectTimeout = IstioDuration(cp.ConnectTimeout.Duration)
+			}
+		}
+		if cp.MaxPendingRequests > 0 || cp.MaxRequests > 0 || cp.MaxRequestsPerConnection > 0 || cp.MaxRetries > 0 {
+			trafficPolicy.ConnectionPool.Http = &istionetworkingv1alpha3.ConnectionPoolSettings_HTTPSettings{
+				Http1MaxPendingRequests:  cp.MaxPendingRequests,
+				Http2MaxRequests:         cp.MaxRequests,
+				MaxRequestsPerConnection: cp.MaxRequestsPerConnection,
+				MaxRetries:               cp.MaxRetries,
+			}
+		}
+	}
+	if od := policy.OutlierDetection; od != nil {
+		trafficPolicy.OutlierDetection = &istionetworkingv1alpha3.OutlierDetection{
+			ConsecutiveErrors:  od.ConsecutiveErrors,
+			MaxEjectionPercent: od.MaxEjectionPercent,
+		}
+		if od.Interval != nil {
+			trafficPolicy.OutlierDetection.Interval = IstioDuration(od.Interval.Duration)
+		}
+		if od.BaseEjectionTime != nil {
+			trafficPolicy.OutlierDetection.BaseEjectionTime = IstioDuration(od.BaseEjectionTime.Duration)
+		}
+	}
+	return trafficPolicy, nil
+}
+
+// mergeTrafficPolicy returns a copy of the policy with its unset fields taken from the defaults.
+func mergeTrafficPolicy(policy, defaults *v1alpha2.TrafficPolicy) *v1alpha2.TrafficPolicy {
+	merged := policy.DeepCopy()
+	if merged == nil {
+		merged = &v1alpha2.TrafficPolicy{}
+	}
+	if defaults == nil {
+		return merged
+	}
+	if len(merged.LoadBalancer) == 0 {
+		merged.LoadBalancer = defaults.LoadBalancer
+	}
+	if d := defaults.ConnectionPool; d != nil {
+		if merged.ConnectionPool == nil {
+			merged.ConnectionPool = &v1alpha2.ConnectionPool{}
+		}
+		cp := merged.ConnectionPool
+		if cp.MaxConnections == 0 {
+			cp.MaxConnections = d.MaxConnections
+		}
+		if cp.ConnectTimeout == nil && d.ConnectTimeout != nil {
+			cp.ConnectTimeout = d.ConnectTimeout.DeepCopy()
+		}
+		if cp.MaxPendingRequests == 0 {
+			cp.MaxPendingRequests = d.MaxPendingRequests
+		}
+		if cp.MaxRequests == 0 {
+			cp.MaxRequests = d.MaxRequests
+		}
+		if cp.MaxRequestsPerConnection == 0 {
+			cp.MaxRequestsPerConnection = d.MaxRequestsPerConnection
+		}
+		if cp.MaxRetries == 0 {
+			cp.MaxRetries = d.MaxRetries
+		}
+	}
+	if d := defaults.OutlierDetection; d != nil {
+		if merged.OutlierDetection == nil {
+			merged.OutlierDetection = &v1alpha2.OutlierDetection{}
+		}
+		od := merged.OutlierDetection
+		if od.ConsecutiveErrors == 0 {
+			od.ConsecutiveErrors = d.ConsecutiveErrors
+		}
+		if od.Interval == nil && d.Interval != nil {
+			od.Interval = d.Interval.DeepCopy()
+		}
+		if od.BaseEjectionTime == nil && d.BaseEjectionTime != nil {
+			od.BaseEjectionTime = d.BaseEjectionTime.DeepCopy()
+		}
+		if od.MaxEjectionPercent == 0 {
+			od.MaxEjectionPercent = d.MaxEjectionPercent
+		}
+	}
+	return merged
+}
+
+// IstioDuration formats the duration in seconds, which is understood by all the Istio duration fields.
+func IstioDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
+// ConflictingDestinationRule returns the DestinationRule of the host in the namespace of the owner which takes
+// precedence over the DestinationRule of the owner with the given name, or nil if there is none. Istio applies a
+// single DestinationRule per host, hence the traffic policies of a gateway route and of the component it routes to
+// cannot both be applied. The oldest DestinationRule of the host is applied, and the one with the lowest name if
+// they were created at the same time, so that the owners agree on the winner regardless of the reconcile order.
+// A DestinationRule of the owner which is yet to be created never takes precedence.
+func ConflictingDestinationRule(lister istionetworkingv1alpha3listers.DestinationRuleLister, owner metav1.Object,
+	name, host string) (*istionetworkingv1alpha3.DestinationRule, error) {
+	destinationRules, err := lister.DestinationRules(owner.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var own, conflicting *istionetworkingv1alpha3.DestinationRule
+	for _, destinationRule := range destinationRules {
+		if destinationRule.Spec.Host != host {
+			continue
+		}
+		if destinationRule.Name == name {
+			own = destinationRule
+		} else if !metav1.IsControlledBy(destinationRule, owner) && (conflicting == nil || precedes(destinationRule, conflicting)) {
+			conflicting = destinationRule
+		}
+	}
+	if conflicting == nil || (own != nil && precedes(own, conflicting)) {
+		return nil, nil
+	}
+	return conflicting, nil
+}
+
+// precedes returns true if the first object was created before the second one, or has a lower name if they
+// were created at the same time.
+func precedes(first, second metav1.Object) bool {
+	t1, t2 := first.GetCreationTimestamp(), second.GetCreationTimestamp()
+	if !t1.Equal(&t2) {
+		return t1.Before(&t2)
+	}
+	return first.GetName() < second.GetName()
+}
@@ -0,0 +1,270 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	istionetworkingv1alpha3 "cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/config"
+	"cellery.io/cellery-controller/pkg/config/fake"
+	istionetworkingv1alpha3listers "cellery.io/cellery-controller/pkg/generated/listers/networking/v1alpha3"
+)
+
+func TestMakeIstioTrafficPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *v1alpha2.TrafficPolicy
+		data    map[string]string
+		want    *istionetworkingv1alpha3.TrafficPolicy
+		wantErr bool
+	}{
+		{
+			name: "policy without defaults",
+			policy: &v1alpha2.TrafficPolicy{
+				LoadBalancer: v1alpha2.LoadBalancerLeastConn,
+				ConnectionPool: &v1alpha2.ConnectionPool{
+					MaxConnections:     100,
+					ConnectTimeout:     &metav1.Duration{Duration: 500 * time.Millisecond},
+					MaxPendingRequests: 10,
+					MaxRequests:        200,
+				},
+				OutlierDetection: &v1alpha2.OutlierDetection{
+					ConsecutiveErrors:  5,
+					Interval:           &metav1.Duration{Duration: time.Minute},
+					BaseEjectionTime:   &metav1.Duration{Duration: 30 * time.Second},
+					MaxEjectionPercent: 50,
+				},
+			},
+			want: &istionetworkingv1alpha3.TrafficPolicy{
+				LoadBalancer: &istionetworkingv1alpha3.LoadBalancerSettings{
+					Simple: "LEAST_CONN",
+				},
+				ConnectionPool: &istionetworkingv1alpha3.ConnectionPoolSettings{
+					Tcp: &istionetworkingv1alpha3.ConnectionPoolSettings_TCPSettings{
+						MaxConnections: 100,
+						ConnectTimeout: "0.5s",
+					},
+					Http: &istionetworkingv1alpha3.ConnectionPoolSettings_HTTPSettings{
+						Http1MaxPendingRequests: 10,
+						Http2MaxRequests:        200,
+					},
+				},
+				OutlierDetection: &istionetworkingv1alpha3.OutlierDetection{
+					ConsecutiveErrors:  5,
+					Interval:           "60s",
+					BaseEjectionTime:   "30s",
+					MaxEjectionPercent: 50,
+				},
+			},
+		},
+		{
+			name: "unset fields are taken from the defaults",
+			policy: &v1alpha2.TrafficPolicy{
+				ConnectionPool: &v1alpha2.ConnectionPool{
+					MaxRetries: 3,
+				},
+			},
+			data: map[string]string{
+				config.ConfigMapKeyTrafficPolicy: `{"loadBalancer": "RANDOM", "connectionPool": {"maxRetries": 10, "maxConnections": 50}, "outlierDetection": {"consecutiveErrors": 7}}`,
+			},
+			want: &istionetworkingv1alpha3.TrafficPolicy{
+				LoadBalancer: &istionetworkingv1alpha3.LoadBalancerSettings{
+					Simple: "RANDOM",
+				},
+				ConnectionPool: &istionetworkingv1alpha3.ConnectionPoolSettings{
+					Tcp: &istionetworkingv1alpha3.ConnectionPoolSettings_TCPSettings{
+						MaxConnections: 50,
+					},
+					Http: &istionetworkingv1alpha3.ConnectionPoolSettings_HTTPSettings{
+						MaxRetries: 3,
+					},
+				},
+				OutlierDetection: &istionetworkingv1alpha3.OutlierDetection{
+					ConsecutiveErrors: 7,
+				},
+			},
+		},
+		{
+			name:   "empty policy",
+			policy: &v1alpha2.TrafficPolicy{},
+			want:   &istionetworkingv1alpha3.TrafficPolicy{},
+		},
+		{
+			name:    "invalid defaults",
+			policy:  &v1alpha2.TrafficPolicy{},
+			data:    map[string]string{config.ConfigMapKeyTrafficPolicy: "{"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := MakeIstioTrafficPolicy(test.policy, fake.New(test.data))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("MakeIstioTrafficPolicy() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("MakeIstioTrafficPolicy() (-want, +got)\n%v", diff)
+			}
+		})
+	}
+}
+
+func TestMakeIstioTrafficPolicyDoesNotModifyThePolicy(t *testing.T) {
+	policy := &v1alpha2.TrafficPolicy{}
+	cfg := fake.New(map[string]string{config.ConfigMapKeyTrafficPolicy: `{"loadBalancer": "RANDOM"}`})
+	if _, err := MakeIstioTrafficPolicy(policy, cfg); err != nil {
+		t.Fatalf("MakeIstioTrafficPolicy() error = %v", err)
+	}
+	if len(policy.LoadBalancer) > 0 {
+		t.Errorf("MakeIstioTrafficPolicy() modified the policy: %v", policy)
+	}
+}
+
+func TestIstioDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		10 * time.Second:        "10s",
+		1500 * time.Millisecond: "1.5s",
+		time.Millisecond:        "0.001s",
+		2 * time.Minute:         "120s",
+	}
+	for d, want := range tests {
+		if got := IstioDuration(d); got != want {
+			t.Errorf("IstioDuration(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestConflictingDestinationRule(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	gateway := &v1alpha2.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "foo", UID: types.UID("1")}}
+	host := "hr.foo.svc.cluster.local"
+
+	owned := &istionetworkingv1alpha3.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "gateway-owned",
+			Namespace:       "foo",
+			OwnerReferences: []metav1.OwnerReference{*CreateGatewayOwnerRef(gateway)},
+		},
+		Spec: istionetworkingv1alpha3.DestinationRuleSpec{Host: host},
+	}
+	other := &istionetworkingv1alpha3.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "bar"},
+		Spec:       istionetworkingv1alpha3.DestinationRuleSpec{Host: host},
+	}
+	indexer.Add(owned)
+	indexer.Add(other)
+	lister := istionetworkingv1alpha3listers.NewDestinationRuleLister(indexer)
+
+	if got, err := ConflictingDestinationRule(lister, gateway, "gateway-hr", host); err != nil || got != nil {
+		t.Errorf("ConflictingDestinationRule() = %v, %v, want nil", got, err)
+	}
+
+	conflicting := &istionetworkingv1alpha3.DestinationRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "hr", Namespace: "foo"},
+		Spec:       istionetworkingv1alpha3.DestinationRuleSpec{Host: host},
+	}
+	indexer.Add(conflicting)
+
+	if got, err := ConflictingDestinationRule(lister, gateway, "gateway-hr", host); err != nil || got != conflicting {
+		t.Errorf("ConflictingDestinationRule() = %v, %v, want %v", got, err, conflicting)
+	}
+	if got, err := ConflictingDestinationRule(lister, gateway, "hr", host); err != nil || got != nil {
+		t.Errorf("ConflictingDestinationRule() = %v, %v, want nil", got, err)
+	}
+}
+
+func TestConflictingDestinationRuleWinner(t *testing.T) {
+	host := "hr.foo.svc.cluster.local"
+	created := metav1.NewTime(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC))
+	component := func(name, uid string) *v1alpha2.Component {
+		return &v1alpha2.Component{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "foo", UID: types.UID(uid)}}
+	}
+	destinationRule := func(owner *v1alpha2.Component, creationTimestamp metav1.Time) *istionetworkingv1alpha3.DestinationRule {
+		return &istionetworkingv1alpha3.DestinationRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              owner.Name + "-destination-rule",
+				Namespace:         "foo",
+				CreationTimestamp: creationTimestamp,
+				OwnerReferences:   []metav1.OwnerReference{*CreateComponentOwnerRef(owner)},
+			},
+			Spec: istionetworkingv1alpha3.DestinationRuleSpec{Host: host},
+		}
+	}
+	hr := component("hr", "1")
+	payroll := component("payroll", "2")
+
+	tests := []struct {
+		name   string
+		hrRule *istionetworkingv1alpha3.DestinationRule
+		other  *istionetworkingv1alpha3.DestinationRule
+		winner *v1alpha2.Component
+	}{
+		{
+			name:   "oldest destination rule wins",
+			hrRule: destinationRule(hr, metav1.NewTime(created.Add(time.Minute))),
+			other:  destinationRule(payroll, created),
+			winner: payroll,
+		},
+		{
+			name:   "lowest name wins if created at the same time",
+			hrRule: destinationRule(hr, created),
+			other:  destinationRule(payroll, created),
+			winner: hr,
+		},
+		{
+			name:   "existing destination rule wins over a new one",
+			other:  destinationRule(payroll, created),
+			winner: payroll,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			if test.hrRule != nil {
+				indexer.Add(test.hrRule)
+			}
+			indexer.Add(test.other)
+			lister := istionetworkingv1alpha3listers.NewDestinationRuleLister(indexer)
+
+			// The owners are checked in both orders and must agree on the winner
+			for _, order := range [][]*v1alpha2.Component{{hr, payroll}, {payroll, hr}} {
+				for _, owner := range order {
+					got, err := ConflictingDestinationRule(lister, owner, owner.Name+"-destination-rule", host)
+					if err != nil {
+						t.Fatalf("ConflictingDestinationRule() error = %v", err)
+					}
+					if owner == test.winner && got != nil {
+						t.Errorf("ConflictingDestinationRule() of the winner %s = %s, want nil", owner.Name, got.Name)
+					} else if owner != test.winner && (got == nil || got.Name != test.winner.Name+"-destination-rule") {
+						t.Errorf("ConflictingDestinationRule() of %s = %v, want the destination rule of %s", owner.Name, got, test.winner.Name)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"cellery.io/cellery-controller/pkg/controller"
+)
+
+// NewHandler returns the handler of the debug endpoints. The pprof profiles are served under /debug/pprof/,
+// the state of the work queues under /debug/queues (optionally filtered with ?controller=<name>) and the
+// desired and current child resources of an object under /debug/objects/<controller>/<namespace>/<name>.
+func NewHandler(controllers []*controller.Controller) http.Handler {
+	byName := make(map[string]*controller.Controller, len(controllers))
+	for _, c := range controllers {
+		byName[strings.ToLower(c.Name())] = c
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	mux.HandleFunc("/debug/queues", func(w http.ResponseWriter, r *http.Request) {
+		var states []controller.QueueState
+		if name := r.URL.Query().Get("controller"); len(name) > 0 {
+			c, ok := byName[strings.ToLower(name)]
+			if !ok {
+				http.Error(w, "unknown controller "+name, http.StatusNotFound)
+				return
+			}
+			states = append(states, c.QueueState())
+		} else {
+			for _, c := range controllers {
+				states = append(states, c.QueueState())
+			}
+		}
+		writeJSON(w, states)
+	})
+
+	mux.HandleFunc("/debug/objects/", func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/debug/objects/"), "/")
+		if len(parts) != 3 {
+			http.Error(w, "expected /debug/objects/<controller>/<namespace>/<name>", http.StatusBadRequest)
+			return
+		}
+		c, ok := byName[strings.ToLower(parts[0])]
+		if !ok {
+			http.Error(w, "unknown controller "+parts[0], http.StatusNotFound)
+			return
+		}
+		states, err := c.Inspect(parts[1] + "/" + parts[2])
+		if errors.IsNotFound(err) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, states)
+	})
+	return mux
+}
+
+// ValidateAddress rejects the addresses which are reachable from outside the pod unless remote access is
+// allowed, since the debug endpoints are not authenticated.
+func ValidateAddress(address string, allowRemote bool) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid debug address %q: %v", address, err)
+	}
+	if allowRemote || host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("debug address %q is not a loopback address, the debug endpoints are not authenticated", address)
+}
+
+// Serve exposes the debug endpoints of the controllers on the given address until the stop channel is closed.
+func Serve(address string, controllers []*controller.Controller, stopCh <-chan struct{}, logger *zap.SugaredLogger) {
+	server := &http.Server{Addr: address, Handler: NewHandler(controllers)}
+
+	go func() {
+		<-stopCh
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(ctx)
+	}()
+
+	logger.Infof("Serving debug endpoints on %s", address)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		logger.Errorf("Failed to serve debug endpoints: %v", err)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
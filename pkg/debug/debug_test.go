@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"cellery.io/cellery-controller/pkg/controller"
+)
+
+type inspectingReconciler struct{}
+
+func (r *inspectingReconciler) Reconcile(ctx context.Context, key string) (controller.Result, error) {
+	return controller.Result{}, fmt.Errorf("failed to reconcile %s", key)
+}
+
+func (r *inspectingReconciler) Inspect(key string) ([]controller.ResourceState, error) {
+	if key != "foo/bar" {
+		return nil, errors.NewNotFound(schema.GroupResource{Resource: "tests"}, key)
+	}
+	return []controller.ResourceState{
+		controller.NewResourceState("Service", "bar-service", map[string]string{"name": "bar-service"}, nil,
+			nil, errors.NewNotFound(schema.GroupResource{Resource: "services"}, "bar-service")),
+	}, nil
+}
+
+func TestQueues(t *testing.T) {
+	c := controller.New(&inspectingReconciler{}, zap.NewNop().Sugar(), "Test")
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go c.Run(1, stopCh)
+	c.EnqueueKey("foo/bar")
+
+	server := httptest.NewServer(NewHandler([]*controller.Controller{c}))
+	defer server.Close()
+
+	var states []controller.QueueState
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		states = nil
+		getJSON(t, server.URL+"/debug/queues?controller=test", http.StatusOK, &states)
+		if len(states) == 1 && len(states[0].Keys) == 1 && states[0].Keys[0].Retries > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the failed reconcile was not recorded: %+v", states)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	key := states[0].Keys[0]
+	if key.Key != "foo/bar" || key.LastError != "failed to reconcile foo/bar" || key.LastReconcileTime.IsZero() {
+		t.Errorf("unexpected key state: %+v", key)
+	}
+
+	getJSON(t, server.URL+"/debug/queues?controller=unknown", http.StatusNotFound, nil)
+}
+
+func TestObjects(t *testing.T) {
+	c := controller.New(&inspectingReconciler{}, zap.NewNop().Sugar(), "Test")
+	server := httptest.NewServer(NewHandler([]*controller.Controller{c}))
+	defer server.Close()
+
+	var states []controller.ResourceState
+	getJSON(t, server.URL+"/debug/objects/test/foo/bar", http.StatusOK, &states)
+	if len(states) != 1 {
+		t.Fatalf("expected one resource, got %+v", states)
+	}
+	if states[0].Name != "bar-service" || states[0].Desired == nil || states[0].Actual != nil || states[0].Error != "" {
+		t.Errorf("unexpected resource state: %+v", states[0])
+	}
+
+	getJSON(t, server.URL+"/debug/objects/test/foo/baz", http.StatusNotFound, nil)
+	getJSON(t, server.URL+"/debug/objects/test/foo", http.StatusBadRequest, nil)
+}
+
+func getJSON(t *testing.T, url string, status int, v interface{}) {
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s failed: %v", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != status {
+		t.Fatalf("GET %s returned %d, want %d", url, resp.StatusCode, status)
+	}
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatalf("invalid response of %s: %v", url, err)
+		}
+	}
+}
+
+func TestValidateAddress(t *testing.T) {
+	tests := []struct {
+		address     string
+		allowRemote bool
+		wantErr     bool
+	}{
+		{address: "127.0.0.1:8008"},
+		{address: "localhost:8008"},
+		{address: "[::1]:8008"},
+		{address: ":8008", wantErr: true},
+		{address: "0.0.0.0:8008", wantErr: true},
+		{address: "10.0.0.1:8008", wantErr: true},
+		{address: ":8008", allowRemote: true},
+		{address: "8008", wantErr: true},
+	}
+	for _, test := range tests {
+		err := ValidateAddress(test.address, test.allowRemote)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ValidateAddress(%q, %v) error = %v, wantErr %v", test.address, test.allowRemote, err, test.wantErr)
+		}
+	}
+}
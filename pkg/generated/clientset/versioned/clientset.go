@@ -22,8 +22,16 @@ package versioned
 
 import (
 	authenticationv1alpha1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/authentication/v1alpha1"
+	autoscalingv2 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/autoscaling/v2"
+	certmanagerv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/certmanager/v1"
+	gatewayapiv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/gatewayapi/v1"
+	gatewayapiv1alpha2 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/gatewayapi/v1alpha2"
+	k8snetworkingv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/k8snetworking/v1"
+	kedav1alpha1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/keda/v1alpha1"
 	meshv1alpha2 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/mesh/v1alpha2"
 	networkingv1alpha3 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/networking/v1alpha3"
+	securityv1beta1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/security/v1beta1"
+	servingv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/serving/v1"
 	servingv1alpha1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/serving/v1alpha1"
 	servingv1beta1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/serving/v1beta1"
 	discovery "k8s.io/client-go/discovery"
@@ -34,8 +42,16 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	AuthenticationV1alpha1() authenticationv1alpha1.AuthenticationV1alpha1Interface
+	AutoscalingV2() autoscalingv2.AutoscalingV2Interface
+	CertmanagerV1() certmanagerv1.CertmanagerV1Interface
+	GatewayAPIV1() gatewayapiv1.GatewayAPIV1Interface
+	GatewayAPIV1alpha2() gatewayapiv1alpha2.GatewayAPIV1alpha2Interface
+	K8sNetworkingV1() k8snetworkingv1.K8sNetworkingV1Interface
+	KedaV1alpha1() kedav1alpha1.KedaV1alpha1Interface
 	MeshV1alpha2() meshv1alpha2.MeshV1alpha2Interface
 	NetworkingV1alpha3() networkingv1alpha3.NetworkingV1alpha3Interface
+	SecurityV1beta1() securityv1beta1.SecurityV1beta1Interface
+	ServingV1() servingv1.ServingV1Interface
 	ServingV1alpha1() servingv1alpha1.ServingV1alpha1Interface
 	ServingV1beta1() servingv1beta1.ServingV1beta1Interface
 }
@@ -45,8 +61,16 @@ type Interface interface {
 type Clientset struct {
 	*discovery.DiscoveryClient
 	authenticationV1alpha1 *authenticationv1alpha1.AuthenticationV1alpha1Client
+	autoscalingV2          *autoscalingv2.AutoscalingV2Client
+	certmanagerV1          *certmanagerv1.CertmanagerV1Client
+	gatewayAPIV1           *gatewayapiv1.GatewayAPIV1Client
+	gatewayAPIV1alpha2     *gatewayapiv1alpha2.GatewayAPIV1alpha2Client
+	k8sNetworkingV1        *k8snetworkingv1.K8sNetworkingV1Client
+	kedaV1alpha1           *kedav1alpha1.KedaV1alpha1Client
 	meshV1alpha2           *meshv1alpha2.MeshV1alpha2Client
 	networkingV1alpha3     *networkingv1alpha3.NetworkingV1alpha3Client
+	securityV1beta1        *securityv1beta1.SecurityV1beta1Client
+	servingV1              *servingv1.ServingV1Client
 	servingV1alpha1        *servingv1alpha1.ServingV1alpha1Client
 	servingV1beta1         *servingv1beta1.ServingV1beta1Client
 }
@@ -56,6 +80,36 @@ func (c *Clientset) AuthenticationV1alpha1() authenticationv1alpha1.Authenticati
 	return c.authenticationV1alpha1
 }
 
+// AutoscalingV2 retrieves the AutoscalingV2Client
+func (c *Clientset) AutoscalingV2() autoscalingv2.AutoscalingV2Interface {
+	return c.autoscalingV2
+}
+
+// CertmanagerV1 retrieves the CertmanagerV1Client
+func (c *Clientset) CertmanagerV1() certmanagerv1.CertmanagerV1Interface {
+	return c.certmanagerV1
+}
+
+// GatewayAPIV1 retrieves the GatewayAPIV1Client
+func (c *Clientset) GatewayAPIV1() gatewayapiv1.GatewayAPIV1Interface {
+	return c.gatewayAPIV1
+}
+
+// GatewayAPIV1alpha2 retrieves the GatewayAPIV1alpha2Client
+func (c *Clientset) GatewayAPIV1alpha2() gatewayapiv1alpha2.GatewayAPIV1alpha2Interface {
+	return c.gatewayAPIV1alpha2
+}
+
+// K8sNetworkingV1 retrieves the K8sNetworkingV1Client
+func (c *Clientset) K8sNetworkingV1() k8snetworkingv1.K8sNetworkingV1Interface {
+	return c.k8sNetworkingV1
+}
+
+// KedaV1alpha1 retrieves the KedaV1alpha1Client
+func (c *Clientset) KedaV1alpha1() kedav1alpha1.KedaV1alpha1Interface {
+	return c.kedaV1alpha1
+}
+
 // MeshV1alpha2 retrieves the MeshV1alpha2Client
 func (c *Clientset) MeshV1alpha2() meshv1alpha2.MeshV1alpha2Interface {
 	return c.meshV1alpha2
@@ -66,6 +120,16 @@ func (c *Clientset) NetworkingV1alpha3() networkingv1alpha3.NetworkingV1alpha3In
 	return c.networkingV1alpha3
 }
 
+// SecurityV1beta1 retrieves the SecurityV1beta1Client
+func (c *Clientset) SecurityV1beta1() securityv1beta1.SecurityV1beta1Interface {
+	return c.securityV1beta1
+}
+
+// ServingV1 retrieves the ServingV1Client
+func (c *Clientset) ServingV1() servingv1.ServingV1Interface {
+	return c.servingV1
+}
+
 // ServingV1alpha1 retrieves the ServingV1alpha1Client
 func (c *Clientset) ServingV1alpha1() servingv1alpha1.ServingV1alpha1Interface {
 	return c.servingV1alpha1
@@ -96,6 +160,30 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.autoscalingV2, err = autoscalingv2.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.certmanagerV1, err = certmanagerv1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.gatewayAPIV1, err = gatewayapiv1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.gatewayAPIV1alpha2, err = gatewayapiv1alpha2.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.k8sNetworkingV1, err = k8snetworkingv1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.kedaV1alpha1, err = kedav1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.meshV1alpha2, err = meshv1alpha2.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
@@ -104,6 +192,14 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.securityV1beta1, err = securityv1beta1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.servingV1, err = servingv1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.servingV1alpha1, err = servingv1alpha1.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
@@ -125,8 +221,16 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
 	cs.authenticationV1alpha1 = authenticationv1alpha1.NewForConfigOrDie(c)
+	cs.autoscalingV2 = autoscalingv2.NewForConfigOrDie(c)
+	cs.certmanagerV1 = certmanagerv1.NewForConfigOrDie(c)
+	cs.gatewayAPIV1 = gatewayapiv1.NewForConfigOrDie(c)
+	cs.gatewayAPIV1alpha2 = gatewayapiv1alpha2.NewForConfigOrDie(c)
+	cs.k8sNetworkingV1 = k8snetworkingv1.NewForConfigOrDie(c)
+	cs.kedaV1alpha1 = kedav1alpha1.NewForConfigOrDie(c)
 	cs.meshV1alpha2 = meshv1alpha2.NewForConfigOrDie(c)
 	cs.networkingV1alpha3 = networkingv1alpha3.NewForConfigOrDie(c)
+	cs.securityV1beta1 = securityv1beta1.NewForConfigOrDie(c)
+	cs.servingV1 = servingv1.NewForConfigOrDie(c)
 	cs.servingV1alpha1 = servingv1alpha1.NewForConfigOrDie(c)
 	cs.servingV1beta1 = servingv1beta1.NewForConfigOrDie(c)
 
@@ -138,8 +242,16 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.authenticationV1alpha1 = authenticationv1alpha1.New(c)
+	cs.autoscalingV2 = autoscalingv2.New(c)
+	cs.certmanagerV1 = certmanagerv1.New(c)
+	cs.gatewayAPIV1 = gatewayapiv1.New(c)
+	cs.gatewayAPIV1alpha2 = gatewayapiv1alpha2.New(c)
+	cs.k8sNetworkingV1 = k8snetworkingv1.New(c)
+	cs.kedaV1alpha1 = kedav1alpha1.New(c)
 	cs.meshV1alpha2 = meshv1alpha2.New(c)
 	cs.networkingV1alpha3 = networkingv1alpha3.New(c)
+	cs.securityV1beta1 = securityv1beta1.New(c)
+	cs.servingV1 = servingv1.New(c)
 	cs.servingV1alpha1 = servingv1alpha1.New(c)
 	cs.servingV1beta1 = servingv1beta1.New(c)
 
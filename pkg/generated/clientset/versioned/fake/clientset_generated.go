@@ -24,10 +24,26 @@ import (
 	clientset "cellery.io/cellery-controller/pkg/generated/clientset/versioned"
 	authenticationv1alpha1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/authentication/v1alpha1"
 	fakeauthenticationv1alpha1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/authentication/v1alpha1/fake"
+	autoscalingv2 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/autoscaling/v2"
+	fakeautoscalingv2 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/autoscaling/v2/fake"
+	certmanagerv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/certmanager/v1"
+	fakecertmanagerv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/certmanager/v1/fake"
+	gatewayapiv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/gatewayapi/v1"
+	fakegatewayapiv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/gatewayapi/v1/fake"
+	gatewayapiv1alpha2 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/gatewayapi/v1alpha2"
+	fakegatewayapiv1alpha2 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/gatewayapi/v1alpha2/fake"
+	k8snetworkingv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/k8snetworking/v1"
+	fakek8snetworkingv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/k8snetworking/v1/fake"
+	kedav1alpha1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/keda/v1alpha1"
+	fakekedav1alpha1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/keda/v1alpha1/fake"
 	meshv1alpha2 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/mesh/v1alpha2"
 	fakemeshv1alpha2 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/mesh/v1alpha2/fake"
 	networkingv1alpha3 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/networking/v1alpha3"
 	fakenetworkingv1alpha3 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/networking/v1alpha3/fake"
+	securityv1beta1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/security/v1beta1"
+	fakesecurityv1beta1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/security/v1beta1/fake"
+	servingv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/serving/v1"
+	fakeservingv1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/serving/v1/fake"
 	servingv1alpha1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/serving/v1alpha1"
 	fakeservingv1alpha1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/serving/v1alpha1/fake"
 	servingv1beta1 "cellery.io/cellery-controller/pkg/generated/clientset/versioned/typed/serving/v1beta1"
@@ -91,6 +107,36 @@ func (c *Clientset) AuthenticationV1alpha1() authenticationv1alpha1.Authenticati
 	return &fakeauthenticationv1alpha1.FakeAuthenticationV1alpha1{Fake: &c.Fake}
 }
 
+// AutoscalingV2 retrieves the AutoscalingV2Client
+func (c *Clientset) AutoscalingV2() autoscalingv2.AutoscalingV2Interface {
+	return &fakeautoscalingv2.FakeAutoscalingV2{Fake: &c.Fake}
+}
+
+// CertmanagerV1 retrieves the CertmanagerV1Client
+func (c *Clientset) CertmanagerV1() certmanagerv1.CertmanagerV1Interface {
+	return &fakecertmanagerv1.FakeCertmanagerV1{Fake: &c.Fake}
+}
+
+// GatewayAPIV1 retrieves the GatewayAPIV1Client
+func (c *Clientset) GatewayAPIV1() gatewayapiv1.GatewayAPIV1Interface {
+	return &fakegatewayapiv1.FakeGatewayAPIV1{Fake: &c.Fake}
+}
+
+// GatewayAPIV1alpha2 retrieves the GatewayAPIV1alpha2Client
+func (c *Clientset) GatewayAPIV1alpha2() gatewayapiv1alpha2.GatewayAPIV1alpha2Interface {
+	return &fakegatewayapiv1alpha2.FakeGatewayAPIV1alpha2{Fake: &c.Fake}
+}
+
+// K8sNetworkingV1 retrieves the K8sNetworkingV1Client
+func (c *Clientset) K8sNetworkingV1() k8snetworkingv1.K8sNetworkingV1Interface {
+	return &fakek8snetworkingv1.FakeK8sNetworkingV1{Fake: &c.Fake}
+}
+
+// KedaV1alpha1 retrieves the KedaV1alpha1Client
+func (c *Clientset) KedaV1alpha1() kedav1alpha1.KedaV1alpha1Interface {
+	return &fakekedav1alpha1.FakeKedaV1alpha1{Fake: &c.Fake}
+}
+
 // MeshV1alpha2 retrieves the MeshV1alpha2Client
 func (c *Clientset) MeshV1alpha2() meshv1alpha2.MeshV1alpha2Interface {
 	return &fakemeshv1alpha2.FakeMeshV1alpha2{Fake: &c.Fake}
@@ -101,6 +147,16 @@ func (c *Clientset) NetworkingV1alpha3() networkingv1alpha3.NetworkingV1alpha3In
 	return &fakenetworkingv1alpha3.FakeNetworkingV1alpha3{Fake: &c.Fake}
 }
 
+// SecurityV1beta1 retrieves the SecurityV1beta1Client
+func (c *Clientset) SecurityV1beta1() securityv1beta1.SecurityV1beta1Interface {
+	return &fakesecurityv1beta1.FakeSecurityV1beta1{Fake: &c.Fake}
+}
+
+// ServingV1 retrieves the ServingV1Client
+func (c *Clientset) ServingV1() servingv1.ServingV1Interface {
+	return &fakeservingv1.FakeServingV1{Fake: &c.Fake}
+}
+
 // ServingV1alpha1 retrieves the ServingV1alpha1Client
 func (c *Clientset) ServingV1alpha1() servingv1alpha1.ServingV1alpha1Interface {
 	return &fakeservingv1alpha1.FakeServingV1alpha1{Fake: &c.Fake}
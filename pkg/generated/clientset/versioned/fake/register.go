@@ -21,8 +21,16 @@
 package fake
 
 import (
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
+	certmanagerv1 "cellery.io/cellery-controller/pkg/apis/certmanager/v1"
 	authenticationv1alpha1 "cellery.io/cellery-controller/pkg/apis/istio/authentication/v1alpha1"
 	networkingv1alpha3 "cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	securityv1beta1 "cellery.io/cellery-controller/pkg/apis/istio/security/v1beta1"
+	gatewayapiv1 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1"
+	gatewayapiv1alpha2 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1alpha2"
+	k8snetworkingv1 "cellery.io/cellery-controller/pkg/apis/k8snetworking/v1"
+	kedav1alpha1 "cellery.io/cellery-controller/pkg/apis/keda/v1alpha1"
+	servingv1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1"
 	servingv1alpha1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1alpha1"
 	servingv1beta1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1beta1"
 	meshv1alpha2 "cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
@@ -38,8 +46,16 @@ var codecs = serializer.NewCodecFactory(scheme)
 var parameterCodec = runtime.NewParameterCodec(scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
 	authenticationv1alpha1.AddToScheme,
+	autoscalingv2.AddToScheme,
+	certmanagerv1.AddToScheme,
+	gatewayapiv1.AddToScheme,
+	gatewayapiv1alpha2.AddToScheme,
+	k8snetworkingv1.AddToScheme,
+	kedav1alpha1.AddToScheme,
 	meshv1alpha2.AddToScheme,
 	networkingv1alpha3.AddToScheme,
+	securityv1beta1.AddToScheme,
+	servingv1.AddToScheme,
 	servingv1alpha1.AddToScheme,
 	servingv1beta1.AddToScheme,
 }
@@ -30,6 +30,10 @@ type FakeMeshV1alpha2 struct {
 	*testing.Fake
 }
 
+func (c *FakeMeshV1alpha2) AutoscaleOverrides(namespace string) v1alpha2.AutoscaleOverrideInterface {
+	return &FakeAutoscaleOverrides{c, namespace}
+}
+
 func (c *FakeMeshV1alpha2) Cells(namespace string) v1alpha2.CellInterface {
 	return &FakeCells{c, namespace}
 }
@@ -20,6 +20,8 @@
 
 package v1alpha2
 
+type AutoscaleOverrideExpansion interface{}
+
 type CellExpansion interface{}
 
 type ComponentExpansion interface{}
@@ -28,6 +28,7 @@ import (
 
 type MeshV1alpha2Interface interface {
 	RESTClient() rest.Interface
+	AutoscaleOverridesGetter
 	CellsGetter
 	ComponentsGetter
 	CompositesGetter
@@ -40,6 +41,10 @@ type MeshV1alpha2Client struct {
 	restClient rest.Interface
 }
 
+func (c *MeshV1alpha2Client) AutoscaleOverrides(namespace string) AutoscaleOverrideInterface {
+	return newAutoscaleOverrides(c, namespace)
+}
+
 func (c *MeshV1alpha2Client) Cells(namespace string) CellInterface {
 	return newCells(c, namespace)
 }
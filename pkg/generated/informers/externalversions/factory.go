@@ -27,9 +27,15 @@ import (
 
 	versioned "cellery.io/cellery-controller/pkg/generated/clientset/versioned"
 	authentication "cellery.io/cellery-controller/pkg/generated/informers/externalversions/authentication"
+	autoscaling "cellery.io/cellery-controller/pkg/generated/informers/externalversions/autoscaling"
+	certmanager "cellery.io/cellery-controller/pkg/generated/informers/externalversions/certmanager"
+	gatewayapi "cellery.io/cellery-controller/pkg/generated/informers/externalversions/gatewayapi"
 	internalinterfaces "cellery.io/cellery-controller/pkg/generated/informers/externalversions/internalinterfaces"
+	k8snetworking "cellery.io/cellery-controller/pkg/generated/informers/externalversions/k8snetworking"
+	keda "cellery.io/cellery-controller/pkg/generated/informers/externalversions/keda"
 	mesh "cellery.io/cellery-controller/pkg/generated/informers/externalversions/mesh"
 	networking "cellery.io/cellery-controller/pkg/generated/informers/externalversions/networking"
+	security "cellery.io/cellery-controller/pkg/generated/informers/externalversions/security"
 	serving "cellery.io/cellery-controller/pkg/generated/informers/externalversions/serving"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -178,8 +184,14 @@ type SharedInformerFactory interface {
 	WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool
 
 	Authentication() authentication.Interface
+	Autoscaling() autoscaling.Interface
+	Certmanager() certmanager.Interface
+	GatewayAPI() gatewayapi.Interface
+	K8sNetworking() k8snetworking.Interface
+	Keda() keda.Interface
 	Mesh() mesh.Interface
 	Networking() networking.Interface
+	Security() security.Interface
 	Serving() serving.Interface
 }
 
@@ -187,6 +199,26 @@ func (f *sharedInformerFactory) Authentication() authentication.Interface {
 	return authentication.New(f, f.namespace, f.tweakListOptions)
 }
 
+func (f *sharedInformerFactory) Autoscaling() autoscaling.Interface {
+	return autoscaling.New(f, f.namespace, f.tweakListOptions)
+}
+
+func (f *sharedInformerFactory) Certmanager() certmanager.Interface {
+	return certmanager.New(f, f.namespace, f.tweakListOptions)
+}
+
+func (f *sharedInformerFactory) GatewayAPI() gatewayapi.Interface {
+	return gatewayapi.New(f, f.namespace, f.tweakListOptions)
+}
+
+func (f *sharedInformerFactory) K8sNetworking() k8snetworking.Interface {
+	return k8snetworking.New(f, f.namespace, f.tweakListOptions)
+}
+
+func (f *sharedInformerFactory) Keda() keda.Interface {
+	return keda.New(f, f.namespace, f.tweakListOptions)
+}
+
 func (f *sharedInformerFactory) Mesh() mesh.Interface {
 	return mesh.New(f, f.namespace, f.tweakListOptions)
 }
@@ -195,6 +227,10 @@ func (f *sharedInformerFactory) Networking() networking.Interface {
 	return networking.New(f, f.namespace, f.tweakListOptions)
 }
 
+func (f *sharedInformerFactory) Security() security.Interface {
+	return security.New(f, f.namespace, f.tweakListOptions)
+}
+
 func (f *sharedInformerFactory) Serving() serving.Interface {
 	return serving.New(f, f.namespace, f.tweakListOptions)
 }
@@ -23,8 +23,16 @@ package externalversions
 import (
 	"fmt"
 
+	v2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
+	certmanagerv1 "cellery.io/cellery-controller/pkg/apis/certmanager/v1"
+	gatewayapiv1 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1"
+	gatewayapiv1alpha2 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1alpha2"
 	v1alpha1 "cellery.io/cellery-controller/pkg/apis/istio/authentication/v1alpha1"
 	v1alpha3 "cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	securityv1beta1 "cellery.io/cellery-controller/pkg/apis/istio/security/v1beta1"
+	v1 "cellery.io/cellery-controller/pkg/apis/k8snetworking/v1"
+	kedav1alpha1 "cellery.io/cellery-controller/pkg/apis/keda/v1alpha1"
+	servingv1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1"
 	servingv1alpha1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1alpha1"
 	v1beta1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1beta1"
 	v1alpha2 "cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
@@ -62,7 +70,33 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha1.SchemeGroupVersion.WithResource("policies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Authentication().V1alpha1().Policies().Informer()}, nil
 
+		// Group=autoscaling, Version=v2
+	case v2.SchemeGroupVersion.WithResource("horizontalpodautoscalers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Autoscaling().V2().HorizontalPodAutoscalers().Informer()}, nil
+
+		// Group=cert-manager.io, Version=v1
+	case certmanagerv1.SchemeGroupVersion.WithResource("certificates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().Certificates().Informer()}, nil
+
+		// Group=gateway.networking.k8s.io, Version=v1
+	case gatewayapiv1.SchemeGroupVersion.WithResource("grpcroutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.GatewayAPI().V1().GRPCRoutes().Informer()}, nil
+	case gatewayapiv1.SchemeGroupVersion.WithResource("httproutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.GatewayAPI().V1().HTTPRoutes().Informer()}, nil
+
+		// Group=gateway.networking.k8s.io, Version=v1alpha2
+	case gatewayapiv1alpha2.SchemeGroupVersion.WithResource("tcproutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.GatewayAPI().V1alpha2().TCPRoutes().Informer()}, nil
+
+		// Group=keda.sh, Version=v1alpha1
+	case kedav1alpha1.SchemeGroupVersion.WithResource("scaledobjects"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Keda().V1alpha1().ScaledObjects().Informer()}, nil
+	case kedav1alpha1.SchemeGroupVersion.WithResource("triggerauthentications"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Keda().V1alpha1().TriggerAuthentications().Informer()}, nil
+
 		// Group=mesh.cellery.io, Version=v1alpha2
+	case v1alpha2.SchemeGroupVersion.WithResource("autoscaleoverrides"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Mesh().V1alpha2().AutoscaleOverrides().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("cells"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Mesh().V1alpha2().Cells().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("components"):
@@ -84,6 +118,28 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha3.SchemeGroupVersion.WithResource("virtualservices"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1alpha3().VirtualServices().Informer()}, nil
 
+		// Group=networking.k8s.io, Version=v1
+	case v1.SchemeGroupVersion.WithResource("ingresses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.K8sNetworking().V1().Ingresses().Informer()}, nil
+
+		// Group=security.istio.io, Version=v1beta1
+	case securityv1beta1.SchemeGroupVersion.WithResource("authorizationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Security().V1beta1().AuthorizationPolicies().Informer()}, nil
+	case securityv1beta1.SchemeGroupVersion.WithResource("peerauthentications"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Security().V1beta1().PeerAuthentications().Informer()}, nil
+	case securityv1beta1.SchemeGroupVersion.WithResource("requestauthentications"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Security().V1beta1().RequestAuthentications().Informer()}, nil
+
+		// Group=serving.knative.dev, Version=v1
+	case servingv1.SchemeGroupVersion.WithResource("configurations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Serving().V1().Configurations().Informer()}, nil
+	case servingv1.SchemeGroupVersion.WithResource("revisions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Serving().V1().Revisions().Informer()}, nil
+	case servingv1.SchemeGroupVersion.WithResource("routes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Serving().V1().Routes().Informer()}, nil
+	case servingv1.SchemeGroupVersion.WithResource("services"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Serving().V1().Services().Informer()}, nil
+
 		// Group=serving.knative.dev, Version=v1alpha1
 	case servingv1alpha1.SchemeGroupVersion.WithResource("configurations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Serving().V1alpha1().Configurations().Informer()}, nil
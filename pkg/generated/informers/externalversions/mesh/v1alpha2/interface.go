@@ -26,6 +26,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AutoscaleOverrides returns a AutoscaleOverrideInformer.
+	AutoscaleOverrides() AutoscaleOverrideInformer
 	// Cells returns a CellInformer.
 	Cells() CellInformer
 	// Components returns a ComponentInformer.
@@ -49,6 +51,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AutoscaleOverrides returns a AutoscaleOverrideInformer.
+func (v *version) AutoscaleOverrides() AutoscaleOverrideInformer {
+	return &autoscaleOverrideInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Cells returns a CellInformer.
 func (v *version) Cells() CellInformer {
 	return &cellInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -20,6 +20,14 @@
 
 package v1alpha2
 
+// AutoscaleOverrideListerExpansion allows custom methods to be added to
+// AutoscaleOverrideLister.
+type AutoscaleOverrideListerExpansion interface{}
+
+// AutoscaleOverrideNamespaceListerExpansion allows custom methods to be added to
+// AutoscaleOverrideNamespaceLister.
+type AutoscaleOverrideNamespaceListerExpansion interface{}
+
 // CellListerExpansion allows custom methods to be added to
 // CellLister.
 type CellListerExpansion interface{}
@@ -24,29 +24,87 @@ import (
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
 
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
+	certmanagerv1 "cellery.io/cellery-controller/pkg/apis/certmanager/v1"
+	gatewayapiv1 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1"
+	gatewayapiv1alpha2 "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1alpha2"
+	istioauthenticationv1alpha1 "cellery.io/cellery-controller/pkg/apis/istio/authentication/v1alpha1"
+	istionetworkingv1alpha3 "cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	istiosecurityv1beta1 "cellery.io/cellery-controller/pkg/apis/istio/security/v1beta1"
+	k8snetworkingv1 "cellery.io/cellery-controller/pkg/apis/k8snetworking/v1"
+	kedav1alpha1 "cellery.io/cellery-controller/pkg/apis/keda/v1alpha1"
+	knativeservingv1 "cellery.io/cellery-controller/pkg/apis/knative/serving/v1"
 	v1alpha2 "cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	clients "cellery.io/cellery-controller/pkg/clients"
 	informers "cellery.io/cellery-controller/pkg/informers"
 )
 
-type fake struct {
+// Informers is an informers.Interface whose caches are seeded with the given objects instead of
+// being populated from the API server.
+type Informers struct {
 	informers.Interface
 	cache map[reflect.Type]cache.Indexer
 }
 
-func New(clients clients.Interface, resync time.Duration, objs ...runtime.Object) *fake {
-	f := &fake{
+func New(clients clients.Interface, resync time.Duration, objs ...runtime.Object) *Informers {
+	f := &Informers{
 		Interface: informers.New(clients, resync),
 		cache:     make(map[reflect.Type]cache.Indexer),
 	}
 
-	f.addIndexer(&v1alpha2.Component{}, f.Components().Informer().GetIndexer())
-	f.addIndexer(&corev1.Service{}, f.Services().Informer().GetIndexer())
+	// K8s informers
+	f.addIndexer(&corev1.ConfigMap{}, f.ConfigMaps().Informer().GetIndexer())
 	f.addIndexer(&appsv1.Deployment{}, f.Deployments().Informer().GetIndexer())
+	f.addIndexer(&autoscalingv2.HorizontalPodAutoscaler{}, f.HorizontalPodAutoscalers().Informer().GetIndexer())
+	f.addIndexer(&batchv1.Job{}, f.Jobs().Informer().GetIndexer())
+	f.addIndexer(&networkingv1.NetworkPolicy{}, f.NetworkPolicies().Informer().GetIndexer())
+	f.addIndexer(&corev1.PersistentVolumeClaim{}, f.PersistentVolumeClaims().Informer().GetIndexer())
+	f.addIndexer(&corev1.Secret{}, f.Secrets().Informer().GetIndexer())
+	f.addIndexer(&corev1.Service{}, f.Services().Informer().GetIndexer())
+	f.addIndexer(&appsv1.StatefulSet{}, f.StatefulSets().Informer().GetIndexer())
+	f.addIndexer(&appsv1.ControllerRevision{}, f.ControllerRevisions().Informer().GetIndexer())
+	f.addIndexer(&k8snetworkingv1.Ingress{}, f.Ingresses().Informer().GetIndexer())
+	f.addIndexer(&extensionsv1beta1.Ingress{}, f.LegacyIngresses().Informer().GetIndexer())
+
+	// Istio informers
+	f.addIndexer(&istionetworkingv1alpha3.DestinationRule{}, f.IstioDestinationRules().Informer().GetIndexer())
+	f.addIndexer(&istionetworkingv1alpha3.EnvoyFilter{}, f.IstioEnvoyFilters().Informer().GetIndexer())
+	f.addIndexer(&istionetworkingv1alpha3.Gateway{}, f.IstioGateways().Informer().GetIndexer())
+	f.addIndexer(&istionetworkingv1alpha3.VirtualService{}, f.IstioVirtualServices().Informer().GetIndexer())
+	f.addIndexer(&istiosecurityv1beta1.PeerAuthentication{}, f.IstioPeerAuthentications().Informer().GetIndexer())
+	f.addIndexer(&istiosecurityv1beta1.RequestAuthentication{}, f.IstioRequestAuthentications().Informer().GetIndexer())
+	f.addIndexer(&istiosecurityv1beta1.AuthorizationPolicy{}, f.IstioAuthorizationPolicies().Informer().GetIndexer())
+	f.addIndexer(&istioauthenticationv1alpha1.Policy{}, f.IstioPolicies().Informer().GetIndexer())
+
+	// Kubernetes Gateway API informers
+	f.addIndexer(&gatewayapiv1.HTTPRoute{}, f.GatewayAPIHTTPRoutes().Informer().GetIndexer())
+	f.addIndexer(&gatewayapiv1.GRPCRoute{}, f.GatewayAPIGRPCRoutes().Informer().GetIndexer())
+	f.addIndexer(&gatewayapiv1alpha2.TCPRoute{}, f.GatewayAPITCPRoutes().Informer().GetIndexer())
+
+	// cert-manager informers
+	f.addIndexer(&certmanagerv1.Certificate{}, f.CertManagerCertificates().Informer().GetIndexer())
+
+	// Knative serving informers
+	f.addIndexer(&knativeservingv1.Service{}, f.KnativeServingServices().Informer().GetIndexer())
+
+	// KEDA informers
+	f.addIndexer(&kedav1alpha1.ScaledObject{}, f.KedaScaledObjects().Informer().GetIndexer())
+	f.addIndexer(&kedav1alpha1.TriggerAuthentication{}, f.KedaTriggerAuthentications().Informer().GetIndexer())
+
+	// Cellery mesh informers
+	f.addIndexer(&v1alpha2.AutoscaleOverride{}, f.AutoscaleOverrides().Informer().GetIndexer())
+	f.addIndexer(&v1alpha2.Cell{}, f.Cells().Informer().GetIndexer())
+	f.addIndexer(&v1alpha2.Component{}, f.Components().Informer().GetIndexer())
+	f.addIndexer(&v1alpha2.Composite{}, f.Composites().Informer().GetIndexer())
+	f.addIndexer(&v1alpha2.Gateway{}, f.Gateways().Informer().GetIndexer())
+	f.addIndexer(&v1alpha2.TokenService{}, f.TokenServices().Informer().GetIndexer())
 
 	for _, obj := range objs {
 		t := reflect.TypeOf(obj).Elem()
@@ -59,6 +117,6 @@ func New(clients clients.Interface, resync time.Duration, objs ...runtime.Object
 	return f
 }
 
-func (f *fake) addIndexer(obj runtime.Object, indexer cache.Indexer) {
+func (f *Informers) addIndexer(obj runtime.Object, indexer cache.Indexer) {
 	f.cache[reflect.TypeOf(obj).Elem()] = indexer
 }
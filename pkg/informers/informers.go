@@ -23,46 +23,88 @@ import (
 	"reflect"
 	"time"
 
+	appsv1api "k8s.io/api/apps/v1"
+	batchv1api "k8s.io/api/batch/v1"
+	corev1api "k8s.io/api/core/v1"
+	extensionsv1beta1api "k8s.io/api/extensions/v1beta1"
+	networkingv1api "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	kubeinformers "k8s.io/client-go/informers"
 	appsv1 "k8s.io/client-go/informers/apps/v1"
-	autoscalingv2beta1 "k8s.io/client-go/informers/autoscaling/v2beta1"
 	batchv1 "k8s.io/client-go/informers/batch/v1"
 	corev1 "k8s.io/client-go/informers/core/v1"
 	extensionsv1beta1 "k8s.io/client-go/informers/extensions/v1beta1"
 	networkingv1 "k8s.io/client-go/informers/networking/v1"
-
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	autoscalingv2api "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
+	certmanagerv1api "cellery.io/cellery-controller/pkg/apis/certmanager/v1"
+	gatewayapiv1api "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1"
+	gatewayapiv1alpha2api "cellery.io/cellery-controller/pkg/apis/gatewayapi/v1alpha2"
+	istioauthenticationv1alpha1api "cellery.io/cellery-controller/pkg/apis/istio/authentication/v1alpha1"
+	istionetworkingv1alpha3api "cellery.io/cellery-controller/pkg/apis/istio/networking/v1alpha3"
+	istiosecurityv1beta1api "cellery.io/cellery-controller/pkg/apis/istio/security/v1beta1"
+	k8snetworkingv1api "cellery.io/cellery-controller/pkg/apis/k8snetworking/v1"
+	kedav1alpha1api "cellery.io/cellery-controller/pkg/apis/keda/v1alpha1"
+	knativeservingv1api "cellery.io/cellery-controller/pkg/apis/knative/serving/v1"
+	meshv1alpha2api "cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/clients"
+	meshclient "cellery.io/cellery-controller/pkg/generated/clientset/versioned"
 	meshinformers "cellery.io/cellery-controller/pkg/generated/informers/externalversions"
 	istioauthenticationv1alpha1 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/authentication/v1alpha1"
+	autoscalingv2 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/autoscaling/v2"
+	certmanagerv1 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/certmanager/v1"
+	gatewayapiv1 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/gatewayapi/v1"
+	gatewayapiv1alpha2 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/gatewayapi/v1alpha2"
+	k8snetworkingv1 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/k8snetworking/v1"
+	kedav1alpha1 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/keda/v1alpha1"
 	meshv1alpha2 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/mesh/v1alpha2"
 	istionetworkv1alpha3 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/networking/v1alpha3"
-	knativeservingv1alpha1 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/serving/v1alpha1"
+	istiosecurityv1beta1 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/security/v1beta1"
+	knativeservingv1 "cellery.io/cellery-controller/pkg/generated/informers/externalversions/serving/v1"
 )
 
 type Interface interface {
 	// K8s informers
 	ConfigMaps() corev1.ConfigMapInformer
+	ControllerRevisions() appsv1.ControllerRevisionInformer
 	Deployments() appsv1.DeploymentInformer
-	HorizontalPodAutoscalers() autoscalingv2beta1.HorizontalPodAutoscalerInformer
+	HorizontalPodAutoscalers() autoscalingv2.HorizontalPodAutoscalerInformer
 	Jobs() batchv1.JobInformer
 	NetworkPolicies() networkingv1.NetworkPolicyInformer
 	PersistentVolumeClaims() corev1.PersistentVolumeClaimInformer
 	Secrets() corev1.SecretInformer
 	Services() corev1.ServiceInformer
 	StatefulSets() appsv1.StatefulSetInformer
-	Ingresses() extensionsv1beta1.IngressInformer
+	Ingresses() k8snetworkingv1.IngressInformer
+	LegacyIngresses() extensionsv1beta1.IngressInformer
 
 	// Istio informers
 	IstioDestinationRules() istionetworkv1alpha3.DestinationRuleInformer
 	IstioEnvoyFilters() istionetworkv1alpha3.EnvoyFilterInformer
 	IstioGateways() istionetworkv1alpha3.GatewayInformer
 	IstioVirtualServices() istionetworkv1alpha3.VirtualServiceInformer
-	IstioPolicy() istioauthenticationv1alpha1.PolicyInformer
+	IstioPeerAuthentications() istiosecurityv1beta1.PeerAuthenticationInformer
+	IstioRequestAuthentications() istiosecurityv1beta1.RequestAuthenticationInformer
+	IstioAuthorizationPolicies() istiosecurityv1beta1.AuthorizationPolicyInformer
+	IstioPolicies() istioauthenticationv1alpha1.PolicyInformer
+
+	// Kubernetes Gateway API informers
+	GatewayAPIHTTPRoutes() gatewayapiv1.HTTPRouteInformer
+	GatewayAPIGRPCRoutes() gatewayapiv1.GRPCRouteInformer
+	GatewayAPITCPRoutes() gatewayapiv1alpha2.TCPRouteInformer
+	CertManagerCertificates() certmanagerv1.CertificateInformer
 
 	// Knative serving informers
-	KnativeServingConfigurations() knativeservingv1alpha1.ConfigurationInformer
+	KnativeServingServices() knativeservingv1.ServiceInformer
+
+	// KEDA informers
+	KedaScaledObjects() kedav1alpha1.ScaledObjectInformer
+	KedaTriggerAuthentications() kedav1alpha1.TriggerAuthenticationInformer
 
 	// Cellery mesh informers
+	AutoscaleOverrides() meshv1alpha2.AutoscaleOverrideInformer
 	Cells() meshv1alpha2.CellInformer
 	Components() meshv1alpha2.ComponentInformer
 	Composites() meshv1alpha2.CompositeInformer
@@ -82,6 +124,160 @@ func New(clients clients.Interface, resync time.Duration) *informers {
 	}
 }
 
+// NewWithOptions creates informers which only watch the objects selected by the options.
+func NewWithOptions(c clients.Interface, resync time.Duration, opts Options) (*informers, error) {
+	if opts.IsEmpty() {
+		return New(c, resync), nil
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	namespaces, err := opts.ResolveNamespaces(c.Kubernetes())
+	if err != nil {
+		return nil, err
+	}
+	i := New(c, resync)
+
+	kubeClient := c.Kubernetes()
+	for _, r := range []struct {
+		obj      runtime.Object
+		client   cache.Getter
+		resource string
+		selector string
+	}{
+		{&corev1api.ConfigMap{}, kubeClient.CoreV1().RESTClient(), "configmaps", opts.ConfigMapSelector},
+		{&corev1api.PersistentVolumeClaim{}, kubeClient.CoreV1().RESTClient(), "persistentvolumeclaims", ""},
+		{&corev1api.Secret{}, kubeClient.CoreV1().RESTClient(), "secrets", opts.SecretSelector},
+		{&corev1api.Service{}, kubeClient.CoreV1().RESTClient(), "services", ""},
+		{&appsv1api.Deployment{}, kubeClient.AppsV1().RESTClient(), "deployments", ""},
+		{&appsv1api.StatefulSet{}, kubeClient.AppsV1().RESTClient(), "statefulsets", ""},
+		{&appsv1api.ControllerRevision{}, kubeClient.AppsV1().RESTClient(), "controllerrevisions", ""},
+		{&batchv1api.Job{}, kubeClient.BatchV1().RESTClient(), "jobs", ""},
+		{&networkingv1api.NetworkPolicy{}, kubeClient.NetworkingV1().RESTClient(), "networkpolicies", ""},
+	} {
+		lw := newListWatch(r.client, r.resource, namespaces, withLabelSelector(r.selector))
+		obj := r.obj
+		i.kubeInformerFactory.InformerFor(obj, func(_ kubernetes.Interface, resync time.Duration) cache.SharedIndexInformer {
+			return newSharedIndexInformer(lw, obj, resync)
+		})
+	}
+
+	meshClient := c.Mesh()
+	for _, r := range []struct {
+		obj      runtime.Object
+		client   cache.Getter
+		resource string
+	}{
+		{&autoscalingv2api.HorizontalPodAutoscaler{}, meshClient.AutoscalingV2().RESTClient(), "horizontalpodautoscalers"},
+		{&istionetworkingv1alpha3api.DestinationRule{}, meshClient.NetworkingV1alpha3().RESTClient(), "destinationrules"},
+		{&istionetworkingv1alpha3api.EnvoyFilter{}, meshClient.NetworkingV1alpha3().RESTClient(), "envoyfilters"},
+		{&istionetworkingv1alpha3api.Gateway{}, meshClient.NetworkingV1alpha3().RESTClient(), "gateways"},
+		{&istionetworkingv1alpha3api.VirtualService{}, meshClient.NetworkingV1alpha3().RESTClient(), "virtualservices"},
+		{&knativeservingv1api.Service{}, meshClient.ServingV1().RESTClient(), "services"},
+		{&meshv1alpha2api.AutoscaleOverride{}, meshClient.MeshV1alpha2().RESTClient(), "autoscaleoverrides"},
+		{&meshv1alpha2api.Cell{}, meshClient.MeshV1alpha2().RESTClient(), "cells"},
+		{&meshv1alpha2api.Component{}, meshClient.MeshV1alpha2().RESTClient(), "components"},
+		{&meshv1alpha2api.Composite{}, meshClient.MeshV1alpha2().RESTClient(), "composites"},
+		{&meshv1alpha2api.Gateway{}, meshClient.MeshV1alpha2().RESTClient(), "gateways"},
+		{&meshv1alpha2api.TokenService{}, meshClient.MeshV1alpha2().RESTClient(), "tokenservices"},
+	} {
+		lw := newListWatch(r.client, r.resource, namespaces, nil)
+		obj := r.obj
+		i.meshInformerFactory.InformerFor(obj, func(_ meshclient.Interface, resync time.Duration) cache.SharedIndexInformer {
+			return newSharedIndexInformer(lw, obj, resync)
+		})
+	}
+
+	// Every registered informer is started, hence only the Ingress API served by the cluster is registered.
+	if clients.IngressV1Available(c) {
+		lw := newListWatch(meshClient.K8sNetworkingV1().RESTClient(), "ingresses", namespaces, nil)
+		i.meshInformerFactory.InformerFor(&k8snetworkingv1api.Ingress{}, func(_ meshclient.Interface, resync time.Duration) cache.SharedIndexInformer {
+			return newSharedIndexInformer(lw, &k8snetworkingv1api.Ingress{}, resync)
+		})
+	} else {
+		lw := newListWatch(kubeClient.ExtensionsV1beta1().RESTClient(), "ingresses", namespaces, nil)
+		i.kubeInformerFactory.InformerFor(&extensionsv1beta1api.Ingress{}, func(_ kubernetes.Interface, resync time.Duration) cache.SharedIndexInformer {
+			return newSharedIndexInformer(lw, &extensionsv1beta1api.Ingress{}, resync)
+		})
+	}
+
+	// The Gateway API is installed with CRDs, hence only the routes served by the cluster are registered.
+	routes := clients.ServedGatewayAPIRoutes(c)
+	for _, r := range []struct {
+		served   bool
+		obj      runtime.Object
+		client   cache.Getter
+		resource string
+	}{
+		{routes.HTTPRoutes, &gatewayapiv1api.HTTPRoute{}, meshClient.GatewayAPIV1().RESTClient(), "httproutes"},
+		{routes.GRPCRoutes, &gatewayapiv1api.GRPCRoute{}, meshClient.GatewayAPIV1().RESTClient(), "grpcroutes"},
+		{routes.TCPRoutes, &gatewayapiv1alpha2api.TCPRoute{}, meshClient.GatewayAPIV1alpha2().RESTClient(), "tcproutes"},
+	} {
+		if !r.served {
+			continue
+		}
+		lw := newListWatch(r.client, r.resource, namespaces, nil)
+		obj := r.obj
+		i.meshInformerFactory.InformerFor(obj, func(_ meshclient.Interface, resync time.Duration) cache.SharedIndexInformer {
+			return newSharedIndexInformer(lw, obj, resync)
+		})
+	}
+
+	if clients.CertificatesAvailable(c) {
+		lw := newListWatch(meshClient.CertmanagerV1().RESTClient(), "certificates", namespaces, nil)
+		i.meshInformerFactory.InformerFor(&certmanagerv1api.Certificate{}, func(_ meshclient.Interface, resync time.Duration) cache.SharedIndexInformer {
+			return newSharedIndexInformer(lw, &certmanagerv1api.Certificate{}, resync)
+		})
+	}
+
+	// The security.istio.io API is only served from istio 1.5 onwards.
+	if clients.IstioSecurityAvailable(c) {
+		for _, r := range []struct {
+			obj      runtime.Object
+			resource string
+		}{
+			{&istiosecurityv1beta1api.PeerAuthentication{}, "peerauthentications"},
+			{&istiosecurityv1beta1api.RequestAuthentication{}, "requestauthentications"},
+			{&istiosecurityv1beta1api.AuthorizationPolicy{}, "authorizationpolicies"},
+		} {
+			lw := newListWatch(meshClient.SecurityV1beta1().RESTClient(), r.resource, namespaces, nil)
+			obj := r.obj
+			i.meshInformerFactory.InformerFor(obj, func(_ meshclient.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return newSharedIndexInformer(lw, obj, resync)
+			})
+		}
+	}
+
+	// The authentication.istio.io API is only served prior to istio 1.6.
+	if clients.IstioAuthenticationAvailable(c) {
+		lw := newListWatch(meshClient.AuthenticationV1alpha1().RESTClient(), "policies", namespaces, nil)
+		i.meshInformerFactory.InformerFor(&istioauthenticationv1alpha1api.Policy{}, func(_ meshclient.Interface, resync time.Duration) cache.SharedIndexInformer {
+			return newSharedIndexInformer(lw, &istioauthenticationv1alpha1api.Policy{}, resync)
+		})
+	}
+
+	if clients.KedaAvailable(c) {
+		for _, r := range []struct {
+			obj      runtime.Object
+			resource string
+		}{
+			{&kedav1alpha1api.ScaledObject{}, "scaledobjects"},
+			{&kedav1alpha1api.TriggerAuthentication{}, "triggerauthentications"},
+		} {
+			lw := newListWatch(meshClient.KedaV1alpha1().RESTClient(), r.resource, namespaces, nil)
+			obj := r.obj
+			i.meshInformerFactory.InformerFor(obj, func(_ meshclient.Interface, resync time.Duration) cache.SharedIndexInformer {
+				return newSharedIndexInformer(lw, obj, resync)
+			})
+		}
+	}
+	return i, nil
+}
+
+func newSharedIndexInformer(lw cache.ListerWatcher, obj runtime.Object, resync time.Duration) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(lw, obj, resync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+}
+
 func (i *informers) Start(stopCh <-chan struct{}) error {
 	i.kubeInformerFactory.Start(stopCh)
 	i.meshInformerFactory.Start(stopCh)
@@ -101,12 +297,16 @@ func (i *informers) ConfigMaps() corev1.ConfigMapInformer {
 	return i.kubeInformerFactory.Core().V1().ConfigMaps()
 }
 
+func (i *informers) ControllerRevisions() appsv1.ControllerRevisionInformer {
+	return i.kubeInformerFactory.Apps().V1().ControllerRevisions()
+}
+
 func (i *informers) Deployments() appsv1.DeploymentInformer {
 	return i.kubeInformerFactory.Apps().V1().Deployments()
 }
 
-func (i *informers) HorizontalPodAutoscalers() autoscalingv2beta1.HorizontalPodAutoscalerInformer {
-	return i.kubeInformerFactory.Autoscaling().V2beta1().HorizontalPodAutoscalers()
+func (i *informers) HorizontalPodAutoscalers() autoscalingv2.HorizontalPodAutoscalerInformer {
+	return i.meshInformerFactory.Autoscaling().V2().HorizontalPodAutoscalers()
 }
 
 func (i *informers) Jobs() batchv1.JobInformer {
@@ -133,7 +333,11 @@ func (i *informers) StatefulSets() appsv1.StatefulSetInformer {
 	return i.kubeInformerFactory.Apps().V1().StatefulSets()
 }
 
-func (i *informers) Ingresses() extensionsv1beta1.IngressInformer {
+func (i *informers) Ingresses() k8snetworkingv1.IngressInformer {
+	return i.meshInformerFactory.K8sNetworking().V1().Ingresses()
+}
+
+func (i *informers) LegacyIngresses() extensionsv1beta1.IngressInformer {
 	return i.kubeInformerFactory.Extensions().V1beta1().Ingresses()
 }
 
@@ -153,12 +357,52 @@ func (i *informers) IstioVirtualServices() istionetworkv1alpha3.VirtualServiceIn
 	return i.meshInformerFactory.Networking().V1alpha3().VirtualServices()
 }
 
-func (i *informers) IstioPolicy() istioauthenticationv1alpha1.PolicyInformer {
+func (i *informers) IstioPeerAuthentications() istiosecurityv1beta1.PeerAuthenticationInformer {
+	return i.meshInformerFactory.Security().V1beta1().PeerAuthentications()
+}
+
+func (i *informers) IstioRequestAuthentications() istiosecurityv1beta1.RequestAuthenticationInformer {
+	return i.meshInformerFactory.Security().V1beta1().RequestAuthentications()
+}
+
+func (i *informers) IstioAuthorizationPolicies() istiosecurityv1beta1.AuthorizationPolicyInformer {
+	return i.meshInformerFactory.Security().V1beta1().AuthorizationPolicies()
+}
+
+func (i *informers) IstioPolicies() istioauthenticationv1alpha1.PolicyInformer {
 	return i.meshInformerFactory.Authentication().V1alpha1().Policies()
 }
 
-func (i *informers) KnativeServingConfigurations() knativeservingv1alpha1.ConfigurationInformer {
-	return i.meshInformerFactory.Serving().V1alpha1().Configurations()
+func (i *informers) GatewayAPIHTTPRoutes() gatewayapiv1.HTTPRouteInformer {
+	return i.meshInformerFactory.GatewayAPI().V1().HTTPRoutes()
+}
+
+func (i *informers) GatewayAPIGRPCRoutes() gatewayapiv1.GRPCRouteInformer {
+	return i.meshInformerFactory.GatewayAPI().V1().GRPCRoutes()
+}
+
+func (i *informers) GatewayAPITCPRoutes() gatewayapiv1alpha2.TCPRouteInformer {
+	return i.meshInformerFactory.GatewayAPI().V1alpha2().TCPRoutes()
+}
+
+func (i *informers) CertManagerCertificates() certmanagerv1.CertificateInformer {
+	return i.meshInformerFactory.Certmanager().V1().Certificates()
+}
+
+func (i *informers) KnativeServingServices() knativeservingv1.ServiceInformer {
+	return i.meshInformerFactory.Serving().V1().Services()
+}
+
+func (i *informers) KedaScaledObjects() kedav1alpha1.ScaledObjectInformer {
+	return i.meshInformerFactory.Keda().V1alpha1().ScaledObjects()
+}
+
+func (i *informers) KedaTriggerAuthentications() kedav1alpha1.TriggerAuthenticationInformer {
+	return i.meshInformerFactory.Keda().V1alpha1().TriggerAuthentications()
+}
+
+func (i *informers) AutoscaleOverrides() meshv1alpha2.AutoscaleOverrideInformer {
+	return i.meshInformerFactory.Mesh().V1alpha2().AutoscaleOverrides()
 }
 
 func (i *informers) Cells() meshv1alpha2.CellInformer {
@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package informers
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// newListWatch creates a ListerWatcher for the resource in the given namespaces. Objects in all
+// namespaces are listed when the namespace list is empty.
+func newListWatch(c cache.Getter, resource string, namespaces []string, optionsModifier func(options *metav1.ListOptions)) cache.ListerWatcher {
+	if optionsModifier == nil {
+		optionsModifier = func(options *metav1.ListOptions) {}
+	}
+	switch len(namespaces) {
+	case 0:
+		return cache.NewFilteredListWatchFromClient(c, resource, metav1.NamespaceAll, optionsModifier)
+	case 1:
+		return cache.NewFilteredListWatchFromClient(c, resource, namespaces[0], optionsModifier)
+	}
+	lw := &multiNamespaceListWatch{
+		listWatchers:     make(map[string]cache.ListerWatcher, len(namespaces)),
+		resourceVersions: make(map[string]string, len(namespaces)),
+	}
+	for _, ns := range namespaces {
+		lw.listWatchers[ns] = cache.NewFilteredListWatchFromClient(c, resource, ns, optionsModifier)
+	}
+	return lw
+}
+
+// multiNamespaceListWatch lists and watches a resource in a fixed set of namespaces so that the
+// controller does not require cluster wide list/watch permissions. Since each namespace has its
+// own list resource version, the last seen resource version of every namespace is tracked here
+// and used when the reflector restarts the watch.
+type multiNamespaceListWatch struct {
+	listWatchers map[string]cache.ListerWatcher
+
+	mutex            sync.Mutex
+	resourceVersions map[string]string
+}
+
+// List merges the items of each namespace into a new list object. The continue token and the
+// remaining item count of a namespace list cannot be used with the merged list, so only the highest
+// resource version is carried over.
+func (lw *multiNamespaceListWatch) List(options metav1.ListOptions) (runtime.Object, error) {
+	if len(lw.listWatchers) == 0 {
+		return nil, fmt.Errorf("no namespaces to list")
+	}
+	var list runtime.Object
+	var items []runtime.Object
+	var maxResourceVersion uint64
+	resourceVersions := make(map[string]string, len(lw.listWatchers))
+	for ns, nsLw := range lw.listWatchers {
+		nsList, err := nsLw.List(options)
+		if err != nil {
+			return nil, err
+		}
+		nsItems, err := meta.ExtractList(nsList)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, nsItems...)
+		listMeta, err := meta.ListAccessor(nsList)
+		if err != nil {
+			return nil, err
+		}
+		resourceVersions[ns] = listMeta.GetResourceVersion()
+		if rv, err := strconv.ParseUint(listMeta.GetResourceVersion(), 10, 64); err == nil && rv > maxResourceVersion {
+			maxResourceVersion = rv
+		}
+		if list == nil {
+			list = reflect.New(reflect.TypeOf(nsList).Elem()).Interface().(runtime.Object)
+			list.GetObjectKind().SetGroupVersionKind(nsList.GetObjectKind().GroupVersionKind())
+		}
+	}
+	if err := meta.SetList(list, items); err != nil {
+		return nil, err
+	}
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil {
+		return nil, err
+	}
+	listMeta.SetResourceVersion(strconv.FormatUint(maxResourceVersion, 10))
+
+	lw.mutex.Lock()
+	lw.resourceVersions = resourceVersions
+	lw.mutex.Unlock()
+	return list, nil
+}
+
+func (lw *multiNamespaceListWatch) Watch(options metav1.ListOptions) (watch.Interface, error) {
+	if len(lw.listWatchers) == 0 {
+		return nil, fmt.Errorf("no namespaces to watch")
+	}
+	mw := &multiNamespaceWatch{
+		result: make(chan watch.Event),
+		stopCh: make(chan struct{}),
+	}
+	namespaces := make([]string, 0, len(lw.listWatchers))
+	for ns, nsLw := range lw.listWatchers {
+		nsOptions := options
+		lw.mutex.Lock()
+		if rv, ok := lw.resourceVersions[ns]; ok {
+			nsOptions.ResourceVersion = rv
+		}
+		lw.mutex.Unlock()
+		w, err := nsLw.Watch(nsOptions)
+		if err != nil {
+			mw.Stop()
+			return nil, err
+		}
+		mw.watchers = append(mw.watchers, w)
+		namespaces = append(namespaces, ns)
+	}
+	for i := range mw.watchers {
+		ns := namespaces[i]
+		mw.wg.Add(1)
+		go mw.forward(mw.watchers[i], func(obj runtime.Object) {
+			lw.recordResourceVersion(ns, obj)
+		})
+	}
+	go func() {
+		mw.wg.Wait()
+		close(mw.result)
+	}()
+	return mw, nil
+}
+
+func (lw *multiNamespaceListWatch) recordResourceVersion(namespace string, obj runtime.Object) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	lw.mutex.Lock()
+	defer lw.mutex.Unlock()
+	lw.resourceVersions[namespace] = accessor.GetResourceVersion()
+}
+
+// multiNamespaceWatch merges the watch events of each namespace into a single channel. The merged
+// watch is closed as soon as one of the underlying watches is closed, which makes the reflector
+// restart all of them.
+type multiNamespaceWatch struct {
+	watchers []watch.Interface
+	result   chan watch.Event
+	wg       sync.WaitGroup
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+func (mw *multiNamespaceWatch) forward(w watch.Interface, record func(obj runtime.Object)) {
+	defer mw.wg.Done()
+	defer mw.Stop()
+	for {
+		select {
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return
+			}
+			if event.Type != watch.Error {
+				record(event.Object)
+			}
+			select {
+			case mw.result <- event:
+			case <-mw.stopCh:
+				return
+			}
+		case <-mw.stopCh:
+			return
+		}
+	}
+}
+
+func (mw *multiNamespaceWatch) Stop() {
+	mw.stopOnce.Do(func() {
+		close(mw.stopCh)
+		for _, w := range mw.watchers {
+			w.Stop()
+		}
+	})
+}
+
+func (mw *multiNamespaceWatch) ResultChan() <-chan watch.Event {
+	return mw.result
+}
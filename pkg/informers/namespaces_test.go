@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package informers
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+type fakeNamespace struct {
+	list    *corev1.ConfigMapList
+	watcher *watch.FakeWatcher
+	watchRv string
+}
+
+// listWatch returns the fake namespace itself rather than a cache.ListWatch, which would follow
+// the continue token of the list and page forever.
+func (f *fakeNamespace) listWatch() cache.ListerWatcher {
+	return f
+}
+
+func (f *fakeNamespace) List(options metav1.ListOptions) (runtime.Object, error) {
+	return f.list.DeepCopy(), nil
+}
+
+func (f *fakeNamespace) Watch(options metav1.ListOptions) (watch.Interface, error) {
+	f.watchRv = options.ResourceVersion
+	f.watcher = watch.NewFake()
+	return f.watcher, nil
+}
+
+func configMap(namespace, name, resourceVersion string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       namespace,
+			Name:            name,
+			ResourceVersion: resourceVersion,
+		},
+	}
+}
+
+func newFakeNamespace(resourceVersion string, items ...corev1.ConfigMap) *fakeNamespace {
+	return &fakeNamespace{
+		list: &corev1.ConfigMapList{
+			ListMeta: metav1.ListMeta{ResourceVersion: resourceVersion},
+			Items:    items,
+		},
+	}
+}
+
+func TestMultiNamespaceListWatch(t *testing.T) {
+	nsA := newFakeNamespace("10", *configMap("a", "foo", "5"))
+	nsB := newFakeNamespace("20", *configMap("b", "bar", "15"), *configMap("b", "baz", "18"))
+	remaining := int64(3)
+	nsA.list.Continue = "a-continue"
+	nsA.list.RemainingItemCount = &remaining
+	nsB.list.Continue = "b-continue"
+	nsB.list.RemainingItemCount = &remaining
+	lw := &multiNamespaceListWatch{
+		listWatchers: map[string]cache.ListerWatcher{
+			"a": nsA.listWatch(),
+			"b": nsB.listWatch(),
+		},
+		resourceVersions: make(map[string]string),
+	}
+
+	list, err := lw.List(metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("List() returned an error: %v", err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		t.Fatalf("cannot extract list items: %v", err)
+	}
+	var keys []string
+	for _, item := range items {
+		key, _ := cache.MetaNamespaceKeyFunc(item)
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if diff := cmp.Diff([]string{"a/foo", "b/bar", "b/baz"}, keys); diff != "" {
+		t.Errorf("List() items (-want, +got) = %v", diff)
+	}
+	listMeta, _ := meta.ListAccessor(list)
+	if got := listMeta.GetResourceVersion(); got != "20" {
+		t.Errorf("List() resource version = %q, want %q", got, "20")
+	}
+	if got := listMeta.GetContinue(); got != "" {
+		t.Errorf("List() continue = %q, want empty", got)
+	}
+	if got := listMeta.GetRemainingItemCount(); got != nil {
+		t.Errorf("List() remaining item count = %d, want nil", *got)
+	}
+	if len(nsA.list.Items) != 1 || len(nsB.list.Items) != 2 {
+		t.Errorf("List() modified the namespace lists")
+	}
+
+	w, err := lw.Watch(metav1.ListOptions{ResourceVersion: "20"})
+	if err != nil {
+		t.Fatalf("Watch() returned an error: %v", err)
+	}
+	if nsA.watchRv != "10" || nsB.watchRv != "20" {
+		t.Errorf("Watch() started with resource versions %q and %q, want %q and %q", nsA.watchRv, nsB.watchRv, "10", "20")
+	}
+
+	nsA.watcher.Add(configMap("a", "qux", "25"))
+	event := <-w.ResultChan()
+	if got := event.Object.(*corev1.ConfigMap).Name; event.Type != watch.Added || got != "qux" {
+		t.Errorf("Watch() event = %s %q, want %s %q", event.Type, got, watch.Added, "qux")
+	}
+
+	// Closing a single namespace watch should close the merged watch
+	nsB.watcher.Stop()
+	for range w.ResultChan() {
+	}
+
+	if _, err := lw.Watch(metav1.ListOptions{ResourceVersion: "25"}); err != nil {
+		t.Fatalf("Watch() returned an error: %v", err)
+	}
+	if nsA.watchRv != "25" || nsB.watchRv != "20" {
+		t.Errorf("Watch() restarted with resource versions %q and %q, want %q and %q", nsA.watchRv, nsB.watchRv, "25", "20")
+	}
+}
+
+func TestMultiNamespaceListWatchWithoutNamespaces(t *testing.T) {
+	lw := &multiNamespaceListWatch{
+		listWatchers:     map[string]cache.ListerWatcher{},
+		resourceVersions: make(map[string]string),
+	}
+	if _, err := lw.List(metav1.ListOptions{}); err == nil {
+		t.Errorf("List() returned no error without namespaces")
+	}
+	if _, err := lw.Watch(metav1.ListOptions{}); err == nil {
+		t.Errorf("Watch() returned no error without namespaces")
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package informers
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Options restricts the set of objects watched by the informers.
+type Options struct {
+	// Namespaces limits the informers to the given namespaces. All namespaces are
+	// watched when both Namespaces and NamespaceSelector are empty.
+	Namespaces []string
+	// NamespaceSelector selects the watched namespaces by their labels. The namespaces are
+	// resolved once at startup, so the controller has to be restarted to pick up new ones.
+	NamespaceSelector string
+	// SecretSelector limits the watched Secrets to the ones matching the label selector.
+	SecretSelector string
+	// ConfigMapSelector limits the watched ConfigMaps to the ones matching the label selector.
+	ConfigMapSelector string
+}
+
+func (o *Options) IsEmpty() bool {
+	return len(o.Namespaces) == 0 && len(o.NamespaceSelector) == 0 &&
+		len(o.SecretSelector) == 0 && len(o.ConfigMapSelector) == 0
+}
+
+func (o *Options) Validate() error {
+	for name, selector := range map[string]string{
+		"namespace selector": o.NamespaceSelector,
+		"secret selector":    o.SecretSelector,
+		"configmap selector": o.ConfigMapSelector,
+	} {
+		if _, err := labels.Parse(selector); err != nil {
+			return fmt.Errorf("invalid %s %q: %v", name, selector, err)
+		}
+	}
+	return nil
+}
+
+// ResolveNamespaces returns the sorted list of namespaces to be watched. An empty list
+// means all the namespaces in the cluster.
+func (o *Options) ResolveNamespaces(kubeClient kubernetes.Interface) ([]string, error) {
+	set := make(map[string]bool)
+	for _, ns := range o.Namespaces {
+		if len(ns) > 0 {
+			set[ns] = true
+		}
+	}
+	if len(o.NamespaceSelector) > 0 {
+		nsList, err := kubeClient.CoreV1().Namespaces().List(metav1.ListOptions{LabelSelector: o.NamespaceSelector})
+		if err != nil {
+			return nil, fmt.Errorf("cannot list namespaces with selector %q: %v", o.NamespaceSelector, err)
+		}
+		if len(nsList.Items) == 0 && len(set) == 0 {
+			return nil, fmt.Errorf("no namespaces found with selector %q", o.NamespaceSelector)
+		}
+		for _, ns := range nsList.Items {
+			set[ns.Name] = true
+		}
+	}
+	var namespaces []string
+	for ns := range set {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
+
+func withLabelSelector(selector string) func(options *metav1.ListOptions) {
+	return func(options *metav1.ListOptions) {
+		options.LabelSelector = selector
+	}
+}
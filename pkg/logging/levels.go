@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package logging
+
+import (
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Levels holds the levels of a logger and its named loggers which can be changed at runtime.
+// The level of a named logger applies to its children as well (e.g. the level of cell-controller
+// applies to cell-controller.events) unless they have a level of their own.
+type Levels struct {
+	format string
+	root   zap.AtomicLevel
+
+	mu         sync.RWMutex
+	components map[string]zap.AtomicLevel
+}
+
+func newLevels(cfg *Config) *Levels {
+	l := &Levels{
+		format:     cfg.Format,
+		root:       zap.NewAtomicLevelAt(cfg.Level),
+		components: map[string]zap.AtomicLevel{},
+	}
+	l.Apply(cfg)
+	return l
+}
+
+// Apply sets the levels of the given configuration. Named loggers which are not configured fall back
+// to the level of the root logger.
+func (l *Levels) Apply(cfg *Config) {
+	l.root.SetLevel(cfg.Level)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for name, level := range cfg.ComponentLevels {
+		if atomicLevel, ok := l.components[name]; ok {
+			atomicLevel.SetLevel(level)
+		} else {
+			l.components[name] = zap.NewAtomicLevelAt(level)
+		}
+	}
+	for name := range l.components {
+		if _, ok := cfg.ComponentLevels[name]; !ok {
+			delete(l.components, name)
+		}
+	}
+}
+
+// Format returns the format the logger was created with. The format cannot be changed at runtime.
+func (l *Levels) Format() string {
+	return l.format
+}
+
+// Level returns the level of the named logger.
+func (l *Levels) Level(name string) zapcore.Level {
+	return l.levelFor(name).Level()
+}
+
+func (l *Levels) levelFor(name string) zap.AtomicLevel {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for len(name) > 0 {
+		if level, ok := l.components[name]; ok {
+			return level
+		}
+		i := strings.LastIndex(name, ".")
+		if i < 0 {
+			break
+		}
+		name = name[:i]
+	}
+	return l.root
+}
+
+func (l *Levels) minLevel() zapcore.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	min := l.root.Level()
+	for _, level := range l.components {
+		if level.Level() < min {
+			min = level.Level()
+		}
+	}
+	return min
+}
+
+// levelCore filters the entries by the level of the logger which wrote them.
+type levelCore struct {
+	zapcore.Core
+	levels *Levels
+}
+
+func (c *levelCore) Enabled(level zapcore.Level) bool {
+	return level >= c.levels.minLevel()
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{
+		Core:   c.Core.With(fields),
+		levels: c.levels,
+	}
+}
+
+func (c *levelCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.levels.levelFor(entry.LoggerName).Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
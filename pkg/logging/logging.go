@@ -15,22 +15,117 @@
  * specific language governing permissions and limitations
  * under the License.
  */
-
 package logging
 
 import (
+	"fmt"
+	"strings"
+
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
 )
 
+const (
+	// ConfigMapName is the name of the ConfigMap which configures the logging of the controller and the webhook
+	ConfigMapName = "config-logging"
+
+	ConfigMapKeyFormat = "log-format"
+	ConfigMapKeyLevel  = "log-level"
+	// ConfigMapKeyComponentLevelPrefix is the prefix of the keys which set the level of a named logger
+	// (e.g. log-level.cell-controller)
+	ConfigMapKeyComponentLevelPrefix = ConfigMapKeyLevel + "."
+
+	FormatJSON    = "json"
+	FormatConsole = "console"
+)
+
+// Config is the logging configuration read from the logging ConfigMap.
+type Config struct {
+	Format          string
+	Level           zapcore.Level
+	ComponentLevels map[string]zapcore.Level
+}
+
+// DefaultConfig returns the configuration used when the logging ConfigMap is not available.
+func DefaultConfig() *Config {
+	return &Config{
+		Format:          FormatJSON,
+		Level:           zapcore.InfoLevel,
+		ComponentLevels: map[string]zapcore.Level{},
+	}
+}
+
+// NewConfigFromMap parses the data of the logging ConfigMap. Missing keys take their default values.
+func NewConfigFromMap(data map[string]string) (*Config, error) {
+	cfg := DefaultConfig()
+	for k, v := range data {
+		v = strings.TrimSpace(v)
+		switch {
+		case k == ConfigMapKeyFormat:
+			if v != FormatJSON && v != FormatConsole {
+				return nil, fmt.Errorf("invalid %s %q, expected %q or %q", ConfigMapKeyFormat, v, FormatJSON, FormatConsole)
+			}
+			cfg.Format = v
+		case k == ConfigMapKeyLevel:
+			if err := cfg.Level.UnmarshalText([]byte(v)); err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %v", ConfigMapKeyLevel, v, err)
+			}
+		case strings.HasPrefix(k, ConfigMapKeyComponentLevelPrefix):
+			var level zapcore.Level
+			if err := level.UnmarshalText([]byte(v)); err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %v", k, v, err)
+			}
+			cfg.ComponentLevels[strings.TrimPrefix(k, ConfigMapKeyComponentLevelPrefix)] = level
+		}
+	}
+	return cfg, nil
+}
+
+// NewConfigFromConfigMap parses the logging ConfigMap. The default configuration is returned if the
+// ConfigMap is nil.
+func NewConfigFromConfigMap(configMap *corev1.ConfigMap) (*Config, error) {
+	if configMap == nil {
+		return DefaultConfig(), nil
+	}
+	return NewConfigFromMap(configMap.Data)
+}
+
+// NewLogger creates a logger with the default configuration.
 func NewLogger() (*zap.SugaredLogger, error) {
-	config := zap.NewDevelopmentConfig()
-	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
-	config.OutputPaths = []string{"stdout"}
-	config.DisableStacktrace = true
-	logger, err := config.Build()
+	logger, _, err := NewLoggerFromConfig(DefaultConfig())
+	return logger, err
+}
+
+// NewLoggerFromConfig creates a logger from the given configuration. The returned levels can be used to
+// change the level of the logger and its named loggers at runtime.
+func NewLoggerFromConfig(cfg *Config) (*zap.SugaredLogger, *Levels, error) {
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+
+	var encoder zapcore.Encoder
+	switch cfg.Format {
+	case FormatConsole:
+		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	default:
+		encoder = zapcore.NewJSONEncoder(encoderConfig)
+	}
+
+	sink, _, err := zap.Open("stdout")
+	if err != nil {
+		return nil, nil, err
+	}
+	errSink, _, err := zap.Open("stderr")
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	levels := newLevels(cfg)
+	// The levels are enforced by the level core, hence the underlying core accepts every level
+	core := &levelCore{
+		Core:   zapcore.NewCore(encoder, sink, zapcore.DebugLevel),
+		levels: levels,
 	}
-	return logger.Sugar(), nil
+	return zap.New(core, zap.AddCaller(), zap.ErrorOutput(errSink)).Sugar(), levels, nil
 }
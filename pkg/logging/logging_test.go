@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package logging
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNewConfigFromMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    *Config
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			data: map[string]string{},
+			want: DefaultConfig(),
+		},
+		{
+			name: "component levels",
+			data: map[string]string{
+				ConfigMapKeyFormat: FormatConsole,
+				ConfigMapKeyLevel:  "warn",
+				ConfigMapKeyComponentLevelPrefix + "cell-controller": "debug",
+			},
+			want: &Config{
+				Format: FormatConsole,
+				Level:  zapcore.WarnLevel,
+				ComponentLevels: map[string]zapcore.Level{
+					"cell-controller": zapcore.DebugLevel,
+				},
+			},
+		},
+		{
+			name:    "invalid format",
+			data:    map[string]string{ConfigMapKeyFormat: "xml"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid component level",
+			data:    map[string]string{ConfigMapKeyComponentLevelPrefix + "webhook": "verbose"},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewConfigFromMap(test.data)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("expected an error for %v", test.data)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Format != test.want.Format || got.Level != test.want.Level || len(got.ComponentLevels) != len(test.want.ComponentLevels) {
+				t.Fatalf("got %+v, want %+v", got, test.want)
+			}
+			for k, v := range test.want.ComponentLevels {
+				if got.ComponentLevels[k] != v {
+					t.Errorf("level of %q: got %v, want %v", k, got.ComponentLevels[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestComponentLevels(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ComponentLevels["cell-controller"] = zapcore.DebugLevel
+	levels := newLevels(cfg)
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := zap.New(&levelCore{Core: core, levels: levels}).Sugar()
+
+	logger.Debug("root debug")
+	logger.Named("cell-controller").Debug("cell debug")
+	logger.Named("cell-controller").Named("events").Debug("cell events debug")
+	logger.Named("gateway-controller").Debug("gateway debug")
+	logger.Named("gateway-controller").Info("gateway info")
+
+	var got []string
+	for _, entry := range logs.AllUntimed() {
+		got = append(got, entry.Message)
+	}
+	want := []string{"cell debug", "cell events debug", "gateway info"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+
+	// Removing the component level falls back to the root level
+	levels.Apply(DefaultConfig())
+	if level := levels.Level("cell-controller.events"); level != zapcore.InfoLevel {
+		t.Errorf("expected the root level, got %v", level)
+	}
+}
+
+func TestWatchConfig(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "cellery-system",
+			Name:      ConfigMapName,
+		},
+		Data: map[string]string{
+			ConfigMapKeyLevel: "info",
+		},
+	}
+	kubeClient := fake.NewSimpleClientset(configMap)
+	levels := newLevels(DefaultConfig())
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go WatchConfig(kubeClient, "cellery-system", levels, zap.NewNop().Sugar(), stopCh)
+
+	updated := configMap.DeepCopy()
+	updated.Data[ConfigMapKeyComponentLevelPrefix+"webhook"] = "debug"
+	if _, err := kubeClient.CoreV1().ConfigMaps("cellery-system").Update(updated); err != nil {
+		t.Fatalf("failed to update the ConfigMap: %v", err)
+	}
+	err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		return levels.Level("webhook") == zapcore.DebugLevel, nil
+	})
+	if err != nil {
+		t.Errorf("the level of the webhook logger was not updated")
+	}
+	if level := levels.Level("cell-controller"); level != zapcore.InfoLevel {
+		t.Errorf("expected the root level for cell-controller, got %v", level)
+	}
+}
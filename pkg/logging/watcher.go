@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package logging
+
+import (
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ConfigFromCluster reads the logging ConfigMap in the given namespace. The default configuration is
+// returned if the ConfigMap does not exist.
+func ConfigFromCluster(kubeClient kubernetes.Interface, namespace string) (*Config, error) {
+	configMap, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ConfigMapName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return DefaultConfig(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	return NewConfigFromConfigMap(configMap)
+}
+
+// NewLoggerFromCluster creates a logger configured by the logging ConfigMap in the given namespace and
+// keeps its levels in sync with the ConfigMap until the stop channel is closed.
+func NewLoggerFromCluster(kubeClient kubernetes.Interface, namespace string, stopCh <-chan struct{}) (*zap.SugaredLogger, error) {
+	cfg, err := ConfigFromCluster(kubeClient, namespace)
+	if err != nil {
+		return nil, err
+	}
+	logger, levels, err := NewLoggerFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	go WatchConfig(kubeClient, namespace, levels, logger, stopCh)
+	return logger, nil
+}
+
+// WatchConfig applies the levels of the logging ConfigMap in the given namespace whenever it changes
+// until the stop channel is closed. The default levels are restored if the ConfigMap is deleted.
+func WatchConfig(kubeClient kubernetes.Interface, namespace string, levels *Levels, logger *zap.SugaredLogger, stopCh <-chan struct{}) {
+	logger = logger.Named("logging-config")
+	selector := fields.OneTermEqualSelector("metadata.name", ConfigMapName).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = selector
+			return kubeClient.CoreV1().ConfigMaps(namespace).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = selector
+			return kubeClient.CoreV1().ConfigMaps(namespace).Watch(options)
+		},
+	}
+
+	apply := func(obj interface{}) {
+		configMap, ok := obj.(*corev1.ConfigMap)
+		if !ok || configMap.Name != ConfigMapName {
+			return
+		}
+		cfg, err := NewConfigFromConfigMap(configMap)
+		if err != nil {
+			logger.Errorf("Ignoring the invalid logging config: %v", err)
+			return
+		}
+		if cfg.Format != levels.Format() {
+			logger.Warnf("Log format changed to %q, restart to apply the change", cfg.Format)
+		}
+		levels.Apply(cfg)
+		logger.Infof("Applied the logging config with level %q", cfg.Level)
+	}
+
+	_, informer := cache.NewInformer(lw, &corev1.ConfigMap{}, 0, cache.ResourceEventHandlerFuncs{
+		AddFunc: apply,
+		UpdateFunc: func(old, new interface{}) {
+			apply(new)
+		},
+		DeleteFunc: func(obj interface{}) {
+			levels.Apply(DefaultConfig())
+			logger.Info("Logging config removed, restored the default levels")
+		},
+	})
+	informer.Run(stopCh)
+}
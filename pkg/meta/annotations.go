@@ -24,9 +24,50 @@ const (
 	// https://github.com/istio/istio/blob/master/install/kubernetes/helm/istio/templates/sidecar-injector-configmap.yaml
 	IstioSidecarInjectAnnotationKey = "sidecar.istio.io/inject"
 
+	// https://knative.dev/docs/serving/autoscaling/
+	KnativeMinScaleAnnotationKey          = "autoscaling.knative.dev/min-scale"
+	KnativeMaxScaleAnnotationKey          = "autoscaling.knative.dev/max-scale"
+	KnativeTargetUtilizationAnnotationKey = "autoscaling.knative.dev/target-utilization-percentage"
+	KnativeScaleDownDelayAnnotationKey    = "autoscaling.knative.dev/scale-down-delay"
+
 	LastAppliedHashAnnotationKey  = mesh.GroupName + "/last-applied-hash"
 	CellDependenciesAnnotationKey = mesh.GroupName + "/cell-dependencies"
 
+	// Reconciliation of an object and the objects it controls can be paused by setting this annotation to "paused"
+	ReconcileAnnotationKey = mesh.GroupName + "/reconcile"
+	ReconcilePausedValue   = "paused"
+
+	// Cells and Composites are suspended by setting this annotation to "true", which scales all the
+	// workloads they control to zero until the annotation is removed
+	SuspendedAnnotationKey = mesh.GroupName + "/suspended"
+	SuspendedValue         = "true"
+	// Replicas of a suspended workload to be restored on resume
+	SuspendedReplicasAnnotationKey = mesh.GroupName + "/suspended-replicas"
+
+	// Name of the scaling schedule applied to a workload or an autoscaler
+	ActiveScheduleAnnotationKey = mesh.GroupName + "/active-schedule"
+	// Name and generation of the AutoscaleOverride merged into an autoscaler, as name/generation
+	AutoscaleOverrideAnnotationKey = mesh.GroupName + "/autoscale-override"
+
+	// Restores the spec of a Cell or a Composite from the ControllerRevision with the given revision
+	// number. The previous revision is restored if the number is 0.
+	RollbackToAnnotationKey = mesh.GroupName + "/rollback-to"
+
+	// Policy for taking the ownership of existing resources which have the names of the resources
+	// created for an object: "never" (default), "orphans" for resources without any owner or
+	// "always" for resources without a controller. Resources controlled by another object are never adopted.
+	AdoptAnnotationKey = mesh.GroupName + "/adopt"
+	AdoptNeverValue    = "never"
+	AdoptOrphansValue  = "orphans"
+	AdoptAlwaysValue   = "always"
+
+	// Hash of the configuration a resource is built from, which is updated when the hash of the
+	// effective configuration of its namespace changes
+	ConfigHashAnnotationKey = mesh.GroupName + "/config-hash"
+
+	// W3C traceparent of the parent reconcile which last changed the object
+	TraceParentAnnotationKey = mesh.GroupName + "/traceparent"
+
 	// Original GW service for advanced routing
 	CellOriginalGatewaySvcKey        = mesh.GroupName + "/original-gw-svc"
 	CompositeOriginalComponentSvcKey = mesh.GroupName + "/original-component-svcs"
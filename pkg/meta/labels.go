@@ -24,6 +24,12 @@ const (
 	AppLabelKey     = "app"
 	VersionLabelKey = "version"
 
+	// Knative serving labels
+	KnativeVisibilityLabelKey     = "networking.knative.dev/visibility"
+	KnativeVisibilityClusterLocal = "cluster-local"
+	KnativeServiceLabelKey        = "serving.knative.dev/service"
+	KnativeRevisionLabelKey       = "serving.knative.dev/revision"
+
 	// Cellery Labels
 	CellLabelKey         = mesh.GroupName + "/cell"
 	CompositeLabelKey    = mesh.GroupName + "/composite"
@@ -34,6 +40,9 @@ const (
 
 	VolumeLabelKey = mesh.GroupName + "/volume"
 
+	// Marks a ConfigMap/Secret as the namespace level override of the global cellery configuration
+	ConfigOverrideLabelKey = mesh.GroupName + "/config-override"
+
 	// Cellery observability labels
 	ObservabilityGroupPrefix          = "observability."
 	ObservabilityInstanceLabelKey     = ObservabilityGroupPrefix + mesh.GroupName + "/instance"
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+const namespace = "cellery_controller"
+
+var (
+	// ReconcileRetries counts the failed reconciles which were requeued, labeled by the controller
+	// and the class of the error (transient or permanent).
+	ReconcileRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconcile_retries_total",
+		Help:      "Number of failed reconciles which were requeued.",
+	}, []string{"controller", "class"})
+
+	// ReconcileFailures counts the objects which exhausted their retries and were marked as failed.
+	ReconcileFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconcile_failures_total",
+		Help:      "Number of objects which exhausted their reconcile retries.",
+	}, []string{"controller"})
+)
+
+func init() {
+	prometheus.MustRegister(ReconcileRetries, ReconcileFailures)
+}
+
+// Serve exposes the registered metrics on the given address until the stop channel is closed.
+func Serve(address string, stopCh <-chan struct{}, logger *zap.SugaredLogger) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{Addr: address, Handler: mux}
+
+	go func() {
+		<-stopCh
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(ctx)
+	}()
+
+	logger.Infof("Serving metrics on %s", address)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		logger.Errorf("Failed to serve metrics: %v", err)
+	}
+}
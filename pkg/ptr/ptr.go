@@ -26,6 +26,10 @@ func Int32(i32 int32) *int32 {
 	return &i32
 }
 
+func Int64(i64 int64) *int64 {
+	return &i64
+}
+
 func String(str string) *string {
 	return &str
 }
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1
+
+import (
+	"encoding/json"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ControllerRevisionOption func(*appsv1.ControllerRevision)
+
+func ControllerRevision(name, namespace string, opt ...ControllerRevisionOption) *appsv1.ControllerRevision {
+	r := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	for _, opt := range opt {
+		opt(r)
+	}
+
+	return r
+}
+
+func WithControllerRevisionLabels(m map[string]string) ControllerRevisionOption {
+	return func(r *appsv1.ControllerRevision) {
+		r.Labels = m
+	}
+}
+
+func WithControllerRevisionOwnerReference(ownerReference metav1.OwnerReference) ControllerRevisionOption {
+	return func(r *appsv1.ControllerRevision) {
+		r.OwnerReferences = append(r.OwnerReferences, ownerReference)
+	}
+}
+
+func WithControllerRevisionNumber(revision int64) ControllerRevisionOption {
+	return func(r *appsv1.ControllerRevision) {
+		r.Revision = revision
+	}
+}
+
+// WithControllerRevisionSpec sets the data of the revision to the given spec in the format
+// written by the cell and composite controllers.
+func WithControllerRevisionSpec(spec interface{}) ControllerRevisionOption {
+	return func(r *appsv1.ControllerRevision) {
+		r.Data.Raw, _ = json.Marshal(map[string]interface{}{"spec": spec})
+	}
+}
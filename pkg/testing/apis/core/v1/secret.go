@@ -44,3 +44,9 @@ func WithSecretData(name string, data []byte) SecretOption {
 		c.Data[name] = data
 	}
 }
+
+func WithSecretOwnerReference(ownerReference metav1.OwnerReference) SecretOption {
+	return func(c *corev1.Secret) {
+		c.OwnerReferences = append(c.OwnerReferences, ownerReference)
+	}
+}
@@ -68,3 +68,12 @@ func WithServiceSelector(m map[string]string) ServiceOption {
 		s.Spec.Selector = m
 	}
 }
+
+func WithServiceLabel(key, value string) ServiceOption {
+	return func(s *corev1.Service) {
+		if s.Labels == nil {
+			s.Labels = make(map[string]string)
+		}
+		s.Labels[key] = value
+	}
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+)
+
+type AutoscaleOverrideOption func(*v1alpha2.AutoscaleOverride)
+
+func AutoscaleOverride(name, namespace, instance string, opt ...AutoscaleOverrideOption) *v1alpha2.AutoscaleOverride {
+	o := &v1alpha2.AutoscaleOverride{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha2.AutoscaleOverrideSpec{
+			Instance: instance,
+		},
+	}
+	for _, opt := range opt {
+		opt(o)
+	}
+
+	return o
+}
+
+func WithAutoscaleOverrideComponent(component string) AutoscaleOverrideOption {
+	return func(o *v1alpha2.AutoscaleOverride) {
+		o.Spec.Component = component
+	}
+}
+
+func WithAutoscaleOverrideReplicas(minReplicas, maxReplicas int32) AutoscaleOverrideOption {
+	return func(o *v1alpha2.AutoscaleOverride) {
+		o.Spec.MinReplicas = &minReplicas
+		o.Spec.MaxReplicas = maxReplicas
+	}
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	"cellery.io/cellery-controller/pkg/ptr"
+)
+
+var OwnerReferenceCellFunc = func(name string) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion:         v1alpha2.SchemeGroupVersion.String(),
+		Kind:               "Cell",
+		Name:               name,
+		Controller:         ptr.Bool(true),
+		BlockOwnerDeletion: ptr.Bool(true),
+	}
+}
+
+type CellOption func(*v1alpha2.Cell)
+
+func Cell(name, namespace string, opt ...CellOption) *v1alpha2.Cell {
+	c := &v1alpha2.Cell{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	for _, opt := range opt {
+		opt(c)
+	}
+
+	return c
+}
+
+func CellWith(cell *v1alpha2.Cell, opt ...CellOption) *v1alpha2.Cell {
+	for _, opt := range opt {
+		opt(cell)
+	}
+
+	return cell
+}
+
+func WithCellGateway(gateway *v1alpha2.Gateway) CellOption {
+	return func(c *v1alpha2.Cell) {
+		c.Spec.Gateway = *gateway
+	}
+}
+
+func WithCellComponent(component *v1alpha2.Component) CellOption {
+	return func(c *v1alpha2.Cell) {
+		c.Spec.Components = append(c.Spec.Components, *component)
+	}
+}
+
+func WithCellAnnotation(key, value string) CellOption {
+	return func(c *v1alpha2.Cell) {
+		if c.Annotations == nil {
+			c.Annotations = make(map[string]string)
+		}
+		c.Annotations[key] = value
+	}
+}
+
+func WithCellStatus(status v1alpha2.CellStatus) CellOption {
+	return func(c *v1alpha2.Cell) {
+		c.Status = status
+	}
+}
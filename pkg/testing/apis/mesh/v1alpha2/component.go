@@ -19,9 +19,12 @@
 package v1alpha2
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	autoscalingv2 "cellery.io/cellery-controller/pkg/apis/autoscaling/v2"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
 	"cellery.io/cellery-controller/pkg/ptr"
 )
@@ -104,3 +107,79 @@ func WithComponentSecret(secret *corev1.Secret) ComponentOption {
 		c.Spec.Secrets = append(c.Spec.Secrets, *secret)
 	}
 }
+
+func WithComponentStatus(status v1alpha2.ComponentStatus) ComponentOption {
+	return func(c *v1alpha2.Component) {
+		c.Status = status
+	}
+}
+
+func WithComponentAnnotation(key, value string) ComponentOption {
+	return func(c *v1alpha2.Component) {
+		if c.Annotations == nil {
+			c.Annotations = make(map[string]string)
+		}
+		c.Annotations[key] = value
+	}
+}
+
+func WithComponentHpa(minReplicas, maxReplicas int32) ComponentOption {
+	return func(c *v1alpha2.Component) {
+		c.Spec.ScalingPolicy.Hpa = &v1alpha2.HorizontalPodAutoscaler{
+			ReplicaRange: v1alpha2.ReplicaRange{
+				MinReplicas: &minReplicas,
+				MaxReplicas: maxReplicas,
+			},
+		}
+	}
+}
+
+func WithComponentHpaOverridable(overridable bool) ComponentOption {
+	return func(c *v1alpha2.Component) {
+		c.Spec.ScalingPolicy.Hpa.Overridable = &overridable
+	}
+}
+
+func WithComponentHpaBehavior(behavior *autoscalingv2.HorizontalPodAutoscalerBehavior) ComponentOption {
+	return func(c *v1alpha2.Component) {
+		c.Spec.ScalingPolicy.Hpa.Behavior = behavior
+	}
+}
+
+func WithComponentKpa(minReplicas, maxReplicas int32, concurrency int64) ComponentOption {
+	return func(c *v1alpha2.Component) {
+		c.Spec.ScalingPolicy.Kpa = &v1alpha2.KnativePodAutoscaler{
+			ReplicaRange: v1alpha2.ReplicaRange{
+				MinReplicas: &minReplicas,
+				MaxReplicas: maxReplicas,
+			},
+			Concurrency: concurrency,
+		}
+	}
+}
+
+func WithComponentEventDriven(minReplicas, maxReplicas int32, triggers ...v1alpha2.EventDrivenTrigger) ComponentOption {
+	return func(c *v1alpha2.Component) {
+		c.Spec.ScalingPolicy.EventDriven = &v1alpha2.EventDrivenAutoscaler{
+			ReplicaRange: v1alpha2.ReplicaRange{
+				MinReplicas: &minReplicas,
+				MaxReplicas: maxReplicas,
+			},
+			Triggers: triggers,
+		}
+	}
+}
+
+func WithComponentSchedule(name, schedule string, duration time.Duration, minReplicas, maxReplicas int32) ComponentOption {
+	return func(c *v1alpha2.Component) {
+		c.Spec.ScalingPolicy.Schedules = append(c.Spec.ScalingPolicy.Schedules, v1alpha2.ScalingSchedule{
+			Name:     name,
+			Schedule: schedule,
+			Duration: metav1.Duration{Duration: duration},
+			ReplicaRange: v1alpha2.ReplicaRange{
+				MinReplicas: &minReplicas,
+				MaxReplicas: maxReplicas,
+			},
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+)
+
+type CompositeOption func(*v1alpha2.Composite)
+
+func Composite(name, namespace string, opt ...CompositeOption) *v1alpha2.Composite {
+	c := &v1alpha2.Composite{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	for _, opt := range opt {
+		opt(c)
+	}
+
+	return c
+}
+
+func WithCompositeComponent(component *v1alpha2.Component) CompositeOption {
+	return func(c *v1alpha2.Composite) {
+		c.Spec.Components = append(c.Spec.Components, *component)
+	}
+}
+
+func WithCompositeAnnotation(key, value string) CompositeOption {
+	return func(c *v1alpha2.Composite) {
+		if c.Annotations == nil {
+			c.Annotations = make(map[string]string)
+		}
+		c.Annotations[key] = value
+	}
+}
+
+func WithCompositeStatus(status v1alpha2.CompositeStatus) CompositeOption {
+	return func(c *v1alpha2.Composite) {
+		c.Status = status
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+)
+
+type GatewayOption func(*v1alpha2.Gateway)
+
+func Gateway(name, namespace string, opt ...GatewayOption) *v1alpha2.Gateway {
+	g := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	for _, opt := range opt {
+		opt(g)
+	}
+
+	return g
+}
+
+func WithGatewayHTTPRoute(context string, global bool, host string, port uint32) GatewayOption {
+	return func(g *v1alpha2.Gateway) {
+		g.Spec.Ingress.HTTPRoutes = append(g.Spec.Ingress.HTTPRoutes, v1alpha2.HTTPRoute{
+			Context: context,
+			Global:  global,
+			Destination: v1alpha2.Destination{
+				Host: host,
+				Port: port,
+			},
+		})
+	}
+}
+
+func WithGatewayReplicas(replicas int32) GatewayOption {
+	return func(g *v1alpha2.Gateway) {
+		g.Spec.ScalingPolicy.Replicas = &replicas
+	}
+}
+
+func WithGatewayAnnotation(key, value string) GatewayOption {
+	return func(g *v1alpha2.Gateway) {
+		if g.Annotations == nil {
+			g.Annotations = make(map[string]string)
+		}
+		g.Annotations[key] = value
+	}
+}
+
+func WithGatewayStatus(status v1alpha2.GatewayStatus) GatewayOption {
+	return func(g *v1alpha2.Gateway) {
+		g.Status = status
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+)
+
+type TokenServiceOption func(*v1alpha2.TokenService)
+
+func TokenService(name, namespace string, opt ...TokenServiceOption) *v1alpha2.TokenService {
+	t := &v1alpha2.TokenService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	for _, opt := range opt {
+		opt(t)
+	}
+
+	return t
+}
+
+func WithTokenServiceSelector(m map[string]string) TokenServiceOption {
+	return func(t *v1alpha2.TokenService) {
+		t.Spec.Selector = m
+	}
+}
+
+func WithTokenServiceInterceptMode(mode v1alpha2.InterceptMode) TokenServiceOption {
+	return func(t *v1alpha2.TokenService) {
+		t.Spec.InterceptMode = mode
+	}
+}
+
+func WithTokenServiceAnnotation(key, value string) TokenServiceOption {
+	return func(t *v1alpha2.TokenService) {
+		if t.Annotations == nil {
+			t.Annotations = make(map[string]string)
+		}
+		t.Annotations[key] = value
+	}
+}
+
+func WithTokenServiceStatus(status v1alpha2.TokenServiceStatus) TokenServiceOption {
+	return func(t *v1alpha2.TokenService) {
+		t.Status = status
+	}
+}
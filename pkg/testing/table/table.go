@@ -0,0 +1,260 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+// Package table provides a table driven test harness for the reconcilers. Each row seeds the fake
+// informers and clients with objects, reconciles a key and asserts on the resulting API actions,
+// events and status updates.
+package table
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/yaml"
+
+	fakeclients "cellery.io/cellery-controller/pkg/clients/fake"
+	"cellery.io/cellery-controller/pkg/config"
+	fakeconfig "cellery.io/cellery-controller/pkg/config/fake"
+	"cellery.io/cellery-controller/pkg/controller"
+	fakeinformers "cellery.io/cellery-controller/pkg/informers/fake"
+	cellerymeta "cellery.io/cellery-controller/pkg/meta"
+)
+
+const maxEvents = 100
+
+var update = flag.Bool("update", false, "Update the golden files of the reconciler table tests")
+
+// Reactor is prepended to the fake clients of a row, e.g. to inject API failures.
+type Reactor struct {
+	Verb     string
+	Resource string
+	Reaction clientgotesting.ReactionFunc
+}
+
+// Delete identifies an expected delete action.
+type Delete struct {
+	Resource  string
+	Namespace string
+	Name      string
+}
+
+// Row is a single test case of a reconciler table test.
+type Row struct {
+	Name string
+	// Key of the object to reconcile
+	Key string
+	// Objects are seeded into the fake informers and the fake clients
+	Objects []runtime.Object
+	// Config is the data of the cellery ConfigMap
+	Config       map[string]string
+	WithReactors []Reactor
+
+	WantErr           bool
+	WantCreates       []runtime.Object
+	WantUpdates       []runtime.Object
+	WantStatusUpdates []runtime.Object
+	WantDeletes       []Delete
+	// WantEvents are in the format of the fake event recorder, e.g. `Normal Created Created Service "foo"`
+	WantEvents []string
+	// Golden is the name of the golden file in testdata which holds the manifests of the created
+	// objects. WantCreates is ignored if set. Run the tests with -update to regenerate the file.
+	Golden string
+}
+
+// Table is a list of reconciler test cases.
+type Table []Row
+
+// Factory creates the reconciler under test from the fake clients, informers, configuration
+// and event recorder of a row.
+type Factory func(clients *fakeclients.Clients, informers *fakeinformers.Informers, cfg config.Interface,
+	recorder record.EventRecorder) controller.Reconciler
+
+// Test runs each row of the table as a subtest.
+func (tt Table) Test(t *testing.T, factory Factory) {
+	for i := range tt {
+		row := &tt[i]
+		t.Run(row.Name, func(t *testing.T) {
+			row.Test(t, factory)
+		})
+	}
+}
+
+// Test reconciles the key of the row and verifies the outcome.
+func (r *Row) Test(t *testing.T, factory Factory) {
+	clients := fakeclients.New(r.Objects...)
+	informers := fakeinformers.New(clients, 0, r.Objects...)
+	for _, reactor := range r.WithReactors {
+		clients.PrependReactor(reactor.Verb, reactor.Resource, reactor.Reaction)
+	}
+	recorder := record.NewFakeRecorder(maxEvents)
+	reconciler := factory(clients, informers, fakeconfig.New(r.Config), recorder)
+
+	_, err := reconciler.Reconcile(context.Background(), r.Key)
+	if (err != nil) != r.WantErr {
+		t.Errorf("Reconcile() error = %v, WantErr %v", err, r.WantErr)
+	}
+
+	clients.ProcessActions()
+	var creates, updates, statusUpdates []runtime.Object
+	for _, action := range clients.GetCreateActions() {
+		creates = append(creates, action.GetObject())
+	}
+	for _, action := range clients.GetUpdateActions() {
+		if action.GetSubresource() == "status" {
+			statusUpdates = append(statusUpdates, action.GetObject())
+		} else {
+			updates = append(updates, action.GetObject())
+		}
+	}
+
+	if len(r.Golden) > 0 {
+		compareGolden(t, r.Golden, creates)
+	} else {
+		compareObjects(t, "create", r.WantCreates, creates)
+	}
+	compareObjects(t, "update", r.WantUpdates, updates)
+	compareObjects(t, "status update", r.WantStatusUpdates, statusUpdates)
+
+	var deletes []Delete
+	for _, action := range clients.GetDeleteActions() {
+		deletes = append(deletes, Delete{
+			Resource:  action.GetResource().Resource,
+			Namespace: action.GetNamespace(),
+			Name:      action.GetName(),
+		})
+	}
+	if diff := cmp.Diff(r.WantDeletes, deletes); diff != "" {
+		t.Errorf("Unexpected deletes (-want, +got)\n%s", diff)
+	}
+
+	var events []string
+	for done := false; !done; {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			done = true
+		}
+	}
+	if diff := cmp.Diff(r.WantEvents, events); diff != "" {
+		t.Errorf("Unexpected events (-want, +got)\n%s", diff)
+	}
+}
+
+// objectKey identifies an object by its type, namespace and name. The type is qualified with the
+// package since the mesh and the istio types share kinds such as Gateway.
+func objectKey(obj runtime.Object) string {
+	kind := strings.TrimPrefix(fmt.Sprintf("%T", obj), "*")
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return kind
+	}
+	return fmt.Sprintf("%s %s/%s", kind, accessor.GetNamespace(), accessor.GetName())
+}
+
+// compareObjects matches the objects by their keys since the actions of the kubernetes and the
+// mesh clients are not ordered with respect to each other.
+func compareObjects(t *testing.T, action string, want, got []runtime.Object) {
+	t.Helper()
+	gotByKey := make(map[string]runtime.Object, len(got))
+	for _, obj := range got {
+		gotByKey[objectKey(obj)] = obj
+	}
+	for _, w := range want {
+		key := objectKey(w)
+		g, ok := gotByKey[key]
+		if !ok {
+			t.Errorf("Missing %s of %s", action, key)
+			continue
+		}
+		delete(gotByKey, key)
+		if !equality.Semantic.DeepEqual(w, g) {
+			t.Errorf("Unexpected %s of %s (-want, +got)\n%s", action, key, diffManifests(w, g))
+		}
+	}
+	for key, obj := range gotByKey {
+		b, _ := yaml.Marshal(obj)
+		t.Errorf("Unexpected %s of %s\n%s", action, key, b)
+	}
+}
+
+func compareGolden(t *testing.T, name string, objs []runtime.Object) {
+	t.Helper()
+	got := manifests(t, objs)
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create the golden file directory: %v", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatalf("Failed to update the golden file %s: %v", path, err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read the golden file %s (run the tests with -update to create it): %v", path, err)
+	}
+	if diff := cmp.Diff(strings.Split(string(want), "\n"), strings.Split(got, "\n")); diff != "" {
+		t.Errorf("Created objects differ from %s (-want, +got), run the tests with -update if the change is expected\n%s", path, diff)
+	}
+}
+
+// manifests serializes the objects sorted by their keys. The data and the config hash of the secrets
+// are redacted since they are usually generated.
+func manifests(t *testing.T, objs []runtime.Object) string {
+	sorted := append([]runtime.Object(nil), objs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return objectKey(sorted[i]) < objectKey(sorted[j])
+	})
+	var docs []string
+	for _, obj := range sorted {
+		if secret, ok := obj.(*corev1.Secret); ok {
+			secret = controller.RedactSecret(secret)
+			if _, ok := secret.Annotations[cellerymeta.ConfigHashAnnotationKey]; ok {
+				secret.Annotations[cellerymeta.ConfigHashAnnotationKey] = "<redacted>"
+			}
+			obj = secret
+		}
+		b, err := yaml.Marshal(obj)
+		if err != nil {
+			t.Fatalf("Failed to serialize %s: %v", objectKey(obj), err)
+		}
+		docs = append(docs, fmt.Sprintf("# %s\n%s", objectKey(obj), b))
+	}
+	return strings.Join(docs, "---\n")
+}
+
+func diffManifests(want, got runtime.Object) string {
+	w, _ := yaml.Marshal(want)
+	g, _ := yaml.Marshal(got)
+	return cmp.Diff(strings.Split(string(w), "\n"), strings.Split(string(g), "\n"))
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package tracing records the spans of the reconcile loops with OpenTelemetry and propagates them
+// between the reconciles using the W3C trace context format.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "cellery.io/cellery-controller"
+	traceParentHeader   = "traceparent"
+)
+
+var propagator = propagation.TraceContext{}
+
+// Span is an OpenTelemetry span which records the error of the traced operation when it is finished.
+type Span struct {
+	trace.Span
+}
+
+// Finish records the error, if any, as the status of the span and ends it.
+func (s *Span) Finish(err error) {
+	if err != nil {
+		s.RecordError(err)
+		s.SetStatus(codes.Error, err.Error())
+	}
+	s.End()
+}
+
+// Start starts a child of the span in the context, or a new trace if the context does not carry a
+// span. Nothing is recorded until a tracer provider is set with SetProvider.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, *Span) {
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attributes...))
+	return ctx, &Span{span}
+}
+
+// StartFromRemote starts a span which continues the trace identified by the W3C traceparent. The span
+// carried by the context, if any, is linked to the new span. Start is used if the traceparent is not valid.
+func StartFromRemote(ctx context.Context, traceParent string, name string, attributes ...attribute.KeyValue) (context.Context, *Span) {
+	remote := trace.SpanContextFromContext(propagator.Extract(context.Background(), propagation.MapCarrier{traceParentHeader: traceParent}))
+	if !remote.IsValid() {
+		return Start(ctx, name, attributes...)
+	}
+	opts := []trace.SpanStartOption{trace.WithAttributes(attributes...)}
+	if local := trace.SpanContextFromContext(ctx); local.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: local}))
+	}
+	ctx, span := otel.Tracer(instrumentationName).Start(trace.ContextWithRemoteSpanContext(ctx, remote), name, opts...)
+	return ctx, &Span{span}
+}
+
+// TraceParent returns the W3C traceparent of the span carried by the context or an empty string if
+// there is no recorded span.
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier.Get(traceParentHeader)
+}
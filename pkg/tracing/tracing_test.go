@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func withRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	SetProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		if err := provider.Shutdown(context.Background()); err != nil {
+			t.Fatalf("failed to shutdown the provider: %v", err)
+		}
+	})
+	return recorder
+}
+
+func TestStartWithoutProvider(t *testing.T) {
+	ctx, span := Start(context.Background(), "noop")
+	span.SetAttributes(attribute.String("key", "value"))
+	span.Finish(errors.New("failed"))
+	if tp := TraceParent(ctx); tp != "" {
+		t.Errorf("expected an empty traceparent, got %q", tp)
+	}
+}
+
+func TestChildSpans(t *testing.T) {
+	recorder := withRecorder(t)
+
+	ctx, parent := Start(context.Background(), "parent", attribute.String("kind", "Cell"))
+	_, child := Start(ctx, "child")
+	child.Finish(errors.New("failed"))
+	parent.Finish(nil)
+
+	traceParent := TraceParent(ctx)
+	if want := "00-" + parent.SpanContext().TraceID().String() + "-" + parent.SpanContext().SpanID().String() + "-01"; traceParent != want {
+		t.Errorf("TraceParent() = %q, want %q", traceParent, want)
+	}
+	remoteCtx, remote := StartFromRemote(context.Background(), traceParent, "remote")
+	remote.Finish(nil)
+	_, linked := StartFromRemote(remoteCtx, "invalid", "fallback")
+	linked.Finish(nil)
+	_, local := StartFromRemote(ctx, traceParent, "local")
+	local.Finish(nil)
+
+	spans := recorder.Ended()
+	if len(spans) != 5 {
+		t.Fatalf("expected 5 ended spans, got %d", len(spans))
+	}
+	c, p, r, f, l := spans[0], spans[1], spans[2], spans[3], spans[4]
+	if c.SpanContext().TraceID() != p.SpanContext().TraceID() || c.Parent().SpanID() != p.SpanContext().SpanID() {
+		t.Errorf("child span is not a child of the parent span")
+	}
+	if c.Status().Code != codes.Error || c.Status().Description != "failed" {
+		t.Errorf("expected the child span to record the error, got %v", c.Status())
+	}
+	if p.Parent().IsValid() {
+		t.Errorf("expected the parent span to be a root span")
+	}
+	if attrs := p.Attributes(); len(attrs) != 1 || attrs[0] != attribute.String("kind", "Cell") {
+		t.Errorf("unexpected attributes of the parent span: %v", attrs)
+	}
+	if r.SpanContext().TraceID() != p.SpanContext().TraceID() || r.Parent().SpanID() != p.SpanContext().SpanID() || !r.Parent().IsRemote() {
+		t.Errorf("remote span does not continue the trace of the traceparent")
+	}
+	if f.Parent().SpanID() != r.SpanContext().SpanID() {
+		t.Errorf("span with an invalid traceparent is not a child of the span in the context")
+	}
+	if links := l.Links(); len(links) != 1 || links[0].SpanContext.SpanID() != p.SpanContext().SpanID() {
+		t.Errorf("remote span is not linked to the span in the context: %v", links)
+	}
+}
+
+func TestExporters(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		exporter func(address string) (sdktrace.SpanExporter, error)
+	}{
+		{
+			name:     "zipkin",
+			path:     "/api/v2/spans",
+			exporter: NewZipkinExporter,
+		},
+		{
+			name:     "otlp",
+			path:     "/v1/traces",
+			exporter: NewOTLPExporter,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var path string
+			var body []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				body, _ = ioutil.ReadAll(r.Body)
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			exporter, err := test.exporter(strings.TrimPrefix(server.URL, "http://"))
+			if err != nil {
+				t.Fatalf("failed to create the exporter: %v", err)
+			}
+			provider := NewProvider(exporter, "test")
+			_, span := provider.Tracer(instrumentationName).Start(context.Background(), "Reconcile Cell",
+				trace.WithAttributes(attribute.String("name", "foo")))
+			span.End()
+			if err := provider.Shutdown(context.Background()); err != nil {
+				t.Fatalf("failed to export: %v", err)
+			}
+			if path != test.path {
+				t.Errorf("unexpected path: got %q, want %q", path, test.path)
+			}
+			if !strings.Contains(strings.ToLower(string(body)), "reconcile cell") {
+				t.Errorf("exported payload does not contain the span: %q", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+)
+
+// validateAutoscaleOverride rejects the override if its target does not exist, is not scaled by an
+// HPA or does not allow its autoscaling to be overridden.
+func (s *server) validateAutoscaleOverride(override *v1alpha2.AutoscaleOverride, namespace string) field.ErrorList {
+	var fldPath *field.Path
+	var value string
+	var hpa *v1alpha2.HorizontalPodAutoscaler
+	var err error
+	switch override.TargetKind() {
+	case "Component":
+		fldPath, value = field.NewPath("spec", "component"), override.Spec.Component
+		var component *v1alpha2.Component
+		component, err = s.meshClient.MeshV1alpha2().Components(namespace).Get(override.TargetName(), metav1.GetOptions{})
+		if err == nil {
+			hpa = component.Spec.ScalingPolicy.Hpa
+		}
+	default:
+		fldPath, value = field.NewPath("spec", "instance"), override.Spec.Instance
+		var gateway *v1alpha2.Gateway
+		gateway, err = s.meshClient.MeshV1alpha2().Gateways(namespace).Get(override.TargetName(), metav1.GetOptions{})
+		if err == nil {
+			hpa = gateway.Spec.ScalingPolicy.Hpa
+		}
+	}
+	if apierrors.IsNotFound(err) {
+		return field.ErrorList{field.NotFound(fldPath, value)}
+	} else if err != nil {
+		return field.ErrorList{field.InternalError(fldPath, err)}
+	}
+	if hpa == nil {
+		return field.ErrorList{field.Invalid(fldPath, value, "is not scaled by a horizontal pod autoscaler")}
+	}
+	if !hpa.IsOverridable() {
+		return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf("autoscaling of the %s %q is not overridable", override.TargetKind(), override.TargetName()))}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2019 WSO2 Inc. (http:www.wso2.org) All Rights Reserved.
+ *
+ * WSO2 Inc. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http:www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package webhook
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+
+	meshfake "cellery.io/cellery-controller/pkg/generated/clientset/versioned/fake"
+	. "cellery.io/cellery-controller/pkg/testing/apis/mesh/v1alpha2"
+)
+
+func TestValidateAutoscaleOverride(t *testing.T) {
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Group: "mesh.cellery.io", Resource: "components"}, "foo--hello", nil)
+	fldPath := field.NewPath("spec", "component")
+
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		reactor clientgotesting.ReactionFunc
+		want    field.ErrorList
+	}{
+		{
+			name: "overridable component",
+			objects: []runtime.Object{
+				Component("foo--hello", "bar", WithComponentHpa(1, 5)),
+			},
+		},
+		{
+			name: "missing component",
+			want: field.ErrorList{field.NotFound(fldPath, "hello")},
+		},
+		{
+			name: "component not scaled by an hpa",
+			objects: []runtime.Object{
+				Component("foo--hello", "bar"),
+			},
+			want: field.ErrorList{field.Invalid(fldPath, "hello", "is not scaled by a horizontal pod autoscaler")},
+		},
+		{
+			name: "component which is not overridable",
+			objects: []runtime.Object{
+				Component("foo--hello", "bar", WithComponentHpa(1, 5), WithComponentHpaOverridable(false)),
+			},
+			want: field.ErrorList{field.Forbidden(fldPath, `autoscaling of the Component "foo--hello" is not overridable`)},
+		},
+		{
+			name: "component lookup forbidden by rbac",
+			reactor: func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, nil, forbidden
+			},
+			want: field.ErrorList{field.InternalError(fldPath, forbidden)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			meshClient := meshfake.NewSimpleClientset(test.objects...)
+			if test.reactor != nil {
+				meshClient.PrependReactor("get", "components", test.reactor)
+			}
+			s := NewServer(kubefake.NewSimpleClientset(), meshClient, ServerOptions{}, zap.NewNop().Sugar())
+			got := s.validateAutoscaleOverride(AutoscaleOverride("override", "bar", "foo", WithAutoscaleOverrideComponent("hello")), "bar")
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected errors (-want, +got)\n%v", diff)
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 
 	"cellery.io/cellery-controller/pkg/apis"
 	"cellery.io/cellery-controller/pkg/apis/mesh/v1alpha2"
+	meshclient "cellery.io/cellery-controller/pkg/generated/clientset/versioned"
 )
 
 const (
@@ -52,15 +53,17 @@ type ServerOptions struct {
 }
 type server struct {
 	kubeClient kubernetes.Interface
+	meshClient meshclient.Interface
 	options    *ServerOptions
 	logger     *zap.SugaredLogger
 	defaulters map[schema.GroupVersionKind]apis.Defaulter
 	validators map[schema.GroupVersionKind]apis.Validator
 }
 
-func NewServer(kubeClient kubernetes.Interface, opt ServerOptions, logger *zap.SugaredLogger) *server {
+func NewServer(kubeClient kubernetes.Interface, meshClient meshclient.Interface, opt ServerOptions, logger *zap.SugaredLogger) *server {
 	return &server{
 		kubeClient: kubeClient,
+		meshClient: meshClient,
 		options:    &opt,
 		logger:     logger.Named("webhook"),
 		defaulters: map[schema.GroupVersionKind]apis.Defaulter{
@@ -71,11 +74,12 @@ func NewServer(kubeClient kubernetes.Interface, opt ServerOptions, logger *zap.S
 			v1alpha2.SchemeGroupVersion.WithKind("Composite"):    &v1alpha2.Composite{},
 		},
 		validators: map[schema.GroupVersionKind]apis.Validator{
-			v1alpha2.SchemeGroupVersion.WithKind("Component"):    &v1alpha2.Component{},
-			v1alpha2.SchemeGroupVersion.WithKind("Gateway"):      &v1alpha2.Gateway{},
-			v1alpha2.SchemeGroupVersion.WithKind("TokenService"): &v1alpha2.TokenService{},
-			v1alpha2.SchemeGroupVersion.WithKind("Cell"):         &v1alpha2.Cell{},
-			v1alpha2.SchemeGroupVersion.WithKind("Composite"):    &v1alpha2.Composite{},
+			v1alpha2.SchemeGroupVersion.WithKind("Component"):         &v1alpha2.Component{},
+			v1alpha2.SchemeGroupVersion.WithKind("Gateway"):           &v1alpha2.Gateway{},
+			v1alpha2.SchemeGroupVersion.WithKind("TokenService"):      &v1alpha2.TokenService{},
+			v1alpha2.SchemeGroupVersion.WithKind("Cell"):              &v1alpha2.Cell{},
+			v1alpha2.SchemeGroupVersion.WithKind("Composite"):         &v1alpha2.Composite{},
+			v1alpha2.SchemeGroupVersion.WithKind("AutoscaleOverride"): &v1alpha2.AutoscaleOverride{},
 		},
 	}
 }
@@ -195,7 +199,11 @@ func (s *server) validate(req *admissionv1beta1.AdmissionRequest) *admissionv1be
 		return makeErrorResponse("cannot not unmarshal raw object: %v", err)
 	}
 
-	if allErrs := obj.Validate(); len(allErrs) > 0 {
+	allErrs := obj.Validate()
+	if override, ok := obj.(*v1alpha2.AutoscaleOverride); ok && len(allErrs) == 0 {
+		allErrs = s.validateAutoscaleOverride(override, req.Namespace)
+	}
+	if len(allErrs) > 0 {
 		err := apierrors.NewInvalid(obj.GetObjectKind().GroupVersionKind().GroupKind(), obj.GetName(), allErrs)
 		logger.Errorf("Validation failed: %v", err)
 		return makeErrorResponse("validation failed: %v", err)